@@ -9,6 +9,7 @@ import (
 	"backend/internal/models"
 	"backend/internal/repositories"
 	"backend/internal/routes"
+	"backend/internal/scrapers"
 	"backend/internal/services"
 	"backend/internal/utils"
 	"fmt"
@@ -17,6 +18,9 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // gin-swagger middleware
@@ -63,6 +67,7 @@ func main() {
 	db := config.ConnectDB(false)
 
 	ttsDir := "./tts-files"
+	coverDir := "./covers"
 	logDir := "logs"
 
 	// Delete all TTS files
@@ -85,16 +90,97 @@ func main() {
 	novelRepo := repositories.NewNovelRepository(db)
 	chapterRepo := repositories.NewChapterRepository(db)
 	bookmarkRepo := repositories.NewBookmarkRepository(db)
+	readingPositionRepo := repositories.NewReadingPositionRepository(db)
+	readingEventRepo := repositories.NewReadingEventRepository(db)
+	shelfRepo := repositories.NewShelfRepository(db)
 	logRepo := repositories.NewLogRepository(db)
-
-	scriptExecutor := &utils.RealScriptExecutor{}
+	importJobRepo := repositories.NewImportJobRepository(db)
+	chapterRevisionRepo := repositories.NewChapterRevisionRepository(db)
+	taxonomyRepo := repositories.NewTaxonomyRepository(db)
+	feedTokenRepo := repositories.NewFeedTokenRepository(db)
+	searchRepo := repositories.NewSearchRepository(db)
+
+	// Keep long-lived Python workers instead of starting an interpreter for every chapter of bulk imports
+	scraperWorkers, err := strconv.Atoi(os.Getenv("SCRAPER_WORKERS"))
+	if err != nil || scraperWorkers <= 0 {
+		scraperWorkers = 4
+	}
+	scriptExecutor := utils.NewScriptWorkerPool(utils.ScriptWorkerPoolConfig{
+		Command:        os.Getenv("PYTHON"),
+		Args:           []string{"-m", "novel_updates_scraper.client", "worker"},
+		Size:           scraperWorkers,
+		RequestTimeout: 2 * time.Minute,
+	})
+	defer scriptExecutor.Close()
+
+	// Each source gets its own rate limits, retries and circuit breaker
+	sourceLimits, err := scrapers.ParseSourceLimits(os.Getenv("SOURCE_LIMITS"), scrapers.DefaultSourceLimits)
+	if err != nil {
+		log.Fatalf("Error configuring the source limits: %v", err)
+	}
+	throttle := scrapers.NewThrottle(scrapers.NewHTTPFetcher(30*time.Second), sourceLimits)
+
+	// Sources are tried in registration order, the Python client is kept as the last fallback for the chapters
+	sourceRegistry := scrapers.NewRegistry(
+		scrapers.NewWuxiaBoxSource(throttle.Fetcher("wuxiabox")),
+		scrapers.NewNovTalesSource(throttle.Fetcher("novtales")),
+		scrapers.NewNovelBinSource(throttle.Fetcher("novelbin")),
+		scrapers.NewLightNovelWorldSource(throttle.Fetcher("lightnovelworld")),
+		scrapers.NewPythonSource(scriptExecutor),
+	)
+	// The metadata is fetched from NovelUpdates through the Python client first, the websites don't have the year and
+	// the release frequency of the novels
+	sourceRegistry.PreferForMetadata("python")
 
 	userService := services.NewUserService(userRepo)
 	authService := services.NewAuthService(userRepo, authRepo)
-	novelService := services.NewNovelService(novelRepo, scriptExecutor)
-	chapterService := services.NewChapterService(chapterRepo)
-	bookmarkService := services.NewBookmarkService(bookmarkRepo)
+	novelService := services.NewNovelService(novelRepo, sourceRegistry)
+	chapterService := services.NewChapterService(chapterRepo, novelRepo, sourceRegistry)
+	bookmarkService := services.NewBookmarkService(bookmarkRepo, novelRepo)
+	readingPositionService := services.NewReadingPositionService(readingPositionRepo, novelRepo, bookmarkRepo)
+	readingHistoryService := services.NewReadingHistoryService(readingEventRepo)
+	shelfService := services.NewShelfService(shelfRepo, novelRepo)
+	libraryService := services.NewLibraryService(bookmarkRepo, novelRepo, novelService)
 	logService := services.NewLogService(logRepo)
+	importJobService := services.NewImportJobService(importJobRepo, novelRepo, chapterService, throttle)
+	chapterRevisionService := services.NewChapterRevisionService(chapterRevisionRepo, chapterRepo, chapterService)
+	epubImportService := services.NewEPUBImportService(novelRepo, chapterRepo, coverDir, fmt.Sprintf("%s/covers", os.Getenv("BACKEND_URL")))
+	opdsService := services.NewOPDSService(novelRepo, taxonomyRepo, os.Getenv("BACKEND_URL"))
+	searchService := services.NewSearchService(novelRepo, searchRepo)
+	taxonomyService := services.NewTaxonomyService(taxonomyRepo)
+	feedService := services.NewFeedService(novelRepo, chapterRepo, bookmarkRepo, feedTokenRepo, os.Getenv("BACKEND_URL"), os.Getenv("FRONTEND_URL"))
+	epubExportService := services.NewEPUBExportService(novelRepo, chapterRepo, coverDir, fmt.Sprintf("%s/covers", os.Getenv("BACKEND_URL")))
+
+	// Pick up the import jobs that were running when the server stopped
+	if err := importJobService.ResumeInterruptedImportJobs(); err != nil {
+		log.Println(err)
+	}
+
+	// Novels releasing often are refreshed more often, a schedule set to "off" hands its novels to the next one
+	var refreshSchedules []services.RefreshSchedule
+	for _, schedule := range []services.RefreshSchedule{
+		{Name: "frequent", Cron: "0 */6 * * *", MaxReleaseInterval: 1},
+		{Name: "regular", Cron: "30 2 * * *", MaxReleaseInterval: 7},
+		{Name: "slow", Cron: "30 3 * * 0"},
+	} {
+		if cron := os.Getenv("NOVEL_REFRESH_" + strings.ToUpper(schedule.Name)); cron != "" {
+			schedule.Cron = cron
+		}
+		if schedule.Cron != "off" {
+			refreshSchedules = append(refreshSchedules, schedule)
+		}
+	}
+	if len(refreshSchedules) > 0 {
+		// The last schedule refreshes the novels with a release frequency too long or unknown for the others
+		refreshSchedules[len(refreshSchedules)-1].MaxReleaseInterval = 0
+	}
+
+	refreshSchedulerService, err := services.NewRefreshSchedulerService(novelRepo, novelService, importJobService, refreshSchedules)
+	if err != nil {
+		log.Fatalf("Error configuring the novel refresh: %v", err)
+	}
+	refreshSchedulerService.Start()
+	defer refreshSchedulerService.Close()
 
 	ttsService := &services.TTSService{
 		OutputDir: ttsDir,
@@ -128,8 +214,21 @@ func main() {
 	userController := controllers.NewUserController(userService)
 	authController := controllers.NewAuthController(authService, userService)
 	novelController := controllers.NewNovelController(novelService)
+	epubImportController := controllers.NewEPUBImportController(epubImportService)
+	epubExportController := controllers.NewEPUBExportController(epubExportService)
+	opdsController := controllers.NewOPDSController(opdsService)
+	feedController := controllers.NewFeedController(feedService)
+	searchController := controllers.NewSearchController(searchService)
+	taxonomyController := controllers.NewTaxonomyController(taxonomyService)
 	bookmarkController := controllers.NewBookmarkController(bookmarkService)
-	chapterController := controllers.NewChapterController(chapterService, novelService)
+	readingPositionController := controllers.NewReadingPositionController(readingPositionService)
+	readingHistoryController := controllers.NewReadingHistoryController(readingHistoryService)
+	shelfController := controllers.NewShelfController(shelfService)
+	libraryController := controllers.NewLibraryController(libraryService)
+	chapterController := controllers.NewChapterController(chapterService, readingHistoryService)
+	chapterRevisionController := controllers.NewChapterRevisionController(chapterRevisionService)
+	importJobController := controllers.NewImportJobController(importJobService)
+	refreshSchedulerController := controllers.NewRefreshSchedulerController(refreshSchedulerService)
 	ttsController := controllers.NewTTSController(ttsService)
 	logController := controllers.NewLogController(logFilePath, logService)
 
@@ -137,7 +236,7 @@ func main() {
 	middleware := middleware.NewMiddleware(userService)
 
 	// Set up routes
-	routes.SetupRoutes(r, authController, userController, novelController, bookmarkController, chapterController, ttsController, logController, middleware)
+	routes.SetupRoutes(r, authController, userController, novelController, epubImportController, epubExportController, opdsController, feedController, searchController, taxonomyController, bookmarkController, readingPositionController, readingHistoryController, shelfController, libraryController, chapterController, chapterRevisionController, importJobController, refreshSchedulerController, ttsController, logController, middleware)
 
 	fmt.Printf("Server running on port %s\n", port)
 	err = r.Run(":" + port)
@@ -33,6 +33,13 @@ func ConnectDB(isTest bool) *gorm.DB {
 		if errConnect != nil {
 			log.Fatalf("Failed to connect to in-memory database: %v", errConnect)
 		}
+
+		// Every connection to ":memory:" opens a different database, so background goroutines must share a single one
+		sqlDB, err := db.DB()
+		if err != nil {
+			log.Fatalf("Failed to get the in-memory database: %v", err)
+		}
+		sqlDB.SetMaxOpenConns(1)
 		fmt.Println("Connected to in-memory SQLite database for testing.")
 	} else {
 		// Read environment variables or configuration for PostgreSQL
@@ -67,16 +74,41 @@ func ConnectDB(isTest bool) *gorm.DB {
 
 	// Automatically migrate database schema for models
 	autoMigrate(db)
+	createSearchIndexes(db)
+	countChapterWords(db)
+	normalizeBookmarkStatuses(db)
 
 	return db
 }
 
+// MigratedModels are the models whose tables are created or updated by autoMigrate, in migration order.
+var MigratedModels = []interface{}{
+	&models.User{},
+	&models.RevokedToken{},
+	&models.Novel{},
+	&models.Chapter{},
+	&models.Tag{},
+	&models.NovelTag{},
+	&models.NovelAuthor{},
+	&models.Author{},
+	&models.BookmarkedNovel{},
+	&models.NovelGenre{},
+	&models.Genre{},
+	&models.LogEntry{},
+	&models.ImportJob{},
+	&models.ImportJobChapter{},
+	&models.ChapterRevision{},
+	&models.FeedToken{},
+	&models.ReadingPosition{},
+	&models.ReadingEvent{},
+	&models.Shelf{},
+}
+
 // autoMigrate performs database migrations for all defined models.
 //
 // This function uses GORM's AutoMigrate function to create or update database tables
-// based on the Go structs defined in the models package. It migrates tables for Users,
-// RevokedTokens, Novels, Chapters, Tags, NovelTags, NovelAuthors, Authors, BookmarkedNovels,
-// NovelGenres, Genres, and LogEntries. Failure to migrate results in a fatal error.
+// based on the Go structs defined in the models package. It migrates the tables of MigratedModels.
+// Failure to migrate results in a fatal error.
 //
 // Parameters:
 //   - db (*gorm.DB): A pointer to a GORM database connection.
@@ -87,11 +119,168 @@ func ConnectDB(isTest bool) *gorm.DB {
 // Error types:
 //   - error:  A fatal error is logged and the program exits if database migration fails.
 func autoMigrate(db *gorm.DB) {
-	err := db.AutoMigrate(&models.User{}, &models.RevokedToken{}, &models.Novel{},
-		&models.Chapter{}, &models.Tag{}, &models.NovelTag{}, &models.NovelAuthor{}, &models.Author{},
-		&models.BookmarkedNovel{}, &models.NovelGenre{}, &models.Genre{}, &models.LogEntry{})
+	err := db.AutoMigrate(MigratedModels...)
 	if err != nil {
 		log.Fatalf("Failed to migrate database schema: %v", err)
 	}
 	fmt.Println("Database schema migrated successfully!")
 }
+
+// countChapterWords counts the words of the chapters stored before the chapters had a word count, which is otherwise
+// counted when a chapter is saved.
+//
+// Parameters:
+//   - db (*gorm.DB): A pointer to a GORM database connection.
+//
+// Error types:
+//   - error: A fatal error is logged and the program exits if the word counts could not be stored.
+func countChapterWords(db *gorm.DB) {
+	var chapters []models.Chapter
+	err := db.Select("id", "body").Where("word_count = 0 AND body <> ''").
+		FindInBatches(&chapters, 100, func(tx *gorm.DB, batch int) error {
+			for _, chapter := range chapters {
+				if err := db.Model(&models.Chapter{}).Where("id = ?", chapter.ID).
+					UpdateColumn("word_count", models.CountWords(chapter.Body)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		log.Fatalf("Failed to count the words of the chapters: %v", err)
+	}
+}
+
+// normalizeBookmarkStatuses rewrites the statuses of the bookmarks stored before they were validated, such as
+// "Plan to Read" or "on_hold", as the status they spell. Statuses that spell none are left as they are.
+//
+// Parameters:
+//   - db (*gorm.DB): A pointer to a GORM database connection.
+//
+// Error types:
+//   - error: A fatal error is logged and the program exits if the statuses could not be stored.
+func normalizeBookmarkStatuses(db *gorm.DB) {
+	for _, status := range models.BookmarkStatuses {
+		if err := db.Model(&models.BookmarkedNovel{}).
+			Where("LOWER(REPLACE(REPLACE(TRIM(status), ' ', '-'), '_', '-')) = ? AND status <> ?", status, status).
+			UpdateColumn("status", status).Error; err != nil {
+			log.Fatalf("Failed to normalize the statuses of the bookmarks: %v", err)
+		}
+	}
+}
+
+// postgresSearchIndexes are the statements creating the full-text search indexes in PostgreSQL. The document of each
+// novel, which includes the names of its authors, is stored in novels.search_document by triggers on the novels and
+// their authors, and the chapter bodies are indexed as they are.
+var postgresSearchIndexes = []string{
+	`ALTER TABLE novels ADD COLUMN IF NOT EXISTS search_document tsvector`,
+	`CREATE OR REPLACE FUNCTION novels_search_document() RETURNS trigger AS $$
+	BEGIN
+		NEW.search_document :=
+			setweight(to_tsvector('simple', NEW.title), 'A') ||
+			setweight(to_tsvector('simple', COALESCE((
+				SELECT string_agg(authors.name, ' ') FROM authors
+				JOIN novel_authors ON novel_authors.author_id = authors.id
+				WHERE novel_authors.novel_id = NEW.id
+			), '')), 'B') ||
+			setweight(to_tsvector('simple', COALESCE(NEW.synopsis, '')), 'C');
+		RETURN NEW;
+	END
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS novels_search_document ON novels`,
+	`CREATE TRIGGER novels_search_document BEFORE INSERT OR UPDATE OF title, synopsis ON novels
+		FOR EACH ROW EXECUTE FUNCTION novels_search_document()`,
+	`CREATE OR REPLACE FUNCTION novel_authors_search_document() RETURNS trigger AS $$
+	BEGIN
+		IF TG_OP <> 'INSERT' THEN
+			UPDATE novels SET title = title WHERE id = OLD.novel_id;
+		END IF;
+		IF TG_OP <> 'DELETE' THEN
+			UPDATE novels SET title = title WHERE id = NEW.novel_id;
+		END IF;
+		RETURN NULL;
+	END
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS novel_authors_search_document ON novel_authors`,
+	`CREATE TRIGGER novel_authors_search_document AFTER INSERT OR UPDATE OR DELETE ON novel_authors
+		FOR EACH ROW EXECUTE FUNCTION novel_authors_search_document()`,
+	`UPDATE novels SET title = title WHERE search_document IS NULL`,
+	`CREATE INDEX IF NOT EXISTS idx_novels_search_document ON novels USING GIN (search_document)`,
+	`CREATE INDEX IF NOT EXISTS idx_chapters_body_search ON chapters USING GIN (to_tsvector('simple', body))`,
+}
+
+// sqliteSearchIndexes are the statements creating the FTS5 tables SQLite searches in, and the triggers keeping them in
+// sync with the novels, their authors and the chapters.
+var sqliteSearchIndexes = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS novels_fts USING fts5(title, synopsis, authors)`,
+	`CREATE TRIGGER IF NOT EXISTS novels_fts_insert AFTER INSERT ON novels BEGIN
+		INSERT INTO novels_fts(rowid, title, synopsis, authors) VALUES (new.id, new.title, new.synopsis, '');
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS novels_fts_update AFTER UPDATE OF title, synopsis ON novels BEGIN
+		UPDATE novels_fts SET title = new.title, synopsis = new.synopsis WHERE rowid = new.id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS novels_fts_delete AFTER DELETE ON novels BEGIN
+		DELETE FROM novels_fts WHERE rowid = old.id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS novel_authors_fts_insert AFTER INSERT ON novel_authors BEGIN
+		UPDATE novels_fts SET authors = (
+			SELECT COALESCE(GROUP_CONCAT(authors.name, ' '), '') FROM authors
+			JOIN novel_authors ON novel_authors.author_id = authors.id
+			WHERE novel_authors.novel_id = new.novel_id
+		) WHERE rowid = new.novel_id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS novel_authors_fts_delete AFTER DELETE ON novel_authors BEGIN
+		UPDATE novels_fts SET authors = (
+			SELECT COALESCE(GROUP_CONCAT(authors.name, ' '), '') FROM authors
+			JOIN novel_authors ON novel_authors.author_id = authors.id
+			WHERE novel_authors.novel_id = old.novel_id
+		) WHERE rowid = old.novel_id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS novel_authors_fts_update AFTER UPDATE ON novel_authors BEGIN
+		UPDATE novels_fts SET authors = (
+			SELECT COALESCE(GROUP_CONCAT(authors.name, ' '), '') FROM authors
+			JOIN novel_authors ON novel_authors.author_id = authors.id
+			WHERE novel_authors.novel_id = novels_fts.rowid
+		) WHERE rowid IN (old.novel_id, new.novel_id);
+	END`,
+	`INSERT INTO novels_fts(rowid, title, synopsis, authors)
+		SELECT novels.id, novels.title, novels.synopsis, COALESCE((
+			SELECT GROUP_CONCAT(authors.name, ' ') FROM authors
+			JOIN novel_authors ON novel_authors.author_id = authors.id
+			WHERE novel_authors.novel_id = novels.id
+		), '') FROM novels WHERE novels.id NOT IN (SELECT rowid FROM novels_fts)`,
+	`CREATE VIRTUAL TABLE IF NOT EXISTS chapters_fts USING fts5(body, content='chapters', content_rowid='id')`,
+	`CREATE TRIGGER IF NOT EXISTS chapters_fts_insert AFTER INSERT ON chapters BEGIN
+		INSERT INTO chapters_fts(rowid, body) VALUES (new.id, new.body);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS chapters_fts_update AFTER UPDATE OF body ON chapters BEGIN
+		INSERT INTO chapters_fts(chapters_fts, rowid, body) VALUES ('delete', old.id, old.body);
+		INSERT INTO chapters_fts(rowid, body) VALUES (new.id, new.body);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS chapters_fts_delete AFTER DELETE ON chapters BEGIN
+		INSERT INTO chapters_fts(chapters_fts, rowid, body) VALUES ('delete', old.id, old.body);
+	END`,
+	`INSERT INTO chapters_fts(chapters_fts) VALUES ('rebuild')`,
+}
+
+// createSearchIndexes creates what the full-text search of the novels and chapters needs: GIN indexes over tsvectors, and
+// the triggers storing the documents of the novels, in PostgreSQL, or FTS5 tables kept in sync by triggers in the SQLite
+// database of the tests.
+//
+// Parameters:
+//   - db (*gorm.DB): A pointer to a GORM database connection.
+//
+// Error types:
+//   - error: A fatal error is logged and the program exits if an index could not be created.
+func createSearchIndexes(db *gorm.DB) {
+	statements := postgresSearchIndexes
+	if db.Dialector.Name() == "sqlite" {
+		statements = sqliteSearchIndexes
+	}
+
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			log.Fatalf("Failed to create the search indexes: %v", err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"backend/internal/models"
 	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
 	"backend/internal/utils"
 	"log"
 	"net/http"
@@ -59,10 +60,32 @@ func (b *BookmarkController) GetBookmarkedNovelsByUserID(ctx *gin.Context) {
 	})
 }
 
+func (b *BookmarkController) GetContinueReading(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	entries, err := b.bookmarkService.GetContinueReading(user.ID, ctx.QueryArray("status"), limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
 func (b *BookmarkController) CreateBookmark(ctx *gin.Context) {
 	var bookmarkedNovel models.BookmarkedNovel
 	if err := ctx.ShouldBindJSON(&bookmarkedNovel); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		utils.HandleError(ctx, errors.ErrInvalidBookmark)
 		return
 	}
 
@@ -70,7 +93,7 @@ func (b *BookmarkController) CreateBookmark(ctx *gin.Context) {
 
 	if err != nil {
 		log.Println(err)
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to save bookmarked novel to database"})
+		utils.HandleError(ctx, err)
 		return
 	}
 
@@ -82,14 +105,14 @@ func (b *BookmarkController) CreateBookmark(ctx *gin.Context) {
 func (b *BookmarkController) UpdateBookmark(ctx *gin.Context) {
 	var novel models.BookmarkedNovel
 	if err := ctx.ShouldBindJSON(&novel); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		utils.HandleError(ctx, errors.ErrInvalidBookmark)
 		return
 	}
 
 	updatedNovel, err := b.bookmarkService.UpdateBookmark(novel)
 
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		utils.HandleError(ctx, err)
 		return
 	}
 
@@ -2,177 +2,23 @@ package controllers
 
 import (
 	"backend/internal/dtos"
-	"backend/internal/models"
 	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
 	"backend/internal/utils"
-	"fmt"
 	"log"
 	"net/http"
 	"strconv"
-	"sync"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ChapterController struct {
-	chapterService interfaces.ChapterServiceInterface
-	novelService   interfaces.NovelServiceInterface
+	chapterService        interfaces.ChapterServiceInterface
+	readingHistoryService interfaces.ReadingHistoryServiceInterface
 }
 
-func NewChapterController(chapterService interfaces.ChapterServiceInterface, novelService interfaces.NovelServiceInterface) *ChapterController {
-	return &ChapterController{chapterService: chapterService, novelService: novelService}
-}
-
-// HandleImportChapters handles streaming response for importing chapters
-func (c *ChapterController) HandleImportChapters(ctx *gin.Context) {
-	idParam := ctx.Param("novel_id")
-
-	ctx.Writer.Header().Set("Content-Type", "text/event-stream")
-	ctx.Writer.Header().Set("Cache-Control", "no-cache")
-	ctx.Writer.Header().Set("Connection", "keep-alive")
-	ctx.Writer.Flush()
-
-	novel, err := c.novelService.GetNovelByUpdatesID(idParam)
-	if err != nil {
-		fmt.Fprintf(ctx.Writer, "event: error\ndata: %s\n\n", err.Error())
-		ctx.Writer.Flush()
-		return
-	}
-
-	err = c.processChaptersWithStreaming(ctx, idParam, novel.ID)
-	if err != nil {
-		fmt.Fprintf(ctx.Writer, "event: error\ndata: %s\n\n", err.Error())
-		ctx.Writer.Flush()
-		return
-	}
-}
-
-func (c *ChapterController) processChaptersWithStreaming(ctx *gin.Context, novelUpdatesID string, novelID uint) error {
-	const workerCount = 10
-	chapterCount := 0
-	chapterQueue := make(chan int, workerCount)
-	results := make(chan models.ImportedChapterMetadata)
-	errorsChan := make(chan dtos.ChapterStatus)
-	skippedChan := make(chan dtos.ChapterStatus)
-	statusUpdates := make(chan dtos.ChapterStatus) // New channel for status updates
-	done := make(chan struct{})
-
-	var wg sync.WaitGroup
-
-	// Fetch the latest chapter number before scraping
-	seriesInfo, err := c.novelService.GetNovelByUpdatesID(novelUpdatesID)
-	if err != nil {
-		fmt.Fprintf(ctx.Writer, "event: error\ndata: %s\n\n", err.Error())
-		ctx.Writer.Flush()
-		return err
-	}
-
-	latestChapter := seriesInfo.LatestChapter
-	if latestChapter == 0 {
-		fmt.Fprintf(ctx.Writer, "event: error\ndata: No chapters found\n\n")
-		ctx.Writer.Flush()
-		return fmt.Errorf("no chapters found")
-	}
-
-	// Initialize chapter statuses
-	chapterStatuses := make(map[any]string, latestChapter+2)
-	for i := 1; i <= latestChapter; i++ {
-		chapterStatuses[i] = "to download"
-	}
-
-	// Send initial status
-	fmt.Fprintf(ctx.Writer, "event: status\ndata: %s\n\n", utils.GetStatusJSON(chapterStatuses))
-	ctx.Writer.Flush()
-
-	// Worker goroutines
-	for i := 0; i < workerCount; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for chapterNo := range chapterQueue {
-				// Notify main loop that we're downloading this chapter
-				statusUpdates <- dtos.ChapterStatus{ChapterNo: chapterNo, Status: "downloading"}
-				log.Printf("Now importing chapter: %d", chapterNo)
-
-				// Check if chapter already exists
-				isChapterCreated := c.chapterService.IsChapterCreated(uint(chapterNo), novelID)
-				if isChapterCreated {
-					skippedChan <- dtos.ChapterStatus{ChapterNo: chapterNo, Status: "skipped"}
-					continue
-				}
-
-				// Import the chapter
-				result, err := c.chapterService.ImportChapter(novelUpdatesID, chapterNo)
-				if err != nil {
-					errorsChan <- dtos.ChapterStatus{ChapterNo: chapterNo, Status: "error", Message: err.Error()}
-					continue
-				}
-
-				results <- result
-			}
-		}()
-	}
-
-	// Populate queue with chapters to process
-	go func() {
-		defer close(chapterQueue)
-		for chapterNo := 1; chapterNo <= latestChapter; chapterNo++ {
-			select {
-			case <-done:
-				return
-			case chapterQueue <- chapterNo:
-				// Notify main loop that this chapter is queued
-				statusUpdates <- dtos.ChapterStatus{ChapterNo: chapterNo, Status: "in queue"}
-				log.Printf("Added chapter %d to queue", chapterNo)
-			}
-		}
-	}()
-
-	// Close channels when all workers finish
-	go func() {
-		wg.Wait()
-		close(results)
-		close(errorsChan)
-		close(skippedChan)
-		close(statusUpdates)
-	}()
-
-	// Main processing loop (single-threaded map updates)
-	for {
-		select {
-		case update := <-statusUpdates:
-			chapterStatuses[update.ChapterNo] = update.Status
-		case err := <-errorsChan:
-			chapterStatuses[err.ChapterNo] = err.Status
-			log.Printf("Error importing chapter %d: %s", err.ChapterNo, err.Status)
-		case skipped := <-skippedChan:
-			chapterCount++
-			chapterStatuses[skipped.ChapterNo] = skipped.Status
-			log.Printf("Chapter %d skipped (already exists)", skipped.ChapterNo)
-		case result, ok := <-results:
-			if !ok {
-				// All chapters processed
-				log.Printf("All chapters processed (total: %d)", chapterCount-2)
-				fmt.Fprintf(ctx.Writer, "event: complete\ndata: All %d chapters processed\n\n", chapterCount)
-				ctx.Writer.Flush()
-				return nil
-			}
-
-			// Save the chapter
-			if err := c.chapterService.CreateChapter(novelID, result); err != nil {
-				chapterStatuses[int(result.ID)] = "save error: " + err.Error()
-				log.Printf("Failed to save chapter %d: %v", result.ID, err)
-			} else {
-				chapterCount++
-				chapterStatuses[int(result.ID)] = "downloaded"
-				log.Printf("Successfully processed chapter %d (%d/%d)", result.ID, chapterCount, latestChapter)
-			}
-		}
-
-		// Send updated status (single-threaded, no race condition)
-		fmt.Fprintf(ctx.Writer, "event: status\ndata: %s\n\n", utils.GetStatusJSON(chapterStatuses))
-		ctx.Writer.Flush()
-	}
+func NewChapterController(chapterService interfaces.ChapterServiceInterface, readingHistoryService interfaces.ReadingHistoryServiceInterface) *ChapterController {
+	return &ChapterController{chapterService: chapterService, readingHistoryService: readingHistoryService}
 }
 
 func (c *ChapterController) GetChapterByNovelUpdatesIDAndChapterNo(ctx *gin.Context) {
@@ -191,6 +37,13 @@ func (c *ChapterController) GetChapterByNovelUpdatesIDAndChapterNo(ctx *gin.Cont
 		return
 	}
 
+	// Reading history is only kept for signed in readers, and failing to record it must not fail the read
+	if user, err := utils.GetAuthenticatedUser(ctx); err == nil {
+		if err := c.readingHistoryService.RecordChapterRead(user.ID, &chapter.Chapter); err != nil {
+			log.Printf("Failed to record the read of chapter %d of %s: %v", chapterNoUint, novelTitle, err)
+		}
+	}
+
 	ctx.JSON(http.StatusOK, chapter)
 }
 
@@ -228,3 +81,177 @@ func (c *ChapterController) GetChaptersByNovelUpdatesID(ctx *gin.Context) {
 		"totalPages": totalPages,
 	})
 }
+
+// InsertChapter stores a chapter written by hand.
+//
+// @Summary Insert a chapter
+// @Description Stores a chapter written by hand, such as one missing from every source. The chapters from its number onwards can be moved one number up to make room for it, otherwise the number must be free.
+// @Tags Chapters
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter body dtos.ChapterInsertRequest true "Chapter to insert"
+// @Success 201 {object} models.Chapter
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapters [post]
+func (c *ChapterController) InsertChapter(ctx *gin.Context) {
+	var request dtos.ChapterInsertRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidChapter)
+		return
+	}
+
+	chapter, err := c.chapterService.InsertChapter(ctx.Param("novel_title"), request.ChapterNo, request.Title, request.Body, request.Shift)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, chapter)
+}
+
+// DeleteChapter deletes a chapter.
+//
+// @Summary Delete a chapter
+// @Description Deletes a chapter of a novel. The following chapters keep their numbers.
+// @Tags Chapters
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter_no path int true "Chapter number"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapter/{chapter_no} [delete]
+func (c *ChapterController) DeleteChapter(ctx *gin.Context) {
+	chapterNo, err := utils.ParseUintID(ctx.Param("chapter_no"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if err := c.chapterService.DeleteChapter(ctx.Param("novel_title"), chapterNo); err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Chapter successfully deleted"})
+}
+
+// DeleteChapters deletes a range of chapters.
+//
+// @Summary Delete a range of chapters
+// @Description Deletes the chapters of a novel whose numbers are in a range, such as duplicates imported from a bad source. The following chapters keep their numbers.
+// @Tags Chapters
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param from query int true "First chapter of the range"
+// @Param to query int true "Last chapter of the range"
+// @Success 200 {object} dtos.ChapterCountResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapters [delete]
+func (c *ChapterController) DeleteChapters(ctx *gin.Context) {
+	from, err := parseChapterQuery(ctx, "from")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	to, err := parseChapterQuery(ctx, "to")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if from < 0 || to < 0 {
+		utils.HandleError(ctx, errors.ErrInvalidChapterRange)
+		return
+	}
+
+	deleted, err := c.chapterService.DeleteChapters(ctx.Param("novel_title"), uint(from), uint(to))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.ChapterCountResponse{Message: "Chapters successfully deleted", Count: deleted})
+}
+
+// RenumberChapters moves a range of chapters to other numbers.
+//
+// @Summary Renumber a range of chapters
+// @Description Adds an offset to the numbers of the chapters of a novel in a range, such as to make room for a side story the source inserted. The range runs to the last chapter when its end is left out, and fails if chapters outside it already have the new numbers.
+// @Tags Chapters
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param renumbering body dtos.ChapterRenumberRequest true "Chapters to renumber"
+// @Success 200 {object} dtos.ChapterCountResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapters/renumber [post]
+func (c *ChapterController) RenumberChapters(ctx *gin.Context) {
+	var request dtos.ChapterRenumberRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidChapterRenumbering)
+		return
+	}
+
+	renumbered, err := c.chapterService.RenumberChapters(ctx.Param("novel_title"), request.From, request.To, request.Offset)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.ChapterCountResponse{Message: "Chapters successfully renumbered", Count: renumbered})
+}
+
+// GetTableOfContents retrieves the table of contents of a novel.
+//
+// @Summary Get the table of contents of a novel
+// @Description Retrieves the chapters of a novel without their bodies, in ascending order of chapter number. The table of contents is paginated by cursor: each page returns the cursor of the next one, left out on the last page.
+// @Tags Chapters
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param cursor query string false "Cursor returned with the previous page"
+// @Param limit query int false "Chapters per page (10 to 100, 100 by default)"
+// @Success 200 {object} dtos.CursorPaginatedResponse{data=[]dtos.ChapterTOCEntry}
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Router /novels/chapters/novel/{novel_title}/toc [get]
+func (c *ChapterController) GetTableOfContents(ctx *gin.Context) {
+	limit := utils.MaxLimit
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		var err error
+		if limit, err = utils.ParseLimit(limitStr); err != nil {
+			utils.HandleError(ctx, err)
+			return
+		}
+	}
+
+	cursor := ctx.Query("cursor")
+	entries, nextCursor, err := c.chapterService.GetTableOfContents(ctx.Param("novel_title"), cursor, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if len(entries) == 0 && cursor == "" {
+		utils.HandleError(ctx, errors.ErrNoChapters)
+		return
+	}
+
+	utils.BuildCursorPaginatedResponse(ctx, entries, nextCursor, limit)
+}
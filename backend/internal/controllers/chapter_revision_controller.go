@@ -0,0 +1,252 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/services/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChapterRevisionController struct manages the revisions of the content of the chapters.
+//
+// Fields:
+//   - chapterRevisionService (interfaces.ChapterRevisionServiceInterface): An interface that provides access to the
+//     chapter revisions.
+type ChapterRevisionController struct {
+	chapterRevisionService interfaces.ChapterRevisionServiceInterface
+}
+
+// NewChapterRevisionController creates a new ChapterRevisionController instance.
+//
+// Parameters:
+//   - chapterRevisionService (interfaces.ChapterRevisionServiceInterface): The chapter revision service to be used by
+//     the controller.
+//
+// Returns:
+//   - *ChapterRevisionController: A pointer to the newly created ChapterRevisionController.
+func NewChapterRevisionController(chapterRevisionService interfaces.ChapterRevisionServiceInterface) *ChapterRevisionController {
+	return &ChapterRevisionController{chapterRevisionService: chapterRevisionService}
+}
+
+// RescrapeChapter scrapes a chapter again and stores the result as a new revision.
+//
+// @Summary Re-scrape a chapter
+// @Description Scrapes a chapter again and stores the result as a new revision. The sources are tried in the priority of the novel unless a source is given. The content served to readers doesn't change until the revision is made current, which can be done right away to force a re-import.
+// @Tags Chapter Revisions
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter_no path int true "Chapter number"
+// @Param source query string false "Only source to scrape the chapter from"
+// @Param current query bool false "Make the new revision current"
+// @Success 201 {object} models.ChapterRevision
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 502 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapter/{chapter_no}/rescrape [post]
+func (c *ChapterRevisionController) RescrapeChapter(ctx *gin.Context) {
+	chapterNo, err := utils.ParseUintID(ctx.Param("chapter_no"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	makeCurrent := false
+	if current := ctx.Query("current"); current != "" {
+		makeCurrent, err = strconv.ParseBool(current)
+		if err != nil {
+			utils.HandleError(ctx, types.WrapError(errors.INVALID_CHAPTER_REVISION, "Invalid current parameter", http.StatusBadRequest, err))
+			return
+		}
+	}
+
+	revision, err := c.chapterRevisionService.RescrapeChapter(ctx.Param("novel_title"), chapterNo, ctx.Query("source"), makeCurrent)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, revision)
+}
+
+// GetChapterRevisions retrieves the revisions of a chapter.
+//
+// @Summary Get chapter revisions
+// @Description Retrieves the revisions of a chapter, ordered by revision number, marking the one served to readers as current.
+// @Tags Chapter Revisions
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter_no path int true "Chapter number"
+// @Success 200 {array} models.ChapterRevision
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Router /novels/chapters/novel/{novel_title}/chapter/{chapter_no}/revisions [get]
+func (c *ChapterRevisionController) GetChapterRevisions(ctx *gin.Context) {
+	chapterNo, err := utils.ParseUintID(ctx.Param("chapter_no"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	revisions, err := c.chapterRevisionService.GetChapterRevisions(ctx.Param("novel_title"), chapterNo)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
+// SetCurrentChapterRevision makes a revision the content of a chapter served to readers.
+//
+// @Summary Set the current chapter revision
+// @Description Makes a revision the content of a chapter served to readers.
+// @Tags Chapter Revisions
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter_no path int true "Chapter number"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} models.ChapterRevision
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapter/{chapter_no}/revisions/{revision}/current [put]
+func (c *ChapterRevisionController) SetCurrentChapterRevision(ctx *gin.Context) {
+	chapterNo, err := utils.ParseUintID(ctx.Param("chapter_no"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	revisionNo, err := utils.ParseInt(ctx.Param("revision"))
+	if err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidChapterRevision)
+		return
+	}
+
+	revision, err := c.chapterRevisionService.SetCurrentChapterRevision(ctx.Param("novel_title"), chapterNo, revisionNo)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision)
+}
+
+// DiffChapterRevisions compares two revisions of a chapter paragraph by paragraph.
+//
+// @Summary Diff chapter revisions
+// @Description Compares two revisions of a chapter paragraph by paragraph, marking each paragraph as unchanged, added or removed. Compares the current revision with the latest one by default.
+// @Tags Chapter Revisions
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter_no path int true "Chapter number"
+// @Param from query int false "Older revision (default: current revision)"
+// @Param to query int false "Newer revision (default: latest revision)"
+// @Success 200 {object} dtos.ChapterRevisionDiff
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Router /novels/chapters/novel/{novel_title}/chapter/{chapter_no}/revisions/diff [get]
+func (c *ChapterRevisionController) DiffChapterRevisions(ctx *gin.Context) {
+	chapterNo, err := utils.ParseUintID(ctx.Param("chapter_no"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	from, err := parseRevisionQuery(ctx, "from")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	to, err := parseRevisionQuery(ctx, "to")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	diff, err := c.chapterRevisionService.DiffChapterRevisions(ctx.Param("novel_title"), chapterNo, from, to)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, diff)
+}
+
+// parseRevisionQuery parses an optional revision number query parameter.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - key (string): The name of the query parameter.
+//
+// Returns:
+//   - int: The revision number, 0 if the parameter is missing.
+//   - error: errors.ErrInvalidChapterRevision if the parameter is not a positive number.
+func parseRevisionQuery(ctx *gin.Context, key string) (int, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	revision, err := utils.ParseInt(value)
+	if err != nil {
+		return 0, errors.ErrInvalidChapterRevision
+	}
+	return revision, nil
+}
+
+// EditChapter corrects the title or the body of a chapter.
+//
+// @Summary Edit a chapter
+// @Description Stores the given title or body as a new manual revision of a chapter and makes it the content served to readers. The previous content stays available as an older revision.
+// @Tags Chapter Revisions
+// @Accept json
+// @Produce json
+// @Param novel_title path string true "NovelUpdatesID"
+// @Param chapter_no path int true "Chapter number"
+// @Param chapter body dtos.ChapterEditRequest true "Edited chapter content"
+// @Success 200 {object} models.ChapterRevision
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/novel/{novel_title}/chapter/{chapter_no} [put]
+func (c *ChapterRevisionController) EditChapter(ctx *gin.Context) {
+	chapterNo, err := utils.ParseUintID(ctx.Param("chapter_no"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.ChapterEditRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidChapter)
+		return
+	}
+
+	revision, err := c.chapterRevisionService.EditChapter(ctx.Param("novel_title"), chapterNo, request.Title, request.Body)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision)
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"backend/internal/services/interfaces"
+	"backend/internal/utils"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EPUBExportController struct manages the export of novels as EPUB files.
+//
+// Fields:
+//   - epubExportService (interfaces.EPUBExportServiceInterface): An interface that provides the EPUB export.
+type EPUBExportController struct {
+	epubExportService interfaces.EPUBExportServiceInterface
+}
+
+// NewEPUBExportController creates a new EPUBExportController instance.
+//
+// Parameters:
+//   - epubExportService (interfaces.EPUBExportServiceInterface): The EPUB export service to be used by the controller.
+//
+// Returns:
+//   - *EPUBExportController: A pointer to the newly created EPUBExportController.
+func NewEPUBExportController(epubExportService interfaces.EPUBExportServiceInterface) *EPUBExportController {
+	return &EPUBExportController{epubExportService: epubExportService}
+}
+
+// ExportNovelToEPUB streams an EPUB of a range of the stored chapters of a novel.
+//
+// @Summary Export a novel as an EPUB
+// @Description Streams an EPUB 3 built from the metadata of a novel (title, authors, synopsis and cover) and its stored chapters in a range, with a table of contents. The range defaults to all the stored chapters.
+// @Tags Novels
+// @Produce application/epub+zip
+// @Param novel_id path int true "Novel ID"
+// @Param from query int false "First chapter of the range (default: 1)"
+// @Param to query int false "Last chapter of the range (default: last stored chapter)"
+// @Success 200 {file} file
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /novels/{novel_id}/export.epub [get]
+func (e *EPUBExportController) ExportNovelToEPUB(ctx *gin.Context) {
+	novelID, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	from, err := parseChapterQuery(ctx, "from")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	to, err := parseChapterQuery(ctx, "to")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	export, err := e.epubExportService.PrepareEPUBExport(novelID, from, to)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "application/epub+zip")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename))
+	ctx.Status(http.StatusOK)
+
+	// The response has already started, an error can only be logged and leaves the client with a truncated file
+	if err := e.epubExportService.WriteEPUB(ctx.Writer, export); err != nil {
+		log.Printf("Failed to export novel %d as EPUB: %v", novelID, err)
+	}
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"backend/internal/services/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxEPUBSize is the largest EPUB file that can be uploaded.
+const maxEPUBSize = 50 << 20
+
+// EPUBImportController struct manages the import of novels from uploaded EPUB files.
+//
+// Fields:
+//   - epubImportService (interfaces.EPUBImportServiceInterface): An interface that provides the EPUB import.
+type EPUBImportController struct {
+	epubImportService interfaces.EPUBImportServiceInterface
+}
+
+// NewEPUBImportController creates a new EPUBImportController instance.
+//
+// Parameters:
+//   - epubImportService (interfaces.EPUBImportServiceInterface): The EPUB import service to be used by the controller.
+//
+// Returns:
+//   - *EPUBImportController: A pointer to the newly created EPUBImportController.
+func NewEPUBImportController(epubImportService interfaces.EPUBImportServiceInterface) *EPUBImportController {
+	return &EPUBImportController{epubImportService: epubImportService}
+}
+
+// ImportNovelFromEPUB imports a novel from an uploaded EPUB file.
+//
+// @Summary Import a novel from an EPUB
+// @Description Creates a novel from the metadata of an EPUB (title, authors, language, cover and subjects) and stores each document of its reading order as a chapter, reporting the result of each one. Uploading the same file again keeps the chapters already stored, and a novel imported from its source is never replaced.
+// @Tags Novels
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "EPUB file"
+// @Param novel_updates_id formData string false "ID of the novel (default: derived from the title)"
+// @Success 201 {object} dtos.EPUBImportReport
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 413 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/epub [post]
+func (e *EPUBImportController) ImportNovelFromEPUB(ctx *gin.Context) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		utils.HandleError(ctx, errors.ErrEPUBRequired)
+		return
+	}
+	if fileHeader.Size > maxEPUBSize {
+		utils.HandleError(ctx, errors.ErrEPUBTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.HandleError(ctx, types.WrapError(errors.INVALID_EPUB, "Failed to read the uploaded file", http.StatusBadRequest, err))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxEPUBSize))
+	if err != nil {
+		utils.HandleError(ctx, types.WrapError(errors.INVALID_EPUB, "Failed to read the uploaded file", http.StatusBadRequest, err))
+		return
+	}
+
+	report, err := e.epubImportService.ImportNovelFromEPUB(data, ctx.PostForm("novel_updates_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, report)
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/services/interfaces"
+	"backend/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeedController struct serves the Atom feeds of new chapters users subscribe to from feed readers.
+//
+// Fields:
+//   - feedService (interfaces.FeedServiceInterface): An interface that builds the feeds and manages their tokens.
+type FeedController struct {
+	feedService interfaces.FeedServiceInterface
+}
+
+// NewFeedController creates a new FeedController instance.
+//
+// Parameters:
+//   - feedService (interfaces.FeedServiceInterface): The feed service to be used by the controller.
+//
+// Returns:
+//   - *FeedController: A pointer to the newly created FeedController.
+func NewFeedController(feedService interfaces.FeedServiceInterface) *FeedController {
+	return &FeedController{feedService: feedService}
+}
+
+// GetNovelFeed serves the Atom feed of the latest chapters of a novel.
+//
+// @Summary Get the feed of a novel
+// @Description Serves the Atom feed of the most recently stored chapters of a novel, newest first.
+// @Tags Feeds
+// @Produce xml
+// @Param novel_id path int true "Novel ID"
+// @Param limit query int false "Number of chapters (default: 10)"
+// @Success 200 {object} dtos.AtomFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /novels/{novel_id}/feed.atom [get]
+func (f *FeedController) GetNovelFeed(ctx *gin.Context) {
+	novelID, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	feed, err := f.feedService.GetNovelFeed(novelID, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	writeXML(ctx, dtos.AtomFeedType, feed)
+}
+
+// GetLibraryFeed serves the Atom feed of the latest chapters of the novels bookmarked by a user.
+//
+// @Summary Get the feed of a library
+// @Description Serves the Atom feed of the most recently stored chapters of the novels bookmarked by the user the feed token belongs to, newest first. Feed readers can't log in, so the token authenticates the request.
+// @Tags Feeds
+// @Produce xml
+// @Param token query string true "Feed token"
+// @Param limit query int false "Number of chapters (default: 10)"
+// @Success 200 {object} dtos.AtomFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /novels/bookmarked/feed.atom [get]
+func (f *FeedController) GetLibraryFeed(ctx *gin.Context) {
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	feed, err := f.feedService.GetLibraryFeed(ctx.Query("token"), limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	writeXML(ctx, dtos.AtomFeedType, feed)
+}
+
+// GetFeedToken gets the feed token of the authenticated user, creating it the first time.
+//
+// @Summary Get the feed token
+// @Description Gets the token of the feed of the library of the authenticated user and the URL to subscribe to, creating the token the first time.
+// @Tags Feeds
+// @Produce json
+// @Success 200 {object} dtos.FeedTokenResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /user/feed-token [get]
+func (f *FeedController) GetFeedToken(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	response, err := f.feedService.GetFeedToken(user.ID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// RegenerateFeedToken replaces the feed token of the authenticated user.
+//
+// @Summary Regenerate the feed token
+// @Description Replaces the token of the feed of the library of the authenticated user, so the feed readers subscribed with the previous one lose access.
+// @Tags Feeds
+// @Produce json
+// @Success 200 {object} dtos.FeedTokenResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /user/feed-token [post]
+func (f *FeedController) RegenerateFeedToken(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	response, err := f.feedService.RegenerateFeedToken(user.ID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
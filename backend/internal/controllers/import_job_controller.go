@@ -0,0 +1,418 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImportJobController struct manages chapter import jobs.
+//
+// Fields:
+//   - importJobService (interfaces.ImportJobServiceInterface): An interface that provides access to the import jobs.
+type ImportJobController struct {
+	importJobService interfaces.ImportJobServiceInterface
+}
+
+// NewImportJobController creates a new ImportJobController instance.
+//
+// Parameters:
+//   - importJobService (interfaces.ImportJobServiceInterface): The import job service to be used by the controller.
+//
+// Returns:
+//   - *ImportJobController: A pointer to the newly created ImportJobController.
+func NewImportJobController(importJobService interfaces.ImportJobServiceInterface) *ImportJobController {
+	return &ImportJobController{importJobService: importJobService}
+}
+
+// StartImportJob starts a background job importing a range of chapters of a novel.
+//
+// @Summary Start a chapter import job
+// @Description Starts a background job importing a range of chapters of a novel. The range defaults to all the chapters of the novel. A delta job only imports the chapters of the range that are not stored yet.
+// @Tags Import Jobs
+// @Accept json
+// @Produce json
+// @Param novel_id path string true "NovelUpdatesID"
+// @Param range body dtos.ImportJobRequest false "Chapter range"
+// @Success 201 {object} models.ImportJob
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/chapters/{novel_id}/import-jobs [post]
+func (i *ImportJobController) StartImportJob(ctx *gin.Context) {
+	novelUpdatesID := ctx.Param("novel_id")
+
+	var request dtos.ImportJobRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && err != io.EOF {
+		utils.HandleError(ctx, errors.ErrInvalidChapterRange)
+		return
+	}
+
+	job, err := i.importJobService.StartImportJob(novelUpdatesID, request.From, request.To, request.Delta)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, job)
+}
+
+// GetMissingChapters reports the chapters of a novel that are not stored yet.
+//
+// @Summary Get missing chapters
+// @Description Reports the chapters of a range of a novel that are not stored yet, separating the gaps below the latest stored chapter from the new chapters above it. The range defaults to all the chapters of the novel.
+// @Tags Import Jobs
+// @Accept json
+// @Produce json
+// @Param novel_id path string true "NovelUpdatesID"
+// @Param from query int false "First chapter of the range (default: 1)"
+// @Param to query int false "Last chapter of the range (default: latest chapter of the novel)"
+// @Success 200 {object} dtos.MissingChaptersResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Router /novels/chapters/{novel_id}/missing [get]
+func (i *ImportJobController) GetMissingChapters(ctx *gin.Context) {
+	novelUpdatesID := ctx.Param("novel_id")
+
+	from, err := parseChapterQuery(ctx, "from")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	to, err := parseChapterQuery(ctx, "to")
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	missing, err := i.importJobService.GetMissingChapters(novelUpdatesID, from, to)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, missing)
+}
+
+// GetImportJobs retrieves a paginated list of import jobs, newest first.
+//
+// @Summary Get import jobs
+// @Description Retrieves a paginated list of import jobs, newest first, without the outcome of their chapters.
+// @Tags Import Jobs
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.PaginatedResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/import-jobs/ [get]
+func (i *ImportJobController) GetImportJobs(ctx *gin.Context) {
+	// Parse parameters
+	page, err := utils.ParsePage(ctx.Query("page"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	jobs, total, err := i.importJobService.GetImportJobs(page, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Validate results
+	if total == 0 {
+		utils.HandleError(ctx, errors.ErrNoResults)
+		return
+	}
+
+	if utils.IsPageOutOfRange(page, total, limit) {
+		utils.HandleError(ctx, errors.ErrPageOutOfRange)
+		return
+	}
+
+	// Build response
+	utils.BuildPaginatedResponse(ctx, jobs, total, page, limit)
+}
+
+// GetImportJob retrieves an import job and the outcome of each of its chapters.
+//
+// @Summary Get import job
+// @Description Retrieves an import job and the status, attempts, last error and error class of each of its chapters.
+// @Tags Import Jobs
+// @Accept json
+// @Produce json
+// @Param job_id path int true "Import job ID"
+// @Success 200 {object} models.ImportJob
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/import-jobs/{job_id} [get]
+func (i *ImportJobController) GetImportJob(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("job_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	job, err := i.importJobService.GetImportJobByID(id)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
+
+// CancelImportJob cancels a queued or running import job.
+//
+// @Summary Cancel import job
+// @Description Cancels a queued or running import job. Chapters being imported are finished before the job stops.
+// @Tags Import Jobs
+// @Accept json
+// @Produce json
+// @Param job_id path int true "Import job ID"
+// @Success 200 {object} models.ImportJob
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/import-jobs/{job_id}/cancel [post]
+func (i *ImportJobController) CancelImportJob(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("job_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	job, err := i.importJobService.CancelImportJob(id)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
+
+// ResumeImportJob runs a finished import job again for the chapters that were not imported.
+//
+// @Summary Resume import job
+// @Description Runs a cancelled, failed or completed import job again, importing only the chapters that were not downloaded or skipped.
+// @Tags Import Jobs
+// @Accept json
+// @Produce json
+// @Param job_id path int true "Import job ID"
+// @Success 200 {object} models.ImportJob
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/import-jobs/{job_id}/resume [post]
+func (i *ImportJobController) ResumeImportJob(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("job_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	job, err := i.importJobService.ResumeImportJob(id)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
+
+// StreamImportJob streams the progress of an import job as server-sent events until the job stops.
+//
+// @Summary Stream import job
+// @Description Streams the progress of an import job as server-sent events: a status event with the status of every chapter each time one changes, a chapter event for each change and for the chapters that already failed, and a complete or error event once the job stops running.
+// @Tags Import Jobs
+// @Produce text/event-stream
+// @Param job_id path int true "Import job ID"
+// @Success 200 {string} string "Server-sent events"
+// @Failure 400 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/import-jobs/{job_id}/events [get]
+func (i *ImportJobController) StreamImportJob(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("job_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	startSSE(ctx)
+	i.streamImportJob(ctx, id)
+}
+
+// HandleImportChapters handles streaming response for importing chapters. It subscribes to the active import job of
+// the novel, starting one for all its chapters if there is none, so closing the stream doesn't stop the import. With
+// delta=true the started job only imports the chapters that are not stored yet. Since it can start a job, it's
+// restricted like StartImportJob, and StreamImportJob follows a job without starting one.
+func (i *ImportJobController) HandleImportChapters(ctx *gin.Context) {
+	novelUpdatesID := ctx.Param("novel_id")
+	delta := ctx.Query("delta") == "true"
+
+	startSSE(ctx)
+
+	job, err := i.importJobService.GetOrStartImportJob(novelUpdatesID, delta)
+	if err != nil {
+		sendSSEError(ctx, err.Error())
+		return
+	}
+
+	i.streamImportJob(ctx, job.ID)
+}
+
+// streamImportJob sends the status of every chapter of a job each time one changes, and a complete or error event
+// once the job stops running. Each change is also sent as a chapter event carrying the error class of failed chapters
+// and whether importing them again may work, which is sent for the chapters that already failed too.
+func (i *ImportJobController) streamImportJob(ctx *gin.Context, id uint) {
+	// Subscribe before loading the job so no update is missed
+	events, unsubscribe := i.importJobService.Subscribe(id)
+	defer unsubscribe()
+
+	job, err := i.importJobService.GetImportJobByID(id)
+	if err != nil {
+		sendSSEError(ctx, err.Error())
+		return
+	}
+
+	chapterStatuses := getChapterStatuses(job)
+	sendSSEStatus(ctx, chapterStatuses)
+	for _, chapter := range job.Chapters {
+		if chapter.ErrorCode != "" {
+			sendSSEChapter(ctx, dtos.ChapterStatus{
+				ChapterNo: chapter.ChapterNo,
+				Status:    chapter.Status,
+				Message:   chapter.Message,
+				ErrorCode: chapter.ErrorCode,
+				Retryable: chapter.Retryable,
+			})
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				// The job stopped, send its final state since events may have been dropped
+				job, err = i.importJobService.GetImportJobByID(id)
+				if err != nil {
+					sendSSEError(ctx, err.Error())
+					return
+				}
+
+				sendSSEStatus(ctx, getChapterStatuses(job))
+				sendImportJobResult(ctx, job)
+				return
+			}
+
+			if event.Chapter != nil {
+				chapterStatuses[event.Chapter.ChapterNo] = event.Chapter.Status
+				sendSSEStatus(ctx, chapterStatuses)
+				sendSSEChapter(ctx, *event.Chapter)
+			}
+		}
+	}
+}
+
+// startSSE sets the headers of a server-sent events response.
+func startSSE(ctx *gin.Context) {
+	ctx.Writer.Header().Set("Content-Type", "text/event-stream")
+	ctx.Writer.Header().Set("Cache-Control", "no-cache")
+	ctx.Writer.Header().Set("Connection", "keep-alive")
+	ctx.Writer.Header().Set("X-Accel-Buffering", "no")
+	ctx.Writer.Flush()
+}
+
+// sendSSEChapter sends the status of a single chapter as a chapter event.
+func sendSSEChapter(ctx *gin.Context, chapter dtos.ChapterStatus) {
+	data, err := json.Marshal(chapter)
+	if err != nil {
+		log.Printf("Failed to marshal chapter status: %v", err)
+		return
+	}
+
+	fmt.Fprintf(ctx.Writer, "event: chapter\ndata: %s\n\n", data)
+	ctx.Writer.Flush()
+}
+
+// getChapterStatuses maps the number of each chapter of a job to its status.
+func getChapterStatuses(job *models.ImportJob) map[any]string {
+	chapterStatuses := make(map[any]string, len(job.Chapters))
+	for _, chapter := range job.Chapters {
+		chapterStatuses[chapter.ChapterNo] = chapter.Status
+	}
+	return chapterStatuses
+}
+
+// sendImportJobResult sends the event matching the final state of a job.
+func sendImportJobResult(ctx *gin.Context, job *models.ImportJob) {
+	switch job.State {
+	case models.ImportJobCompleted:
+		processed := 0
+		for _, chapter := range job.Chapters {
+			if models.IsChapterDone(chapter.Status) {
+				processed++
+			}
+		}
+		sendSSEComplete(ctx, fmt.Sprintf("All %d chapters processed", processed))
+	case models.ImportJobCancelled:
+		sendSSEError(ctx, errors.ErrImportJobCancelled.Error())
+	case models.ImportJobFailed:
+		sendSSEError(ctx, job.Error)
+	default:
+		sendSSEError(ctx, "Import job interrupted")
+	}
+}
+
+// parseChapterQuery parses an optional chapter number query parameter.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - key (string): The name of the query parameter.
+//
+// Returns:
+//   - int: The chapter number, 0 if the parameter is missing.
+//   - error: errors.ErrInvalidChapterRange if the parameter is not a positive number.
+func parseChapterQuery(ctx *gin.Context, key string) (int, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	chapterNo, err := utils.ParseInt(value)
+	if err != nil {
+		return 0, errors.ErrInvalidChapterRange
+	}
+	return chapterNo, nil
+}
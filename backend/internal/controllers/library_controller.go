@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/permissions"
+	"backend/internal/services/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxLibrarySize is the largest library file that can be imported.
+const maxLibrarySize = 5 << 20
+
+// LibraryController struct manages the export and the import of the library of the authenticated user.
+//
+// Fields:
+//   - libraryService (interfaces.LibraryServiceInterface): An interface that exports and imports the libraries.
+type LibraryController struct {
+	libraryService interfaces.LibraryServiceInterface
+}
+
+// NewLibraryController creates a new LibraryController instance.
+//
+// Parameters:
+//   - libraryService (interfaces.LibraryServiceInterface): The library service to be used by the controller.
+//
+// Returns:
+//   - *LibraryController: A pointer to the newly created LibraryController.
+func NewLibraryController(libraryService interfaces.LibraryServiceInterface) *LibraryController {
+	return &LibraryController{libraryService: libraryService}
+}
+
+// ExportLibrary exports the library of the authenticated user.
+//
+// @Summary Export the library
+// @Description Downloads the bookmarked novels of the authenticated user (novel ID, NovelUpdates ID, title, status, score and current chapter) as JSON or CSV, in the order they were bookmarked.
+// @Tags Library
+// @Produce json
+// @Produce text/csv
+// @Param format query string false "Format of the file, json or csv (default: json)"
+// @Success 200 {array} dtos.LibraryRow
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/library/export [get]
+func (l *LibraryController) ExportLibrary(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		utils.HandleError(ctx, errors.ErrInvalidLibraryFormat)
+		return
+	}
+
+	rows, err := l.libraryService.ExportLibrary(user.ID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="library.`+format+`"`)
+	if format == "json" {
+		ctx.JSON(http.StatusOK, rows)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := utils.WriteLibraryCSV(&buf, rows); err != nil {
+		utils.HandleError(ctx, types.WrapError(errors.EXPORTING_LIBRARY, "Failed to export the library", http.StatusInternalServerError, err))
+		return
+	}
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// ImportLibrary imports a library into the library of the authenticated user.
+//
+// @Summary Import a library
+// @Description Bookmarks the novels of a JSON or CSV library, sent as the body or as an uploaded file, such as one exported by this API or by another tracker. Each row is matched by its NovelUpdates ID (or the URL of its NovelUpdates page), or else by its title, and novels already bookmarked are updated. CSV files need a header, and the columns are matched by name (novel_updates_id or url, title or name, status, score or rating, current_chapter or progress). Unknown novels can be imported from NovelUpdates, up to 20 per import, by the users allowed to create novels. A row that fails doesn't stop the others, and the result of each row is reported.
+// @Tags Library
+// @Accept json
+// @Accept text/csv
+// @Accept multipart/form-data
+// @Produce json
+// @Param format query string false "Format of the file, json or csv (default: json)"
+// @Param create query bool false "Import the unknown novels from NovelUpdates, needs the novels:create permission (default: false)"
+// @Param file formData file false "Library file, instead of the body"
+// @Success 200 {object} dtos.LibraryImportReport
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 403 {object} dtos.ErrorResponse
+// @Failure 413 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/library/import [post]
+func (l *LibraryController) ImportLibrary(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		utils.HandleError(ctx, errors.ErrInvalidLibraryFormat)
+		return
+	}
+
+	createNovels := false
+	if create := ctx.Query("create"); create != "" {
+		createNovels, err = strconv.ParseBool(create)
+		if err != nil {
+			utils.HandleError(ctx, types.WrapError(errors.INVALID_LIBRARY_FILE, "Invalid create parameter", http.StatusBadRequest, err))
+			return
+		}
+	}
+
+	// Importing unknown novels scrapes NovelUpdates, which is restricted like importing a novel directly
+	if createNovels && !permissions.HasPermission(*user, "novels", "create", nil) {
+		utils.HandleError(ctx, errors.ErrLibraryCreateForbidden)
+		return
+	}
+
+	data, err := readLibraryFile(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var rows []dtos.LibraryRow
+	if format == "csv" {
+		rows, err = utils.ReadLibraryCSV(bytes.NewReader(data))
+	} else if err = json.Unmarshal(data, &rows); err != nil {
+		err = types.WrapError(errors.INVALID_LIBRARY_FILE, "Invalid library file: "+err.Error(), http.StatusBadRequest, err)
+	}
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	report, err := l.libraryService.ImportLibrary(user.ID, rows, createNovels)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+// readLibraryFile reads the library being imported from the uploaded file, or else from the body of the request.
+func readLibraryFile(ctx *gin.Context) ([]byte, error) {
+	body := io.Reader(ctx.Request.Body)
+	if ctx.ContentType() == "multipart/form-data" {
+		fileHeader, err := ctx.FormFile("file")
+		if err != nil {
+			return nil, errors.ErrInvalidLibraryFile
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, types.WrapError(errors.INVALID_LIBRARY_FILE, "Failed to read the uploaded file", http.StatusBadRequest, err)
+		}
+		defer file.Close()
+		body = file
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxLibrarySize+1))
+	if err != nil {
+		return nil, types.WrapError(errors.INVALID_LIBRARY_FILE, "Failed to read the library file", http.StatusBadRequest, err)
+	}
+	if len(data) > maxLibrarySize {
+		return nil, errors.ErrLibraryImportTooLarge
+	}
+	return data, nil
+}
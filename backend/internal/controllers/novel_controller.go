@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"backend/internal/dtos"
+	"backend/internal/models"
 	"backend/internal/services/interfaces"
 	"backend/internal/types/errors"
 	"backend/internal/utils"
@@ -10,6 +11,7 @@ import (
 
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -237,15 +239,30 @@ func (n *NovelController) GetNovelsByTagName(ctx *gin.Context) {
 	utils.BuildPaginatedResponse(ctx, novels, total, page, limit)
 }
 
-// GetNovels retrieves a paginated list of novels.
+// GetNovels retrieves a paginated list of novels, filtered and sorted by the query parameters.
 //
 // @Summary Get novels
-// @Description Retrieves a paginated list of novels.
+// @Description Retrieves a paginated list of novels. With the cursor parameter, the pages continue after the last novel of the previous one and are returned as a dtos.CursorPaginatedResponse, which is not limited to 1000 pages. The tags, genres and authors can be repeated or comma-separated, and are matched ignoring case, e.g. "?tags=Cultivation,System&exclude_tags=Harem&status=completed&min_chapters=500".
 // @Tags Novels
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number (default: 1)"
 // @Param limit query int false "Number of items per page (default: 10)"
+// @Param tags query []string false "Tags the novels must have" collectionFormat(multi)
+// @Param exclude_tags query []string false "Tags the novels must not have" collectionFormat(multi)
+// @Param genres query []string false "Genres the novels must have" collectionFormat(multi)
+// @Param exclude_genres query []string false "Genres the novels must not have" collectionFormat(multi)
+// @Param authors query []string false "Authors the novels must be by" collectionFormat(multi)
+// @Param exclude_authors query []string false "Authors the novels must not be by" collectionFormat(multi)
+// @Param match query string false "Whether the novels must have all the tags, genres and authors of each kind, or any of them (default: all)" Enums(all, any)
+// @Param status query string false "Status of the novels"
+// @Param language query string false "Language of the novels"
+// @Param year_from query int false "First year of the novels"
+// @Param year_to query int false "Last year of the novels"
+// @Param min_chapters query int false "Minimum latest chapter of the novels"
+// @Param sort query string false "Sort of the novels, popularity being their number of bookmarks (default: the order they were added)" Enums(title, created, updated, latest_chapter, year, popularity)
+// @Param order query string false "Order of the novels (default: asc)" Enums(asc, desc)
+// @Param cursor query string false "Paginate by cursor instead of by page: empty for the first page, then the nextCursor of the previous page"
 // @Success 200 {object} dtos.PaginatedResponse
 // @Failure 400 {object} dtos.ErrorResponse
 // @Failure 404 {object} dtos.ErrorResponse
@@ -266,8 +283,30 @@ func (n *NovelController) GetNovels(ctx *gin.Context) {
 		return
 	}
 
+	query, err := parseNovelQuery(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if cursor, ok := ctx.GetQuery("cursor"); ok {
+		novels, nextCursor, err := n.novelService.GetNovelsPage(query, cursor, limit)
+		if err != nil {
+			utils.HandleError(ctx, err)
+			return
+		}
+
+		if len(novels) == 0 && cursor == "" {
+			utils.HandleError(ctx, errors.ErrNoResults)
+			return
+		}
+
+		utils.BuildCursorPaginatedResponse(ctx, novels, nextCursor, limit)
+		return
+	}
+
 	// Get data
-	novels, total, err := n.novelService.GetNovels(page, limit)
+	novels, total, err := n.novelService.GetNovelsByQuery(query, page, limit)
 	if err != nil {
 		utils.HandleError(ctx, err)
 		return
@@ -319,6 +358,172 @@ func (n *NovelController) GetNovelByID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, novel)
 }
 
+// GetNovelSourcePriority retrieves the source priority of a novel.
+//
+// @Summary Get novel source priority
+// @Description Retrieves the sources the chapters of a novel are fetched from first, the resulting order and the available sources.
+// @Tags Novels
+// @Accept json
+// @Produce json
+// @Param novel_id path string true "Novel ID"
+// @Success 200 {object} dtos.SourcePriorityResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /novels/{novel_id}/sources [get]
+func (n *NovelController) GetNovelSourcePriority(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	priority, err := n.novelService.GetNovelSourcePriority(id)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, priority)
+}
+
+// UpdateNovelSourcePriority changes the source priority of a novel.
+//
+// @Summary Update novel source priority
+// @Description Changes the sources the chapters of a novel are fetched from first, in order. The other sources are still tried afterwards. An empty list restores the default order.
+// @Tags Novels
+// @Accept json
+// @Produce json
+// @Param novel_id path string true "Novel ID"
+// @Param priority body dtos.SourcePriorityRequest true "Source priority"
+// @Success 200 {object} dtos.SourcePriorityResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/{novel_id}/sources [put]
+func (n *NovelController) UpdateNovelSourcePriority(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.SourcePriorityRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidSourcePriority)
+		return
+	}
+
+	priority, err := n.novelService.UpdateNovelSourcePriority(id, request.Sources)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, priority)
+}
+
+// UpdateNovel replaces the metadata of a novel.
+//
+// @Summary Replace novel metadata
+// @Description Replaces every editable field of a novel: title, synopsis, cover, language, status, year, release frequency, tags, genres and authors. The fields are locked so the imports of the novel don't overwrite them, unless the locked fields are given.
+// @Tags Novels
+// @Accept json
+// @Produce json
+// @Param novel_id path string true "Novel ID"
+// @Param novel body dtos.NovelUpdateRequest true "Novel metadata"
+// @Success 200 {object} models.Novel
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/{novel_id} [put]
+func (n *NovelController) UpdateNovel(ctx *gin.Context) {
+	n.updateNovel(ctx, true)
+}
+
+// PatchNovel edits some of the metadata of a novel.
+//
+// @Summary Edit novel metadata
+// @Description Edits the given fields of a novel, leaving the others as they are. The edited fields are locked so the imports of the novel don't overwrite them, unless the locked fields are given. Giving only the locked fields unlocks the others.
+// @Tags Novels
+// @Accept json
+// @Produce json
+// @Param novel_id path string true "Novel ID"
+// @Param novel body dtos.NovelUpdateRequest true "Edited novel metadata"
+// @Success 200 {object} models.Novel
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/{novel_id} [patch]
+func (n *NovelController) PatchNovel(ctx *gin.Context) {
+	n.updateNovel(ctx, false)
+}
+
+// updateNovel edits the metadata of the novel of a request.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - replace (bool): Whether every editable field is replaced.
+func (n *NovelController) updateNovel(ctx *gin.Context, replace bool) {
+	id, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.NovelUpdateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidNovelUpdate)
+		return
+	}
+
+	novel, err := n.novelService.UpdateNovel(id, request, replace)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, novel)
+}
+
+// DeleteNovel deletes a novel.
+//
+// @Summary Delete novel
+// @Description Deletes a novel. It's hidden with its chapters, and restored if it's imported again.
+// @Tags Novels
+// @Produce json
+// @Param novel_id path string true "Novel ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/{novel_id} [delete]
+func (n *NovelController) DeleteNovel(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if err := n.novelService.DeleteNovel(id); err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Novel successfully deleted"})
+}
+
 // GetNovelByUpdatesID retrieves a novel based on its title (which acts as the updates ID).
 //
 // @Summary Get novel by NovelByUpdatesID
@@ -374,13 +579,13 @@ func (n *NovelController) processNovelsWithStreaming(ctx *gin.Context) error {
 	var wg sync.WaitGroup
 
 	// Fetch all novels
-	novels, total, err := n.novelService.GetNovels(1, 999999999)
+	novels, err := n.novelService.GetAllNovels()
 	if err != nil {
 		sendSSEError(ctx, "Failed to fetch novels: "+err.Error())
 		return err
 	}
 
-	totalInt := int(total)
+	totalInt := len(novels)
 	if totalInt == 0 {
 		sendSSEError(ctx, "No novels found")
 		return fmt.Errorf("no novels found")
@@ -478,3 +683,72 @@ func sendSSEComplete(ctx *gin.Context, message string) {
 	fmt.Fprintf(ctx.Writer, "event: complete\ndata: %s\n\n", message)
 	ctx.Writer.Flush()
 }
+
+// parseNovelQuery parses the filters and the order of a listing of novels from the query parameters of a request.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//
+// Returns:
+//   - models.NovelQuery: The filters and the order of the novels.
+//   - error: An error if a number or the order is invalid.
+//
+// Error types:
+//   - PARSE_INT_ERROR: Returned if a year or the minimum number of chapters is not a positive number.
+//   - errors.ErrInvalidNovelOrder: Returned if the order is neither asc nor desc.
+func parseNovelQuery(ctx *gin.Context) (models.NovelQuery, error) {
+	query := models.NovelQuery{
+		IncludeTags:    queryList(ctx, "tags"),
+		ExcludeTags:    queryList(ctx, "exclude_tags"),
+		IncludeGenres:  queryList(ctx, "genres"),
+		ExcludeGenres:  queryList(ctx, "exclude_genres"),
+		IncludeAuthors: queryList(ctx, "authors"),
+		ExcludeAuthors: queryList(ctx, "exclude_authors"),
+		Match:          strings.ToLower(ctx.Query("match")),
+		Status:         strings.TrimSpace(ctx.Query("status")),
+		Language:       strings.TrimSpace(ctx.Query("language")),
+		Sort:           strings.ToLower(ctx.Query("sort")),
+	}
+
+	for param, value := range map[string]*int{"year_from": &query.YearFrom, "year_to": &query.YearTo, "min_chapters": &query.MinChapters} {
+		if ctx.Query(param) == "" {
+			continue
+		}
+
+		number, err := utils.ParseInt(ctx.Query(param))
+		if err != nil {
+			return models.NovelQuery{}, err
+		}
+		*value = number
+	}
+
+	switch strings.ToLower(ctx.Query("order")) {
+	case "", "asc":
+	case "desc":
+		query.Descending = true
+	default:
+		return models.NovelQuery{}, errors.ErrInvalidNovelOrder
+	}
+
+	return query, nil
+}
+
+// queryList returns the values of a query parameter that can be repeated or comma-separated, without the empty ones.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - param (string): The name of the query parameter.
+//
+// Returns:
+//   - []string: The values of the parameter.
+func queryList(ctx *gin.Context, param string) []string {
+	var values []string
+	for _, value := range ctx.QueryArray(param) {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
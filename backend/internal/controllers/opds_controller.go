@@ -0,0 +1,253 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/services/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OPDSController struct serves the OPDS 1.2 catalog e-reader apps, like KOReader or Moon+ Reader, browse the library
+// with.
+//
+// Fields:
+//   - opdsService (interfaces.OPDSServiceInterface): An interface that builds the feeds of the catalog.
+type OPDSController struct {
+	opdsService interfaces.OPDSServiceInterface
+}
+
+// NewOPDSController creates a new OPDSController instance.
+//
+// Parameters:
+//   - opdsService (interfaces.OPDSServiceInterface): The OPDS service to be used by the controller.
+//
+// Returns:
+//   - *OPDSController: A pointer to the newly created OPDSController.
+func NewOPDSController(opdsService interfaces.OPDSServiceInterface) *OPDSController {
+	return &OPDSController{opdsService: opdsService}
+}
+
+// GetRootFeed serves the navigation feed the catalog starts at.
+//
+// @Summary Get the OPDS catalog
+// @Description Serves the OPDS 1.2 navigation feed the catalog starts at, linking to all the novels and to the genres, tags and authors.
+// @Tags OPDS
+// @Produce xml
+// @Success 200 {object} dtos.OPDSFeed
+// @Router /opds [get]
+func (o *OPDSController) GetRootFeed(ctx *gin.Context) {
+	writeXML(ctx, dtos.OPDSNavigationType, o.opdsService.GetRootFeed())
+}
+
+// GetNovelsFeed serves the acquisition feed of all the novels.
+//
+// @Summary Get the OPDS feed of all the novels
+// @Description Serves a page of the OPDS acquisition feed of all the novels, each linking to the EPUB of its stored chapters.
+// @Tags OPDS
+// @Produce xml
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/novels [get]
+func (o *OPDSController) GetNovelsFeed(ctx *gin.Context) {
+	servePaginatedFeed(ctx, dtos.OPDSAcquisitionType, o.opdsService.GetNovelsFeed)
+}
+
+// GetGenresFeed serves the navigation feed of the genres.
+//
+// @Summary Get the OPDS feed of the genres
+// @Description Serves a page of the OPDS navigation feed of the genres, each linking to the feed of its novels.
+// @Tags OPDS
+// @Produce xml
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/genres [get]
+func (o *OPDSController) GetGenresFeed(ctx *gin.Context) {
+	servePaginatedFeed(ctx, dtos.OPDSNavigationType, o.opdsService.GetGenresFeed)
+}
+
+// GetTagsFeed serves the navigation feed of the tags.
+//
+// @Summary Get the OPDS feed of the tags
+// @Description Serves a page of the OPDS navigation feed of the tags, each linking to the feed of its novels.
+// @Tags OPDS
+// @Produce xml
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/tags [get]
+func (o *OPDSController) GetTagsFeed(ctx *gin.Context) {
+	servePaginatedFeed(ctx, dtos.OPDSNavigationType, o.opdsService.GetTagsFeed)
+}
+
+// GetAuthorsFeed serves the navigation feed of the authors.
+//
+// @Summary Get the OPDS feed of the authors
+// @Description Serves a page of the OPDS navigation feed of the authors, each linking to the feed of their novels.
+// @Tags OPDS
+// @Produce xml
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/authors [get]
+func (o *OPDSController) GetAuthorsFeed(ctx *gin.Context) {
+	servePaginatedFeed(ctx, dtos.OPDSNavigationType, o.opdsService.GetAuthorsFeed)
+}
+
+// GetGenreFeed serves the acquisition feed of the novels of a genre.
+//
+// @Summary Get the OPDS feed of a genre
+// @Description Serves a page of the OPDS acquisition feed of the novels of a genre.
+// @Tags OPDS
+// @Produce xml
+// @Param genre_name path string true "Genre name"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/genres/{genre_name} [get]
+func (o *OPDSController) GetGenreFeed(ctx *gin.Context) {
+	genreName := ctx.Param("genre_name")
+	servePaginatedFeed(ctx, dtos.OPDSAcquisitionType, func(page, limit int) (*dtos.OPDSFeed, error) {
+		return o.opdsService.GetGenreFeed(genreName, page, limit)
+	})
+}
+
+// GetTagFeed serves the acquisition feed of the novels of a tag.
+//
+// @Summary Get the OPDS feed of a tag
+// @Description Serves a page of the OPDS acquisition feed of the novels of a tag.
+// @Tags OPDS
+// @Produce xml
+// @Param tag_name path string true "Tag name"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/tags/{tag_name} [get]
+func (o *OPDSController) GetTagFeed(ctx *gin.Context) {
+	tagName := ctx.Param("tag_name")
+	servePaginatedFeed(ctx, dtos.OPDSAcquisitionType, func(page, limit int) (*dtos.OPDSFeed, error) {
+		return o.opdsService.GetTagFeed(tagName, page, limit)
+	})
+}
+
+// GetAuthorFeed serves the acquisition feed of the novels of an author.
+//
+// @Summary Get the OPDS feed of an author
+// @Description Serves a page of the OPDS acquisition feed of the novels of an author.
+// @Tags OPDS
+// @Produce xml
+// @Param author_name path string true "Author name"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/authors/{author_name} [get]
+func (o *OPDSController) GetAuthorFeed(ctx *gin.Context) {
+	authorName := ctx.Param("author_name")
+	servePaginatedFeed(ctx, dtos.OPDSAcquisitionType, func(page, limit int) (*dtos.OPDSFeed, error) {
+		return o.opdsService.GetAuthorFeed(authorName, page, limit)
+	})
+}
+
+// SearchFeed serves the acquisition feed of the novels whose title contains a query.
+//
+// @Summary Search the OPDS catalog
+// @Description Serves a page of the OPDS acquisition feed of the novels whose title contains a query, as described by the OpenSearch description of the catalog.
+// @Tags OPDS
+// @Produce xml
+// @Param q query string true "Search query"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.OPDSFeed
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /opds/search [get]
+func (o *OPDSController) SearchFeed(ctx *gin.Context) {
+	query := ctx.Query("q")
+	servePaginatedFeed(ctx, dtos.OPDSAcquisitionType, func(page, limit int) (*dtos.OPDSFeed, error) {
+		return o.opdsService.SearchFeed(query, page, limit)
+	})
+}
+
+// GetOpenSearchDescription serves the OpenSearch description of the catalog.
+//
+// @Summary Get the OpenSearch description of the OPDS catalog
+// @Description Serves the OpenSearch description telling e-reader apps how to search the catalog.
+// @Tags OPDS
+// @Produce xml
+// @Success 200 {object} dtos.OpenSearchDescription
+// @Router /opds/opensearch.xml [get]
+func (o *OPDSController) GetOpenSearchDescription(ctx *gin.Context) {
+	writeXML(ctx, dtos.OpenSearchDescriptionType, o.opdsService.GetOpenSearchDescription())
+}
+
+// servePaginatedFeed parses the pagination parameters of a request and serves the page of a feed.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - feedType (string): The media type of the feed.
+//   - getFeed (func(page, limit int) (*dtos.OPDSFeed, error)): The function building the page of the feed.
+func servePaginatedFeed(ctx *gin.Context, feedType string, getFeed func(page, limit int) (*dtos.OPDSFeed, error)) {
+	page, err := utils.ParsePage(ctx.Query("page"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	feed, err := getFeed(page, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	writeXML(ctx, feedType, feed)
+}
+
+// writeXML writes an XML document as the response of a request.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - contentType (string): The media type of the document.
+//   - document (any): The document.
+func writeXML(ctx *gin.Context, contentType string, document any) {
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		utils.HandleError(ctx, types.WrapError(errors.WRITING_OPDS_FEED, "Failed to write the feed", http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx.Data(http.StatusOK, contentType+";charset=utf-8", append([]byte(xml.Header), body...))
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultReadingStatsDays is the number of days the reading statistics are computed over when none is requested.
+const defaultReadingStatsDays = 30
+
+// ReadingHistoryController struct serves the reading statistics of the authenticated user, computed from the chapters
+// they read.
+//
+// Fields:
+//   - readingHistoryService (interfaces.ReadingHistoryServiceInterface): An interface that computes the reading
+//     statistics.
+type ReadingHistoryController struct {
+	readingHistoryService interfaces.ReadingHistoryServiceInterface
+}
+
+// NewReadingHistoryController creates a new ReadingHistoryController instance.
+//
+// Parameters:
+//   - readingHistoryService (interfaces.ReadingHistoryServiceInterface): The reading history service to be used by
+//     the controller.
+//
+// Returns:
+//   - *ReadingHistoryController: A pointer to the newly created ReadingHistoryController.
+func NewReadingHistoryController(readingHistoryService interfaces.ReadingHistoryServiceInterface) *ReadingHistoryController {
+	return &ReadingHistoryController{readingHistoryService: readingHistoryService}
+}
+
+// GetReadingStats retrieves the reading statistics of the authenticated user.
+//
+// @Summary Get the reading statistics
+// @Description Computes what the authenticated user read over the last days from the chapters they opened while signed in: chapters and words finished and time spent per day and per week, their current and longest reading streaks, and their favourite genres and tags. A chapter counts as finished when the user moves on to a later chapter of the same novel.
+// @Tags Reading History
+// @Produce json
+// @Param days query int false "Number of days, today included (1 to 365)" default(30)
+// @Param tz query string false "IANA timezone the days are counted in" default(UTC)
+// @Success 200 {object} dtos.ReadingStats
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/reading-stats [get]
+func (r *ReadingHistoryController) GetReadingStats(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	days, err := strconv.Atoi(ctx.DefaultQuery("days", strconv.Itoa(defaultReadingStatsDays)))
+	if err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidReadingStatsPeriod)
+		return
+	}
+
+	stats, err := r.readingHistoryService.GetReadingStats(user.ID, days, ctx.Query("tz"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, stats)
+}
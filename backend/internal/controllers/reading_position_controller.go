@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReadingPositionController struct syncs where the authenticated user is reading their novels across their devices.
+//
+// Fields:
+//   - readingPositionService (interfaces.ReadingPositionServiceInterface): An interface that stores the reading
+//     positions.
+type ReadingPositionController struct {
+	readingPositionService interfaces.ReadingPositionServiceInterface
+}
+
+// NewReadingPositionController creates a new ReadingPositionController instance.
+//
+// Parameters:
+//   - readingPositionService (interfaces.ReadingPositionServiceInterface): The reading position service to be used by
+//     the controller.
+//
+// Returns:
+//   - *ReadingPositionController: A pointer to the newly created ReadingPositionController.
+func NewReadingPositionController(readingPositionService interfaces.ReadingPositionServiceInterface) *ReadingPositionController {
+	return &ReadingPositionController{readingPositionService: readingPositionService}
+}
+
+// SyncReadingPosition stores the reading position of a novel sent from a device.
+//
+// @Summary Sync the reading position of a novel
+// @Description Stores the chapter, paragraph and scroll percent the authenticated user reached in a novel on a device. The position read last wins: a position reached before the stored one is not kept. The losing position is returned as superseded, so the client can offer to jump to it.
+// @Tags Reading Positions
+// @Accept json
+// @Produce json
+// @Param novel_id path int true "Novel ID"
+// @Param position body dtos.ReadingPositionRequest true "Reading position"
+// @Success 200 {object} dtos.ReadingPositionSyncResponse
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/{novel_id}/reading-position [put]
+func (r *ReadingPositionController) SyncReadingPosition(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	novelID, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.ReadingPositionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidReadingPosition)
+		return
+	}
+
+	response, err := r.readingPositionService.SyncReadingPosition(user.ID, novelID, request)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetReadingPosition retrieves the latest reading position of a novel.
+//
+// @Summary Get the reading position of a novel
+// @Description Retrieves the position the authenticated user read last in a novel, on any device.
+// @Tags Reading Positions
+// @Produce json
+// @Param novel_id path int true "Novel ID"
+// @Success 200 {object} models.ReadingPosition
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/{novel_id}/reading-position [get]
+func (r *ReadingPositionController) GetReadingPosition(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	novelID, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	position, err := r.readingPositionService.GetReadingPosition(user.ID, novelID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, position)
+}
+
+// GetReadingPositions retrieves the latest reading positions of all the novels of the authenticated user.
+//
+// @Summary Get the reading positions
+// @Description Retrieves the position the authenticated user read last in each novel they synced, most recently read first, so a device can catch up at once.
+// @Tags Reading Positions
+// @Produce json
+// @Success 200 {array} models.ReadingPosition
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/reading-positions [get]
+func (r *ReadingPositionController) GetReadingPositions(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	positions, err := r.readingPositionService.GetReadingPositions(user.ID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, positions)
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"backend/internal/services/interfaces"
+	"backend/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RefreshSchedulerController struct exposes the scheduled refresh of the novels.
+//
+// Fields:
+//   - refreshSchedulerService (interfaces.RefreshSchedulerServiceInterface): An interface that provides access to the
+//     scheduler.
+type RefreshSchedulerController struct {
+	refreshSchedulerService interfaces.RefreshSchedulerServiceInterface
+}
+
+// NewRefreshSchedulerController creates a new RefreshSchedulerController instance.
+//
+// Parameters:
+//   - refreshSchedulerService (interfaces.RefreshSchedulerServiceInterface): The scheduler to be used by the controller.
+//
+// Returns:
+//   - *RefreshSchedulerController: A pointer to the newly created RefreshSchedulerController.
+func NewRefreshSchedulerController(refreshSchedulerService interfaces.RefreshSchedulerServiceInterface) *RefreshSchedulerController {
+	return &RefreshSchedulerController{refreshSchedulerService: refreshSchedulerService}
+}
+
+// GetRefreshStatus retrieves the status of the scheduled refresh of the novels.
+//
+// @Summary Get novel refresh status
+// @Description Retrieves the schedules refreshing the novels that are not completed, when they run next and the outcome of their last run.
+// @Tags Novels
+// @Accept json
+// @Produce json
+// @Success 200 {object} dtos.RefreshSchedulerStatus
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 403 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/refresh/status [get]
+func (r *RefreshSchedulerController) GetRefreshStatus(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, r.refreshSchedulerService.GetStatus())
+}
+
+// RunRefreshSchedule refreshes the novels of a schedule right away.
+//
+// @Summary Run novel refresh schedule
+// @Description Refreshes the novels of a schedule without waiting for its next run, and queues the import of the chapters released after their latest stored chapter. The response is sent once every novel of the schedule is checked.
+// @Tags Novels
+// @Accept json
+// @Produce json
+// @Param schedule path string true "Name of the schedule"
+// @Success 200 {object} dtos.RefreshRun
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 403 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /novels/refresh/{schedule} [post]
+func (r *RefreshSchedulerController) RunRefreshSchedule(ctx *gin.Context) {
+	run, err := r.refreshSchedulerService.RunSchedule(ctx.Param("schedule"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, run)
+}
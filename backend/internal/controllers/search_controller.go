@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SearchController struct handles the full-text search of the novels and of their chapters.
+//
+// Fields:
+//   - searchService (interfaces.SearchServiceInterface): An interface that searches the novels and chapters.
+type SearchController struct {
+	searchService interfaces.SearchServiceInterface
+}
+
+// NewSearchController creates a new SearchController instance.
+//
+// Parameters:
+//   - searchService (interfaces.SearchServiceInterface): The search service to be used by the controller.
+//
+// Returns:
+//   - *SearchController: A pointer to the newly created SearchController.
+func NewSearchController(searchService interfaces.SearchServiceInterface) *SearchController {
+	return &SearchController{searchService: searchService}
+}
+
+// SearchNovels searches the novels by title, synopsis and authors.
+//
+// @Summary Search novels
+// @Description Retrieves a paginated list of the novels whose title, synopsis or authors contain every word of the query, or words starting with it, most relevant first. Each result has an HTML-escaped snippet with the matching words between <mark> and </mark>.
+// @Tags Search
+// @Produce json
+// @Param q query string true "Search query"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.PaginatedResponse{data=[]models.NovelSearchResult}
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /novels/search [get]
+func (s *SearchController) SearchNovels(ctx *gin.Context) {
+	// Parse parameters
+	page, err := utils.ParsePage(ctx.Query("page"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Get data
+	results, total, err := s.searchService.SearchNovels(ctx.Query("q"), page, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Validate results
+	if total == 0 {
+		utils.HandleError(ctx, errors.ErrNoResults)
+		return
+	}
+
+	if utils.IsPageOutOfRange(page, total, limit) {
+		utils.HandleError(ctx, errors.ErrPageOutOfRange)
+		return
+	}
+
+	// Build response
+	utils.BuildPaginatedResponse(ctx, results, total, page, limit)
+}
+
+// SearchChapters searches the text of the chapters of a novel.
+//
+// @Summary Search the chapters of a novel
+// @Description Retrieves a paginated list of the chapters of a novel whose text contains every word of the query, or words starting with it, most relevant first. Each result has an HTML-escaped snippet with the matching words between <mark> and </mark>.
+// @Tags Search
+// @Produce json
+// @Param novel_id path int true "Novel ID"
+// @Param q query string true "Search query"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.PaginatedResponse{data=[]models.ChapterSearchResult}
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /novels/{novel_id}/search [get]
+func (s *SearchController) SearchChapters(ctx *gin.Context) {
+	// Parse parameters
+	novelID, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	page, err := utils.ParsePage(ctx.Query("page"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Get data
+	results, total, err := s.searchService.SearchChapters(novelID, ctx.Query("q"), page, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Validate results
+	if total == 0 {
+		utils.HandleError(ctx, errors.ErrNoResults)
+		return
+	}
+
+	if utils.IsPageOutOfRange(page, total, limit) {
+		utils.HandleError(ctx, errors.ErrPageOutOfRange)
+		return
+	}
+
+	// Build response
+	utils.BuildPaginatedResponse(ctx, results, total, page, limit)
+}
@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ShelfController struct manages the shelves the authenticated user organizes their library with.
+//
+// Fields:
+//   - shelfService (interfaces.ShelfServiceInterface): An interface that manages the shelves.
+type ShelfController struct {
+	shelfService interfaces.ShelfServiceInterface
+}
+
+// NewShelfController creates a new ShelfController instance.
+//
+// Parameters:
+//   - shelfService (interfaces.ShelfServiceInterface): The shelf service to be used by the controller.
+//
+// Returns:
+//   - *ShelfController: A pointer to the newly created ShelfController.
+func NewShelfController(shelfService interfaces.ShelfServiceInterface) *ShelfController {
+	return &ShelfController{shelfService: shelfService}
+}
+
+// GetShelves retrieves the shelves of the authenticated user.
+//
+// @Summary Get the shelves
+// @Description Retrieves the shelves of the authenticated user with their novels, ordered by name.
+// @Tags Shelves
+// @Produce json
+// @Success 200 {array} models.Shelf
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves [get]
+func (s *ShelfController) GetShelves(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelves, err := s.shelfService.GetShelves(user.ID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, shelves)
+}
+
+// GetShelf retrieves a shelf of the authenticated user.
+//
+// @Summary Get a shelf
+// @Description Retrieves a shelf of the authenticated user with its novels, ordered by title.
+// @Tags Shelves
+// @Produce json
+// @Param shelf_id path int true "Shelf ID"
+// @Success 200 {object} models.Shelf
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves/{shelf_id} [get]
+func (s *ShelfController) GetShelf(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelfID, err := utils.ParseUintID(ctx.Param("shelf_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelf, err := s.shelfService.GetShelf(user.ID, shelfID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, shelf)
+}
+
+// CreateShelf creates a shelf for the authenticated user.
+//
+// @Summary Create a shelf
+// @Description Creates an empty shelf for the authenticated user. Each of their shelves has a different name.
+// @Tags Shelves
+// @Accept json
+// @Produce json
+// @Param shelf body dtos.ShelfRequest true "Shelf"
+// @Success 201 {object} models.Shelf
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves [post]
+func (s *ShelfController) CreateShelf(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.ShelfRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidShelf)
+		return
+	}
+
+	shelf, err := s.shelfService.CreateShelf(user.ID, request.Name)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, shelf)
+}
+
+// RenameShelf renames a shelf of the authenticated user.
+//
+// @Summary Rename a shelf
+// @Description Changes the name of a shelf of the authenticated user.
+// @Tags Shelves
+// @Accept json
+// @Produce json
+// @Param shelf_id path int true "Shelf ID"
+// @Param shelf body dtos.ShelfRequest true "Shelf"
+// @Success 200 {object} models.Shelf
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves/{shelf_id} [put]
+func (s *ShelfController) RenameShelf(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelfID, err := utils.ParseUintID(ctx.Param("shelf_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.ShelfRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidShelf)
+		return
+	}
+
+	shelf, err := s.shelfService.RenameShelf(user.ID, shelfID, request.Name)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, shelf)
+}
+
+// DeleteShelf deletes a shelf of the authenticated user.
+//
+// @Summary Delete a shelf
+// @Description Deletes a shelf of the authenticated user. The novels on it stay bookmarked and on their other shelves.
+// @Tags Shelves
+// @Produce json
+// @Param shelf_id path int true "Shelf ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves/{shelf_id} [delete]
+func (s *ShelfController) DeleteShelf(ctx *gin.Context) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelfID, err := utils.ParseUintID(ctx.Param("shelf_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if err := s.shelfService.DeleteShelf(user.ID, shelfID); err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Shelf deleted successfully"})
+}
+
+// AddNovelToShelf puts a novel on a shelf of the authenticated user.
+//
+// @Summary Put a novel on a shelf
+// @Description Puts a novel on a shelf of the authenticated user, whatever the status of its bookmark. Putting a novel already on the shelf changes nothing.
+// @Tags Shelves
+// @Produce json
+// @Param shelf_id path int true "Shelf ID"
+// @Param novel_id path int true "Novel ID"
+// @Success 200 {object} models.Shelf
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves/{shelf_id}/novels/{novel_id} [put]
+func (s *ShelfController) AddNovelToShelf(ctx *gin.Context) {
+	s.changeShelfNovel(ctx, s.shelfService.AddNovelToShelf)
+}
+
+// RemoveNovelFromShelf takes a novel off a shelf of the authenticated user.
+//
+// @Summary Take a novel off a shelf
+// @Description Takes a novel off a shelf of the authenticated user. Its bookmark is left as it is.
+// @Tags Shelves
+// @Produce json
+// @Param shelf_id path int true "Shelf ID"
+// @Param novel_id path int true "Novel ID"
+// @Success 200 {object} models.Shelf
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 401 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /user/shelves/{shelf_id}/novels/{novel_id} [delete]
+func (s *ShelfController) RemoveNovelFromShelf(ctx *gin.Context) {
+	s.changeShelfNovel(ctx, s.shelfService.RemoveNovelFromShelf)
+}
+
+// changeShelfNovel parses the shelf and the novel of a request putting a novel on a shelf or taking it off, and
+// responds with the shelf changed.
+//
+// Parameters:
+//   - ctx (*gin.Context): The context of the request.
+//   - change (func(userID, shelfID, novelID uint) (*models.Shelf, error)): The change made to the shelf.
+func (s *ShelfController) changeShelfNovel(ctx *gin.Context, change func(userID, shelfID, novelID uint) (*models.Shelf, error)) {
+	user, err := utils.GetAuthenticatedUser(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelfID, err := utils.ParseUintID(ctx.Param("shelf_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	novelID, err := utils.ParseUintID(ctx.Param("novel_id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	shelf, err := change(user.ID, shelfID, novelID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, shelf)
+}
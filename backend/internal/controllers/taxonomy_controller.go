@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/services/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaxonomyController struct handles the browsing of the genres, tags and authors the novels are classified by, and
+// their curation by the administrators.
+//
+// Fields:
+//   - taxonomyService (interfaces.TaxonomyServiceInterface): An interface that manages the entries of the taxonomies.
+type TaxonomyController struct {
+	taxonomyService interfaces.TaxonomyServiceInterface
+}
+
+// NewTaxonomyController creates a new TaxonomyController instance.
+//
+// Parameters:
+//   - taxonomyService (interfaces.TaxonomyServiceInterface): The taxonomy service to be used by the controller.
+//
+// Returns:
+//   - *TaxonomyController: A pointer to the newly created TaxonomyController.
+func NewTaxonomyController(taxonomyService interfaces.TaxonomyServiceInterface) *TaxonomyController {
+	return &TaxonomyController{taxonomyService: taxonomyService}
+}
+
+// GetEntries lists the genres, tags or authors.
+//
+// @Summary List genres, tags or authors
+// @Description Retrieves a paginated list of the genres, tags or authors, ordered by name, with the number of novels of each. Only the entries whose name contains the search are listed, ignoring case, if one is given.
+// @Tags Taxonomy
+// @Produce json
+// @Param kind path string true "Taxonomy" Enums(genres, tags, authors)
+// @Param q query string false "Text the names must contain"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10)"
+// @Success 200 {object} dtos.PaginatedResponse{data=[]models.TaxonomyEntry}
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /taxonomy/{kind} [get]
+func (t *TaxonomyController) GetEntries(ctx *gin.Context) {
+	// Parse parameters
+	page, err := utils.ParsePage(ctx.Query("page"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	limit, err := utils.ParseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Get data
+	entries, total, err := t.taxonomyService.GetEntries(models.TaxonomyKind(ctx.Param("kind")), ctx.Query("q"), page, limit)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	// Validate results
+	if total == 0 {
+		utils.HandleError(ctx, errors.ErrNoResults)
+		return
+	}
+
+	if utils.IsPageOutOfRange(page, total, limit) {
+		utils.HandleError(ctx, errors.ErrPageOutOfRange)
+		return
+	}
+
+	// Build response
+	utils.BuildPaginatedResponse(ctx, entries, total, page, limit)
+}
+
+// GetEntry retrieves a genre, tag or author.
+//
+// @Summary Get a genre, tag or author
+// @Description Retrieves a genre, tag or author with the number of its novels.
+// @Tags Taxonomy
+// @Produce json
+// @Param kind path string true "Taxonomy" Enums(genres, tags, authors)
+// @Param id path int true "Entry ID"
+// @Success 200 {object} models.TaxonomyEntry
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Router /taxonomy/{kind}/{id} [get]
+func (t *TaxonomyController) GetEntry(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	entry, err := t.taxonomyService.GetEntry(models.TaxonomyKind(ctx.Param("kind")), id)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+// UpdateEntryDescription changes the description of a genre or tag.
+//
+// @Summary Update the description of a genre or tag
+// @Description Changes the description of a genre or tag. Authors have no description.
+// @Tags Taxonomy
+// @Accept json
+// @Produce json
+// @Param kind path string true "Taxonomy" Enums(genres, tags)
+// @Param id path int true "Entry ID"
+// @Param description body dtos.TaxonomyDescriptionRequest true "Description"
+// @Success 200 {object} models.TaxonomyEntry
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /taxonomy/{kind}/{id}/description [put]
+func (t *TaxonomyController) UpdateEntryDescription(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.TaxonomyDescriptionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidTaxonomyDescription)
+		return
+	}
+
+	entry, err := t.taxonomyService.UpdateEntryDescription(models.TaxonomyKind(ctx.Param("kind")), id, request.Description)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+// MergeEntries merges a genre, tag or author into another.
+//
+// @Summary Merge a genre, tag or author into another
+// @Description Merges a duplicate genre, tag or author into another: its novels are moved to the target entry, and it is deleted.
+// @Tags Taxonomy
+// @Accept json
+// @Produce json
+// @Param kind path string true "Taxonomy" Enums(genres, tags, authors)
+// @Param id path int true "ID of the entry merged and deleted"
+// @Param merge body dtos.TaxonomyMergeRequest true "Entry kept"
+// @Success 200 {object} models.TaxonomyEntry
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /taxonomy/{kind}/{id}/merge [post]
+func (t *TaxonomyController) MergeEntries(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	var request dtos.TaxonomyMergeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		utils.HandleError(ctx, errors.ErrInvalidTaxonomyMerge)
+		return
+	}
+
+	entry, err := t.taxonomyService.MergeEntries(models.TaxonomyKind(ctx.Param("kind")), id, request.TargetID)
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+// DeleteEntry deletes an unused genre, tag or author.
+//
+// @Summary Delete an unused genre, tag or author
+// @Description Deletes a genre, tag or author no novel is classified by.
+// @Tags Taxonomy
+// @Produce json
+// @Param kind path string true "Taxonomy" Enums(genres, tags, authors)
+// @Param id path int true "Entry ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dtos.ErrorResponse
+// @Failure 404 {object} dtos.ErrorResponse
+// @Failure 409 {object} dtos.ErrorResponse
+// @Failure 500 {object} dtos.ErrorResponse
+// @Failure 503 {object} dtos.ErrorResponse
+// @Security BearerAuth
+// @Router /taxonomy/{kind}/{id} [delete]
+func (t *TaxonomyController) DeleteEntry(ctx *gin.Context) {
+	id, err := utils.ParseUintID(ctx.Param("id"))
+	if err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	if err := t.taxonomyService.DeleteEntry(models.TaxonomyKind(ctx.Param("kind")), id); err != nil {
+		utils.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Entry successfully deleted"})
+}
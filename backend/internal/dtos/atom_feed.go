@@ -0,0 +1,86 @@
+package dtos
+
+import "encoding/xml"
+
+// AtomFeedType is the media type of the Atom feeds.
+const AtomFeedType = "application/atom+xml"
+
+// AtomFeed represents an Atom feed of new chapters, subscribed to from feed readers.
+//
+// Fields:
+//   - ID (string): The unique identifier of the feed.
+//   - Title (string): The title of the feed.
+//   - Subtitle (string): The description of the feed.
+//   - Updated (string): When the feed was last updated, in RFC 3339 format.
+//   - Links ([]AtomLink): The links of the feed (self and alternate).
+//   - Entries ([]AtomEntry): The entries of the feed, newest first.
+type AtomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []AtomLink  `xml:"link"`
+	Entries  []AtomEntry `xml:"entry"`
+}
+
+// AtomEntry represents an entry of an Atom feed, a new chapter.
+//
+// Fields:
+//   - Title (string): The title of the entry.
+//   - ID (string): The unique identifier of the entry.
+//   - Published (string): When the chapter was stored, in RFC 3339 format.
+//   - Updated (string): When the chapter was last updated, in RFC 3339 format.
+//   - Authors ([]AtomAuthor): The authors of the novel of the chapter.
+//   - Summary (*AtomText): The beginning of the chapter.
+//   - Links ([]AtomLink): The links of the entry (the chapter on the website).
+type AtomEntry struct {
+	Title     string       `xml:"title"`
+	ID        string       `xml:"id"`
+	Published string       `xml:"published"`
+	Updated   string       `xml:"updated"`
+	Authors   []AtomAuthor `xml:"author"`
+	Summary   *AtomText    `xml:"summary,omitempty"`
+	Links     []AtomLink   `xml:"link"`
+}
+
+// AtomLink represents a link of an Atom feed or entry.
+//
+// Fields:
+//   - Rel (string): The relation of the link.
+//   - Href (string): The URL of the link.
+//   - Type (string): The media type of the resource linked to.
+type AtomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// AtomAuthor represents an author of an Atom entry.
+//
+// Fields:
+//   - Name (string): The name of the author.
+type AtomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// AtomText represents a text of an Atom entry.
+//
+// Fields:
+//   - Type (string): The type of the text ("text").
+//   - Text (string): The text.
+type AtomText struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// FeedTokenResponse represents the private token a user subscribes to the feed of their library with.
+//
+// Fields:
+//   - Token (string): The token.
+//   - FeedURL (string): The URL of the feed of the library, including the token.
+type FeedTokenResponse struct {
+	Token   string `json:"token"`
+	FeedURL string `json:"feedUrl"`
+}
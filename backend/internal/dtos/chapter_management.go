@@ -0,0 +1,49 @@
+package dtos
+
+// ChapterEditRequest represents the request body for correcting the title or the body of a chapter. The fields left
+// out are not changed.
+//
+// Fields:
+//   - Title (*string): The new title of the chapter.
+//   - Body (*string): The new body of the chapter.
+type ChapterEditRequest struct {
+	Title *string `json:"title"`
+	Body  *string `json:"body"`
+}
+
+// ChapterInsertRequest represents the request body for inserting a chapter written by hand.
+//
+// Fields:
+//   - ChapterNo (uint): The number of the chapter.
+//   - Title (string): The title of the chapter.
+//   - Body (string): The body of the chapter.
+//   - Shift (bool): Whether the chapters from the number onwards are moved one number up to make room for the chapter.
+//     If false, the number must be free.
+type ChapterInsertRequest struct {
+	ChapterNo uint   `json:"chapterNo"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Shift     bool   `json:"shift"`
+}
+
+// ChapterRenumberRequest represents the request body for renumbering a range of chapters.
+//
+// Fields:
+//   - From (uint): The first chapter of the range.
+//   - To (uint): The last chapter of the range, 0 for the last chapter of the novel.
+//   - Offset (int): The number added to the numbers of the chapters of the range, negative to move them down.
+type ChapterRenumberRequest struct {
+	From   uint `json:"from"`
+	To     uint `json:"to"`
+	Offset int  `json:"offset"`
+}
+
+// ChapterCountResponse represents the number of chapters changed by a request.
+//
+// Fields:
+//   - Message (string): A description of the change.
+//   - Count (int64): The number of chapters changed.
+type ChapterCountResponse struct {
+	Message string `json:"message"`
+	Count   int64  `json:"count"`
+}
@@ -0,0 +1,35 @@
+package dtos
+
+import (
+	"backend/internal/models"
+	"time"
+)
+
+// ChapterTOCEntry represents a chapter in the table of contents of a novel, without its body.
+//
+// Fields:
+//   - ChapterNo (uint): The number of the chapter.
+//   - Title (string): The title of the chapter.
+//   - WordCount (int): The number of words of the body of the chapter.
+//   - CreatedAt (time.Time): When the chapter was stored.
+//   - UpdatedAt (time.Time): When the chapter was last changed.
+type ChapterTOCEntry struct {
+	ChapterNo uint      `json:"chapterNo"`
+	Title     string    `json:"title"`
+	WordCount int       `json:"wordCount"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ChapterResponse represents a chapter served to readers, with the numbers of the chapters to navigate to. The numbers
+// skip the chapters that are not stored, so they are not always one apart.
+//
+// Fields:
+//   - Chapter (models.Chapter): The chapter.
+//   - PreviousChapterNo (*uint): The number of the previous stored chapter, left out on the first chapter.
+//   - NextChapterNo (*uint): The number of the next stored chapter, left out on the last chapter.
+type ChapterResponse struct {
+	models.Chapter
+	PreviousChapterNo *uint `json:"previousChapterNo,omitempty"`
+	NextChapterNo     *uint `json:"nextChapterNo,omitempty"`
+}
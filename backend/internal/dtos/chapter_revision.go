@@ -0,0 +1,44 @@
+package dtos
+
+const (
+	// ParagraphUnchanged marks a paragraph present in both revisions.
+	ParagraphUnchanged = "unchanged"
+	// ParagraphAdded marks a paragraph only present in the newer revision.
+	ParagraphAdded = "added"
+	// ParagraphRemoved marks a paragraph only present in the older revision.
+	ParagraphRemoved = "removed"
+)
+
+// ParagraphChange represents a paragraph of a diff between two revisions of a chapter.
+//
+// Fields:
+//   - Change (string): Whether the paragraph is unchanged, added or removed.
+//   - From (int): The 1-based position of the paragraph in the older revision, 0 if it was added.
+//   - To (int): The 1-based position of the paragraph in the newer revision, 0 if it was removed.
+//   - Text (string): The text of the paragraph.
+type ParagraphChange struct {
+	Change string `json:"change"`
+	From   int    `json:"from,omitempty"`
+	To     int    `json:"to,omitempty"`
+	Text   string `json:"text"`
+}
+
+// ChapterRevisionDiff represents the paragraph level differences between two revisions of a chapter.
+//
+// Fields:
+//   - ChapterNo (uint): The number of the chapter.
+//   - From (int): The older revision.
+//   - To (int): The newer revision.
+//   - Added (int): The number of paragraphs only present in the newer revision.
+//   - Removed (int): The number of paragraphs only present in the older revision.
+//   - Unchanged (int): The number of paragraphs present in both revisions.
+//   - Paragraphs ([]ParagraphChange): The paragraphs of both revisions, in reading order.
+type ChapterRevisionDiff struct {
+	ChapterNo  uint              `json:"chapterNo"`
+	From       int               `json:"from"`
+	To         int               `json:"to"`
+	Added      int               `json:"added"`
+	Removed    int               `json:"removed"`
+	Unchanged  int               `json:"unchanged"`
+	Paragraphs []ParagraphChange `json:"paragraphs"`
+}
@@ -5,8 +5,13 @@ package dtos
 // Fields:
 //   - ChapterNo (int): The chapter number.
 //   - Status (string): The status of the chapter (e.g., "completed", "downloading").
+//   - Message (string): The error of the last attempt, if any.
+//   - ErrorCode (string): The class of the error of the last attempt, if any (e.g., "CHAPTER_EMPTY", "SOURCE_DOWN").
+//   - Retryable (bool): Whether the error of the last attempt is transient.
 type ChapterStatus struct {
 	ChapterNo int    `json:"chapterNo"`
 	Status    string `json:"status"`
 	Message   string `json:"message"`
+	ErrorCode string `json:"errorCode,omitempty"`
+	Retryable bool   `json:"retryable"`
 }
@@ -0,0 +1,19 @@
+package dtos
+
+import "backend/internal/models"
+
+// EPUBExport represents an EPUB export of a range of chapters of a novel, checked before the EPUB is written.
+//
+// Fields:
+//   - Novel (*models.Novel): The exported novel.
+//   - From (uint): The first chapter number of the range.
+//   - To (uint): The last chapter number of the range.
+//   - Chapters (int64): The number of stored chapters in the range.
+//   - Filename (string): The name of the EPUB file.
+type EPUBExport struct {
+	Novel    *models.Novel
+	From     uint
+	To       uint
+	Chapters int64
+	Filename string
+}
@@ -0,0 +1,47 @@
+package dtos
+
+import "backend/internal/models"
+
+const (
+	// EPUBChapterImported is the status of a document of an EPUB stored as a chapter.
+	EPUBChapterImported = "imported"
+	// EPUBChapterSkipped is the status of a document of an EPUB that is not a chapter, like a cover page, or whose
+	// chapter is already stored.
+	EPUBChapterSkipped = "skipped"
+	// EPUBChapterFailed is the status of a document of an EPUB that could not be read or stored.
+	EPUBChapterFailed = "failed"
+)
+
+// EPUBImportReport represents the result of importing a novel from an EPUB.
+//
+// Fields:
+//   - Novel (*models.Novel): The imported novel.
+//   - Imported (int): The number of chapters stored.
+//   - Skipped (int): The number of documents that were not stored as chapters.
+//   - Failed (int): The number of documents that could not be read or stored.
+//   - Chapters ([]EPUBChapterResult): The result of each document of the reading order, in order.
+type EPUBImportReport struct {
+	Novel    *models.Novel       `json:"novel"`
+	Imported int                 `json:"imported"`
+	Skipped  int                 `json:"skipped"`
+	Failed   int                 `json:"failed"`
+	Chapters []EPUBChapterResult `json:"chapters"`
+}
+
+// EPUBChapterResult represents the result of importing a document of an EPUB as a chapter.
+//
+// Fields:
+//   - Href (string): The path of the document inside the EPUB.
+//   - ChapterNo (int): The number of the chapter, 0 if the document is not a chapter.
+//   - Title (string): The title of the chapter.
+//   - Status (string): The result ("imported", "skipped" or "failed").
+//   - Message (string): Why the document was skipped or failed, if it was.
+//   - ErrorCode (string): The code of the error, if any (e.g., "CHAPTER_EMPTY", "PARSING_EPUB_CHAPTER").
+type EPUBChapterResult struct {
+	Href      string `json:"href"`
+	ChapterNo int    `json:"chapterNo"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+	ErrorCode string `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,43 @@
+package dtos
+
+import "backend/internal/models"
+
+// ImportJobRequest represents the request body for starting a chapter import job.
+//
+// Fields:
+//   - From (int): The first chapter to import. Defaults to 1.
+//   - To (int): The last chapter to import. Defaults to the latest chapter of the novel.
+//   - Delta (bool): Whether to only import the chapters of the range that are not stored yet.
+type ImportJobRequest struct {
+	From  int  `json:"from"`
+	To    int  `json:"to"`
+	Delta bool `json:"delta"`
+}
+
+// MissingChaptersResponse represents the chapters of a range that are not stored yet.
+//
+// Fields:
+//   - LatestStoredChapter (int): The highest stored chapter number of the novel, 0 if it has no chapters.
+//   - Gaps ([]models.ChapterRange): The missing ranges below the latest stored chapter.
+//   - NewChapters (*models.ChapterRange): The missing range above the latest stored chapter, if any.
+//   - GapCount (int): The number of chapters in the gaps.
+//   - NewCount (int): The number of new chapters.
+type MissingChaptersResponse struct {
+	LatestStoredChapter int                   `json:"latestStoredChapter"`
+	Gaps                []models.ChapterRange `json:"gaps"`
+	NewChapters         *models.ChapterRange  `json:"newChapters"`
+	GapCount            int                   `json:"gapCount"`
+	NewCount            int                   `json:"newCount"`
+}
+
+// ImportJobEvent represents a progress update of an import job sent to its subscribers.
+//
+// Fields:
+//   - JobID (uint): The ID of the job.
+//   - State (string): The state of the job when the event was sent.
+//   - Chapter (*ChapterStatus): The new status of a chapter, nil if the event is a change of the state of the job.
+type ImportJobEvent struct {
+	JobID   uint           `json:"jobId"`
+	State   string         `json:"state"`
+	Chapter *ChapterStatus `json:"chapter,omitempty"`
+}
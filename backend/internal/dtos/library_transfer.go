@@ -0,0 +1,65 @@
+package dtos
+
+// The results of the rows of a library import.
+const (
+	// LibraryImportCreated is the result of a row that bookmarked a novel.
+	LibraryImportCreated = "created"
+	// LibraryImportUpdated is the result of a row that updated the bookmark of a novel already bookmarked.
+	LibraryImportUpdated = "updated"
+	// LibraryImportFailed is the result of a row that couldn't be imported.
+	LibraryImportFailed = "failed"
+)
+
+// LibraryRow represents a bookmarked novel in an exported library, or in a library being imported.
+//
+// Fields:
+//   - NovelID (uint): The ID of the novel, only set in exports.
+//   - NovelUpdatesID (string): The NovelUpdates ID of the novel, or the URL of its NovelUpdates page.
+//   - Title (string): The title of the novel, matched when the NovelUpdates ID isn't known.
+//   - Status (string): The status of the bookmark.
+//   - Score (int): The score the user rated the novel with, 0 if not rated.
+//   - CurrentChapter (int): The chapter the user reached.
+type LibraryRow struct {
+	NovelID        uint   `json:"novelId,omitempty"`
+	NovelUpdatesID string `json:"novelUpdatesId"`
+	Title          string `json:"title"`
+	Status         string `json:"status"`
+	Score          int    `json:"score"`
+	CurrentChapter int    `json:"currentChapter"`
+}
+
+// LibraryImportRowResult represents what importing a row of a library did.
+//
+// Fields:
+//   - Row (int): The position of the row in the file, starting at 1 for the first novel.
+//   - NovelUpdatesID (string): The NovelUpdates ID of the row.
+//   - Title (string): The title of the row.
+//   - Result (string): The result of the row ("created", "updated" or "failed").
+//   - NovelID (uint): The ID of the novel the row matched.
+//   - NovelCreated (bool): Whether the novel was unknown and imported from NovelUpdates for the row.
+//   - Code (string): The code of the error the row failed with.
+//   - Error (string): The message of the error the row failed with.
+type LibraryImportRowResult struct {
+	Row            int    `json:"row"`
+	NovelUpdatesID string `json:"novelUpdatesId,omitempty"`
+	Title          string `json:"title,omitempty"`
+	Result         string `json:"result"`
+	NovelID        uint   `json:"novelId,omitempty"`
+	NovelCreated   bool   `json:"novelCreated,omitempty"`
+	Code           string `json:"code,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// LibraryImportReport represents the outcome of a library import.
+//
+// Fields:
+//   - Created (int): The number of novels bookmarked.
+//   - Updated (int): The number of bookmarks updated.
+//   - Failed (int): The number of rows that couldn't be imported.
+//   - Rows ([]LibraryImportRowResult): What importing each row did, in the order of the file.
+type LibraryImportReport struct {
+	Created int                      `json:"created"`
+	Updated int                      `json:"updated"`
+	Failed  int                      `json:"failed"`
+	Rows    []LibraryImportRowResult `json:"rows"`
+}
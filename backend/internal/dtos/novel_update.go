@@ -0,0 +1,32 @@
+package dtos
+
+// NovelUpdateRequest represents the request body for editing the metadata of a novel. The fields left out are not
+// changed when patching the novel, and are all required when replacing it.
+//
+// Fields:
+//   - Title (*string): The title of the novel.
+//   - Synopsis (*string): The synopsis of the novel.
+//   - CoverUrl (*string): The URL of the cover of the novel.
+//   - Language (*string): The language of the novel.
+//   - Status (*string): The status of the novel (e.g., "Ongoing", "Completed").
+//   - Year (*string): The year the novel was first released.
+//   - ReleaseFrequency (*string): How often new chapters are released.
+//   - Tags (*[]string): The names of the tags of the novel.
+//   - Genres (*[]string): The names of the genres of the novel.
+//   - Authors (*[]string): The names of the authors of the novel.
+//   - LockedFields (*[]string): The names of the fields the imports of the novel don't overwrite, among title,
+//     synopsis, coverUrl, language, status, year, releaseFrequency, tags, genres and authors. Left out to lock the
+//     edited fields in addition to the fields already locked.
+type NovelUpdateRequest struct {
+	Title            *string   `json:"title"`
+	Synopsis         *string   `json:"synopsis"`
+	CoverUrl         *string   `json:"coverUrl"`
+	Language         *string   `json:"language"`
+	Status           *string   `json:"status"`
+	Year             *string   `json:"year"`
+	ReleaseFrequency *string   `json:"releaseFrequency"`
+	Tags             *[]string `json:"tags"`
+	Genres           *[]string `json:"genres"`
+	Authors          *[]string `json:"authors"`
+	LockedFields     *[]string `json:"lockedFields"`
+}
@@ -0,0 +1,143 @@
+package dtos
+
+import "encoding/xml"
+
+const (
+	// OPDSNavigationType is the media type of the OPDS feeds listing other feeds.
+	OPDSNavigationType = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	// OPDSAcquisitionType is the media type of the OPDS feeds listing books.
+	OPDSAcquisitionType = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+	// OpenSearchDescriptionType is the media type of the OpenSearch description of the catalog.
+	OpenSearchDescriptionType = "application/opensearchdescription+xml"
+)
+
+// OPDSFeed represents an OPDS 1.2 catalog feed, an Atom feed listing either other feeds (navigation) or books
+// (acquisition).
+//
+// Fields:
+//   - ID (string): The unique identifier of the feed.
+//   - Title (string): The title of the feed.
+//   - Updated (string): When the feed was last updated, in RFC 3339 format.
+//   - Links ([]OPDSLink): The links of the feed (self, start, search and pagination).
+//   - TotalResults (int64): The total number of entries of the feed, across all pages.
+//   - ItemsPerPage (int): The number of entries per page.
+//   - StartIndex (int): The index of the first entry of the page, starting at 1.
+//   - Entries ([]OPDSEntry): The entries of the page.
+type OPDSFeed struct {
+	XMLName         xml.Name    `xml:"feed"`
+	Xmlns           string      `xml:"xmlns,attr"`
+	XmlnsDC         string      `xml:"xmlns:dc,attr"`
+	XmlnsOpenSearch string      `xml:"xmlns:opensearch,attr"`
+	XmlnsOPDS       string      `xml:"xmlns:opds,attr"`
+	XmlnsThr        string      `xml:"xmlns:thr,attr"`
+	ID              string      `xml:"id"`
+	Title           string      `xml:"title"`
+	Updated         string      `xml:"updated"`
+	Links           []OPDSLink  `xml:"link"`
+	TotalResults    int64       `xml:"opensearch:totalResults,omitempty"`
+	ItemsPerPage    int         `xml:"opensearch:itemsPerPage,omitempty"`
+	StartIndex      int         `xml:"opensearch:startIndex,omitempty"`
+	Entries         []OPDSEntry `xml:"entry"`
+}
+
+// OPDSEntry represents an entry of an OPDS feed, either a link to another feed or a book.
+//
+// Fields:
+//   - Title (string): The title of the entry.
+//   - ID (string): The unique identifier of the entry.
+//   - Updated (string): When the entry was last updated, in RFC 3339 format.
+//   - Authors ([]OPDSAuthor): The authors of the book.
+//   - Language (string): The language of the book.
+//   - Issued (string): The year the book was published.
+//   - Categories ([]OPDSCategory): The genres and tags of the book.
+//   - Summary (*OPDSContent): The synopsis of the book.
+//   - Content (*OPDSContent): The description of a feed entry.
+//   - Links ([]OPDSLink): The links of the entry (feed, acquisition and images).
+type OPDSEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Updated    string         `xml:"updated"`
+	Authors    []OPDSAuthor   `xml:"author"`
+	Language   string         `xml:"dc:language,omitempty"`
+	Issued     string         `xml:"dc:issued,omitempty"`
+	Categories []OPDSCategory `xml:"category"`
+	Summary    *OPDSContent   `xml:"summary,omitempty"`
+	Content    *OPDSContent   `xml:"content,omitempty"`
+	Links      []OPDSLink     `xml:"link"`
+}
+
+// OPDSLink represents a link of an OPDS feed or entry.
+//
+// Fields:
+//   - Rel (string): The relation of the link.
+//   - Href (string): The URL of the link.
+//   - Type (string): The media type of the resource linked to.
+//   - Title (string): The title of the link.
+//   - Count (int64): The number of entries of the feed linked to.
+type OPDSLink struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+	Count int64  `xml:"thr:count,attr,omitempty"`
+}
+
+// OPDSAuthor represents an author of a book.
+//
+// Fields:
+//   - Name (string): The name of the author.
+//   - URI (string): The URL of the feed of the books of the author.
+type OPDSAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+// OPDSCategory represents a genre or tag of a book.
+//
+// Fields:
+//   - Scheme (string): Whether the category is a genre or a tag.
+//   - Term (string): The name of the genre or tag.
+//   - Label (string): The name of the genre or tag, for display.
+type OPDSCategory struct {
+	Scheme string `xml:"scheme,attr,omitempty"`
+	Term   string `xml:"term,attr"`
+	Label  string `xml:"label,attr,omitempty"`
+}
+
+// OPDSContent represents a text of an entry.
+//
+// Fields:
+//   - Type (string): The type of the text ("text").
+//   - Text (string): The text.
+type OPDSContent struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// OpenSearchDescription represents the OpenSearch description of the catalog, telling the clients how to search it.
+//
+// Fields:
+//   - ShortName (string): The name of the catalog.
+//   - Description (string): The description of the search.
+//   - InputEncoding (string): The encoding of the search queries.
+//   - OutputEncoding (string): The encoding of the search results.
+//   - URLs ([]OpenSearchURL): The templates of the search URLs.
+type OpenSearchDescription struct {
+	XMLName        xml.Name        `xml:"OpenSearchDescription"`
+	Xmlns          string          `xml:"xmlns,attr"`
+	ShortName      string          `xml:"ShortName"`
+	Description    string          `xml:"Description"`
+	InputEncoding  string          `xml:"InputEncoding"`
+	OutputEncoding string          `xml:"OutputEncoding"`
+	URLs           []OpenSearchURL `xml:"Url"`
+}
+
+// OpenSearchURL represents a template of a search URL.
+//
+// Fields:
+//   - Type (string): The media type of the search results.
+//   - Template (string): The URL, with {searchTerms} standing for the query.
+type OpenSearchURL struct {
+	Type     string `xml:"type,attr"`
+	Template string `xml:"template,attr"`
+}
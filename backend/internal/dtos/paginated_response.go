@@ -7,3 +7,11 @@ type PaginatedResponse struct {
 	Limit      int         `json:"limit"` // Number of items per page
 	TotalPages int64       `json:"totalPages"`
 }
+
+// CursorPaginatedResponse is a page of a listing paginated by cursor, where each page continues after the last item
+// of the previous one instead of skipping a number of items.
+type CursorPaginatedResponse struct {
+	Data       interface{} `json:"data"`                 // The paginated data (e.g., list of novels)
+	Limit      int         `json:"limit"`                // Number of items per page
+	NextCursor string      `json:"nextCursor,omitempty"` // Cursor of the next page, empty on the last page
+}
@@ -0,0 +1,37 @@
+package dtos
+
+import (
+	"backend/internal/models"
+	"time"
+)
+
+// ReadingPositionRequest represents the request body for syncing the reading position of a novel from a device.
+//
+// Fields:
+//   - ChapterNo (uint): The number of the chapter being read.
+//   - Paragraph (int): The index of the paragraph being read in the chapter, starting at 0.
+//   - Percent (float64): How far the chapter has been scrolled through, from 0 to 100.
+//   - DeviceID (string): The identifier the client gives to the device.
+//   - ReadAt (*time.Time): When the position was reached on the device, now if left out. Devices that were offline
+//     send the time the position was reached so a later position from another device is kept.
+type ReadingPositionRequest struct {
+	ChapterNo uint       `json:"chapterNo"`
+	Paragraph int        `json:"paragraph"`
+	Percent   float64    `json:"percent"`
+	DeviceID  string     `json:"deviceId"`
+	ReadAt    *time.Time `json:"readAt"`
+}
+
+// ReadingPositionSyncResponse represents the result of syncing a reading position. The position read last wins: the
+// other one is returned as superseded so the client can offer to jump to it.
+//
+// Fields:
+//   - Position (models.ReadingPosition): The reading position kept.
+//   - Applied (bool): Whether the synced position was kept, false if a position reached later was already stored.
+//   - Superseded (*models.ReadingPosition): The losing position: the synced one if it was not kept, or the stored
+//     position it replaced if that one came from another device. Left out when there was no conflict.
+type ReadingPositionSyncResponse struct {
+	Position   models.ReadingPosition  `json:"position"`
+	Applied    bool                    `json:"applied"`
+	Superseded *models.ReadingPosition `json:"superseded,omitempty"`
+}
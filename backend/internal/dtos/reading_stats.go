@@ -0,0 +1,47 @@
+package dtos
+
+import "backend/internal/models"
+
+// ReadingStatsPeriod represents what a user read in a day or a week.
+//
+// Fields:
+//   - Period (string): The day (2006-01-02) or the ISO week (2006-W01).
+//   - Chapters (int64): The number of chapters finished.
+//   - Words (int64): The number of words of the chapters finished.
+//   - Seconds (int64): The time spent reading the chapters finished.
+type ReadingStatsPeriod struct {
+	Period   string `json:"period"`
+	Chapters int64  `json:"chapters"`
+	Words    int64  `json:"words"`
+	Seconds  int64  `json:"seconds"`
+}
+
+// ReadingStats represents the reading statistics of a user over a number of days, computed from their reading history.
+//
+// Fields:
+//   - From (string): The first day of the period.
+//   - To (string): The last day of the period, today.
+//   - Timezone (string): The timezone the days are counted in.
+//   - ChaptersRead (int64): The number of chapters finished in the period.
+//   - WordsRead (int64): The number of words of the chapters finished in the period.
+//   - SecondsRead (int64): The time spent reading the chapters finished in the period.
+//   - CurrentStreak (int): The number of consecutive days up to today or yesterday the user read on.
+//   - LongestStreak (int): The longest number of consecutive days the user read on in the last two years.
+//   - Days ([]ReadingStatsPeriod): What the user read on each day of the period, oldest first.
+//   - Weeks ([]ReadingStatsPeriod): What the user read on each week of the period, oldest first.
+//   - FavouriteGenres ([]models.ReadingShare): The genres the user finished the most chapters of in the period.
+//   - FavouriteTags ([]models.ReadingShare): The tags the user finished the most chapters of in the period.
+type ReadingStats struct {
+	From            string                `json:"from"`
+	To              string                `json:"to"`
+	Timezone        string                `json:"timezone"`
+	ChaptersRead    int64                 `json:"chaptersRead"`
+	WordsRead       int64                 `json:"wordsRead"`
+	SecondsRead     int64                 `json:"secondsRead"`
+	CurrentStreak   int                   `json:"currentStreak"`
+	LongestStreak   int                   `json:"longestStreak"`
+	Days            []ReadingStatsPeriod  `json:"days"`
+	Weeks           []ReadingStatsPeriod  `json:"weeks"`
+	FavouriteGenres []models.ReadingShare `json:"favouriteGenres"`
+	FavouriteTags   []models.ReadingShare `json:"favouriteTags"`
+}
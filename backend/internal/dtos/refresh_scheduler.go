@@ -0,0 +1,54 @@
+package dtos
+
+import "time"
+
+// RefreshRun represents the outcome of a run of a novel refresh schedule.
+//
+// Fields:
+//   - Schedule (string): The name of the schedule.
+//   - StartedAt (time.Time): When the run started.
+//   - FinishedAt (*time.Time): When the run finished, nil while it is running.
+//   - Checked (int): The number of novels refreshed.
+//   - Updated (int): The number of novels whose latest chapter increased.
+//   - NewChapters (int): The number of new chapters queued for import.
+//   - Failed (int): The number of novels that could not be refreshed.
+//   - Novels ([]NovelStatus): The status of the novels that were updated or failed.
+type RefreshRun struct {
+	Schedule    string        `json:"schedule"`
+	StartedAt   time.Time     `json:"startedAt"`
+	FinishedAt  *time.Time    `json:"finishedAt"`
+	Checked     int           `json:"checked"`
+	Updated     int           `json:"updated"`
+	NewChapters int           `json:"newChapters"`
+	Failed      int           `json:"failed"`
+	Novels      []NovelStatus `json:"novels"`
+}
+
+// RefreshScheduleStatus represents the status of a novel refresh schedule.
+//
+// Fields:
+//   - Name (string): The name of the schedule.
+//   - Cron (string): The cron expression of the schedule.
+//   - MaxReleaseInterval (float64): The longest release interval in days of the novels of the schedule, 0 if it takes
+//     the novels of every release frequency left.
+//   - NextRun (*time.Time): When the schedule runs next, nil if the scheduler is stopped.
+//   - LastRun (*RefreshRun): The last run of the schedule, nil if it never ran.
+type RefreshScheduleStatus struct {
+	Name               string      `json:"name"`
+	Cron               string      `json:"cron"`
+	MaxReleaseInterval float64     `json:"maxReleaseIntervalDays"`
+	NextRun            *time.Time  `json:"nextRun"`
+	LastRun            *RefreshRun `json:"lastRun"`
+}
+
+// RefreshSchedulerStatus represents the status of the novel refresh scheduler.
+//
+// Fields:
+//   - Running (bool): Whether the scheduler is started.
+//   - CurrentRun (*RefreshRun): The run in progress, nil if no schedule is running.
+//   - Schedules ([]RefreshScheduleStatus): The status of each schedule.
+type RefreshSchedulerStatus struct {
+	Running    bool                    `json:"running"`
+	CurrentRun *RefreshRun             `json:"currentRun"`
+	Schedules  []RefreshScheduleStatus `json:"schedules"`
+}
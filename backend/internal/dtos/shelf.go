@@ -0,0 +1,9 @@
+package dtos
+
+// ShelfRequest represents the body of a request creating or renaming a shelf.
+//
+// Fields:
+//   - Name (string): The name of the shelf.
+type ShelfRequest struct {
+	Name string `json:"name"`
+}
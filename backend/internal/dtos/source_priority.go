@@ -0,0 +1,22 @@
+package dtos
+
+// SourcePriorityRequest represents the request body for changing the source priority of a novel.
+//
+// Fields:
+//   - Sources ([]string): The names of the sources the chapters of the novel are fetched from first, in order. Empty
+//     to use the default order.
+type SourcePriorityRequest struct {
+	Sources []string `json:"sources"`
+}
+
+// SourcePriorityResponse represents the source priority of a novel.
+//
+// Fields:
+//   - Sources ([]string): The names of the sources the chapters of the novel are fetched from first, in order.
+//   - Order ([]string): The order the sources are tried in when importing a chapter of the novel.
+//   - Available ([]string): The names of the registered sources, in default order.
+type SourcePriorityResponse struct {
+	Sources   []string `json:"sources"`
+	Order     []string `json:"order"`
+	Available []string `json:"available"`
+}
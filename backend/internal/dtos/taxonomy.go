@@ -0,0 +1,17 @@
+package dtos
+
+// TaxonomyDescriptionRequest represents the request body for changing the description of a genre or tag.
+//
+// Fields:
+//   - Description (string): The new description, at most 1000 characters long.
+type TaxonomyDescriptionRequest struct {
+	Description string `json:"description"`
+}
+
+// TaxonomyMergeRequest represents the request body for merging an entry of a taxonomy into another.
+//
+// Fields:
+//   - TargetID (uint): The ID of the entry the novels are moved to and which is kept.
+type TaxonomyMergeRequest struct {
+	TargetID uint `json:"targetId" binding:"required"`
+}
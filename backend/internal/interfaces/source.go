@@ -0,0 +1,93 @@
+package interfaces
+
+import "backend/internal/models"
+
+// Source is an interface that defines the operations a novel source (a website or an adapter around an external
+// scraper) must provide so novels and chapters can be imported from it.
+type Source interface {
+	// Name returns the unique name of the source, used as its key in the source registry.
+	//
+	// Returns:
+	//   - string (The name of the source)
+	Name() string
+
+	// FetchNovelMetadata fetches the metadata of a novel.
+	//
+	// Parameters:
+	//   - novelID string (The NovelUpdates ID of the novel)
+	//
+	// Returns:
+	//   - *models.ImportedNovel (The metadata of the novel)
+	//   - error (errors.ErrNovelNotFound if the source doesn't have the novel, errors.ErrSourceUnsupported if the
+	//     source can't provide novel metadata, or any other error that occurred while fetching or parsing)
+	FetchNovelMetadata(novelID string) (*models.ImportedNovel, error)
+
+	// FetchChapter fetches a single chapter of a novel.
+	//
+	// Parameters:
+	//   - novelID string (The NovelUpdates ID of the novel)
+	//   - chapterNo int (The number of the chapter)
+	//
+	// Returns:
+	//   - *models.ImportedChapterMetadata (The chapter, including the URL it was fetched from)
+	//   - error (errors.ErrChapterNotFound if the source doesn't have the chapter, errors.ErrSourceUnsupported if the
+	//     source can't provide chapters, or any other error that occurred while fetching or parsing)
+	FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error)
+
+	// ListChapters lists the chapters of a novel that are available on the source.
+	//
+	// Parameters:
+	//   - novelID string (The NovelUpdates ID of the novel)
+	//
+	// Returns:
+	//   - []models.ImportedChapterListing (The chapters available on the source, ordered by chapter number)
+	//   - error (errors.ErrNovelNotFound if the source doesn't have the novel, errors.ErrSourceUnsupported if the
+	//     source can't list chapters, or any other error that occurred while fetching or parsing)
+	ListChapters(novelID string) ([]models.ImportedChapterListing, error)
+}
+
+// SourceSelector is an interface implemented by the sources that group other sources, like the source registry, so a
+// chapter can be fetched from a chosen list of them.
+type SourceSelector interface {
+	// Names returns the names of the grouped sources in fallback order.
+	//
+	// Returns:
+	//   - []string (The names of the sources)
+	Names() []string
+
+	// FetchChapterFrom fetches a chapter from the first of the given sources that provides it.
+	//
+	// Parameters:
+	//   - names []string (The names of the sources to try, in order)
+	//   - novelID string (The NovelUpdates ID of the novel)
+	//   - chapterNo int (The number of the chapter)
+	//
+	// Returns:
+	//   - *models.ImportedChapterMetadata (The chapter, including the name of the source it was fetched from)
+	//   - error (errors.ErrSourceNotRegistered if one of the names is unknown, or the error of the sources if all of
+	//     them failed)
+	FetchChapterFrom(names []string, novelID string, chapterNo int) (*models.ImportedChapterMetadata, error)
+}
+
+// Fetcher is an interface that defines a method for downloading a web page.
+type Fetcher interface {
+	// Fetch downloads the page at the given URL.
+	//
+	// Parameters:
+	//   - url string (The URL of the page)
+	//
+	// Returns:
+	//   - []byte (The body of the page)
+	//   - int (The HTTP status code of the response)
+	//   - error (An error if the page could not be downloaded)
+	Fetch(url string) ([]byte, int, error)
+}
+
+// SourceStatusProvider is an interface that defines a method for reporting the limits and the health of the sources.
+type SourceStatusProvider interface {
+	// SourceStatuses returns the status of each source whose requests are limited.
+	//
+	// Returns:
+	//   - []models.SourceStatus (The status of the sources, in registration order)
+	SourceStatuses() []models.SourceStatus
+}
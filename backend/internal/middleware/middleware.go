@@ -57,59 +57,91 @@ func (m *Middleware) AuthMiddleware() gin.HandlerFunc {
 	}
 
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Missing or invalid Authorization header"})
+		user, message := m.authenticate(c, secretKey)
+		if message != "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": message})
 			c.Abort()
 			return
 		}
 
-		// Extract the token
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-		token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(secretKey), nil
-		})
+		// Set the user in the Gin context
+		c.Set("user", user)
+		c.Next()
+	}
+}
 
-		if err != nil || !token.Valid {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
-			c.Abort()
-			return
+// authenticate verifies the JWT in the Authorization header of a request and fetches the user it was issued to.
+//
+// Parameters:
+//   - c (*gin.Context): The Gin context of the request.
+//   - secretKey (string): The key the JWT must be signed with.
+//
+// Returns:
+//   - *models.User: The authenticated user, nil if the authentication failed.
+//   - string: Why the authentication failed, empty if it succeeded.
+func (m *Middleware) authenticate(c *gin.Context, secretKey string) (*models.User, string) {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil, "Missing or invalid Authorization header"
+	}
+
+	// Extract the token
+	tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
 		}
+		return []byte(secretKey), nil
+	})
 
-		// Validate token claims
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			expiration, ok := claims["exp"].(float64)
-			if !ok || time.Unix(int64(expiration), 0).Before(time.Now()) {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Token has expired"})
-				c.Abort()
-				return
-			}
+	if err != nil || !token.Valid {
+		return nil, "Invalid or expired token"
+	}
 
-			// Extract user information from claims
-			userID, ok := claims["user_id"].(float64)
-			if !ok {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
-				c.Abort()
-				return
-			}
+	// Validate token claims
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, "Invalid token claims"
+	}
 
-			// Fetch the user from the database (or wherever you store user data)
-			user, err := m.userService.GetUser(uint(userID))
-			if err != nil {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
-				c.Abort()
-				return
-			}
+	expiration, ok := claims["exp"].(float64)
+	if !ok || time.Unix(int64(expiration), 0).Before(time.Now()) {
+		return nil, "Token has expired"
+	}
+
+	// Extract user information from claims
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return nil, "Invalid user ID in token"
+	}
+
+	// Fetch the user from the database (or wherever you store user data)
+	user, err := m.userService.GetUser(uint(userID))
+	if err != nil {
+		return nil, "User not found"
+	}
+
+	return user, ""
+}
+
+// OptionalAuthMiddleware is a Gin middleware function that authenticates users like AuthMiddleware when the request has
+// a valid token, and lets the other requests through anonymously, without a user in the Gin context. An expired or
+// invalid token is ignored rather than rejected, so that a reader whose session ran out can still read.
+//
+// Parameters:
+//   - m (*Middleware): The middleware instance containing dependencies like userService.
+//
+// Returns:
+//   - gin.HandlerFunc: A Gin middleware handler function.
+func (m *Middleware) OptionalAuthMiddleware() gin.HandlerFunc {
+	secretKey := os.Getenv("SECRET_KEY")
+	if secretKey == "" {
+		log.Fatal("SECRET_KEY not set in environment variables")
+	}
 
-			// Set the user in the Gin context
+	return func(c *gin.Context) {
+		if user, message := m.authenticate(c, secretKey); message == "" {
 			c.Set("user", user)
-		} else {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
-			c.Abort()
-			return
 		}
 
 		c.Next()
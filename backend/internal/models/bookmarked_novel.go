@@ -1,6 +1,8 @@
 package models
 
 import (
+	"strings"
+
 	"gorm.io/gorm"
 )
 
@@ -13,16 +15,50 @@ import (
 //   - UserID (int): The ID of the user who bookmarked the novel. The constraint `OnUpdate:CASCADE,OnDelete:SET NULL;`
 //
 // ensures that if the user is updated or deleted, the corresponding entry in this table is updated or set to NULL respectively.
-//   - Status (string): The status of the bookmarked novel (e.g., "reading", "completed", "dropped").  This field is
+//   - Status (string): The status of the bookmarked novel, one of BookmarkStatuses.  This field is
 //
 // required (`not null`).
-//   - Score (int): The user's rating of the novel (0-5). Defaults to 0.
+//   - Score (int): The user's rating of the novel (0-5, 0 meaning not rated). Defaults to 0.
 //   - CurrentChapter (int): The chapter the user has currently reached. Defaults to 0.
 type BookmarkedNovel struct {
 	gorm.Model
 	NovelID int `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"novelId"`
 	UserID int `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"userId"`
 	Status string `gorm:"not null" json:"status"`
-	Score int `gorm:"default:0;max:5" json:"score"`
+	Score int `gorm:"default:0" json:"score"`
 	CurrentChapter int `gorm:"default:0" json:"currentChapter"`
 }
+
+// The statuses of a bookmarked novel.
+const (
+	// BookmarkStatusReading is the status of a novel the user is reading.
+	BookmarkStatusReading = "reading"
+	// BookmarkStatusCompleted is the status of a novel the user read to its last chapter.
+	BookmarkStatusCompleted = "completed"
+	// BookmarkStatusOnHold is the status of a novel the user paused.
+	BookmarkStatusOnHold = "on-hold"
+	// BookmarkStatusDropped is the status of a novel the user stopped reading.
+	BookmarkStatusDropped = "dropped"
+	// BookmarkStatusPlanToRead is the status of a novel the user intends to read.
+	BookmarkStatusPlanToRead = "plan-to-read"
+)
+
+// BookmarkStatuses are the statuses a bookmarked novel can have.
+var BookmarkStatuses = []string{
+	BookmarkStatusReading,
+	BookmarkStatusCompleted,
+	BookmarkStatusOnHold,
+	BookmarkStatusDropped,
+	BookmarkStatusPlanToRead,
+}
+
+// NormalizeBookmarkStatus turns a status written for people, as the clients and the other trackers do (e.g., "Plan to
+// Read" or "on_hold"), into one of BookmarkStatuses: it's lowercased and its words are joined with dashes. Statuses
+// that aren't known are returned normalized too, so validating them still fails.
+func NormalizeBookmarkStatus(status string) string {
+	words := strings.FieldsFunc(strings.ToLower(status), func(r rune) bool { return r == ' ' || r == '_' || r == '-' })
+	return strings.Join(words, "-")
+}
+
+// MaxBookmarkScore is the highest score a user can rate a novel with. A score of 0 means the novel isn't rated.
+const MaxBookmarkScore = 5
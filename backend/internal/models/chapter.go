@@ -1,6 +1,28 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ManualChapterSource is the source of the chapters written by moderators instead of being imported.
+const ManualChapterSource = "manual"
+
+// ManualChapterUrl returns the URL stored for a chapter written by hand, which has no page on a source. It identifies
+// the chapter by the time it was written rather than by its number, which changes when chapters are moved.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - writtenAt (time.Time): The time the chapter was written.
+//
+// Returns:
+//   - string: The URL, such as manual://lord-of-the-mysteries/1700000000000000000.
+func ManualChapterUrl(novelUpdatesID string, writtenAt time.Time) string {
+	return fmt.Sprintf("%s://%s/%d", ManualChapterSource, novelUpdatesID, writtenAt.UnixNano())
+}
 
 // Chapter represents a chapter in a novel.
 //
@@ -10,6 +32,8 @@ import "gorm.io/gorm"
 //  - Title (string): The title of the chapter (max 255 characters).  Cannot be empty.
 //  - ChapterUrl (string): The URL of the chapter (max 255 characters). Must be unique. Cannot be empty.
 //  - Body (string): The content of the chapter. Cannot be empty.
+//  - Source (string): The name of the source the chapter was fetched from (max 50 characters).
+//  - WordCount (int): The number of words of the body, counted when the chapter is saved.
 type Chapter struct {
 	gorm.Model
 	ChapterNo  uint   `gorm:"index,default:0" json:"chapterNo"`
@@ -17,6 +41,31 @@ type Chapter struct {
 	Title      string `gorm:"size:255;not null" json:"title"`
 	ChapterUrl string `gorm:"size:255;not null,uniqueIndex" json:"chapterUrl"`
 	Body       string `gorm:"not null" json:"body"`
+	Source     string `gorm:"size:50" json:"source"`
+	WordCount  int    `gorm:"default:0" json:"wordCount"`
+}
+
+// BeforeSave counts the words of the body before the chapter is stored.
+//
+// Parameters:
+//  - tx (*gorm.DB): The transaction saving the chapter.
+//
+// Returns:
+//   - error: Always nil.
+func (c *Chapter) BeforeSave(tx *gorm.DB) error {
+	c.WordCount = CountWords(c.Body)
+	return nil
+}
+
+// CountWords counts the words of a text, as the runs of characters between whitespace.
+//
+// Parameters:
+//  - text (string): The text.
+//
+// Returns:
+//   - int: The number of words.
+func CountWords(text string) int {
+	return len(strings.Fields(text))
 }
 
 // ImportedChapter represents a chapter imported from an external source.
@@ -27,12 +76,14 @@ type Chapter struct {
 //   - Title (string): The title of the chapter.
 //   - ChapterUrl (string): The URL where the chapter was originally sourced from.
 //   - Body (string): The content of the chapter.
+//   - Source (string): The name of the source the chapter was fetched from.
 type ImportedChapter struct {
 	ID         uint   `json:"id"`
 	NovelID    *uint  `json:"novel_id"`
 	Title      string `json:"title"`
 	ChapterUrl string `json:"url"`
 	Body       string `json:"body"`
+	Source     string `json:"source"`
 }
 
 // ImportedChapterMetadata represents metadata for an imported chapter.
@@ -42,11 +93,25 @@ type ImportedChapter struct {
 //  - ChapterUrl (string): The URL of the chapter. Required.
 //  - Body (string): The body content of the chapter. Required.
 //  - ID (uint): The unique identifier of the chapter.
+//  - Source (string): The name of the source the chapter was fetched from.
 type ImportedChapterMetadata struct {
 	Title      string `json:"title" binding:"required"`
 	ChapterUrl string `json:"url" binding:"required"`
 	Body       string `json:"body" binding:"required"`
 	ID         uint   `json:"id"`
+	Source     string `json:"source"`
+}
+
+// ImportedChapterListing represents an entry of the chapter list of a novel on an external source.
+//
+// Fields:
+//  - ChapterNo (uint): The number of the chapter.
+//  - Title (string): The title of the chapter.
+//  - ChapterUrl (string): The URL of the chapter on the source.
+type ImportedChapterListing struct {
+	ChapterNo  uint   `json:"chapterNo"`
+	Title      string `json:"title"`
+	ChapterUrl string `json:"url"`
 }
 
 // ToChapter converts an ImportedChapter to a Chapter.
@@ -63,5 +128,6 @@ func (c *ImportedChapter) ToChapter() *Chapter {
 		Title:      c.Title,
 		ChapterUrl: c.ChapterUrl,
 		Body:       c.Body,
+		Source:     c.Source,
 	}
 }
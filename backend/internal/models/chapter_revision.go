@@ -0,0 +1,36 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	// RevisionOriginImport is the origin of the first revision of a chapter, holding the content it was imported with.
+	RevisionOriginImport = "import"
+	// RevisionOriginRescrape is the origin of a revision created by scraping the chapter again.
+	RevisionOriginRescrape = "rescrape"
+	// RevisionOriginManual is the origin of a revision written by a moderator.
+	RevisionOriginManual = "manual"
+)
+
+// ChapterRevision represents a version of the content of a chapter. The content of the current revision is the one
+// stored in the chapter and served to readers.
+//
+// Fields:
+//   - ChapterID (uint): The ID of the chapter the revision belongs to.
+//   - Revision (int): The number of the revision, starting at 1 for each chapter.
+//   - Title (string): The title of the chapter in this revision.
+//   - ChapterUrl (string): The URL the content of this revision was scraped from.
+//   - Body (string): The content of the chapter in this revision.
+//   - Source (string): The name of the source the content of this revision was scraped from.
+//   - Origin (string): How the revision was created ("import", "rescrape" or "manual").
+//   - Current (bool): Whether this is the revision served to readers.
+type ChapterRevision struct {
+	gorm.Model
+	ChapterID  uint   `gorm:"uniqueIndex:idx_chapter_revision;not null" json:"chapterId"`
+	Revision   int    `gorm:"uniqueIndex:idx_chapter_revision;not null" json:"revision"`
+	Title      string `gorm:"size:255;not null" json:"title"`
+	ChapterUrl string `gorm:"size:255" json:"chapterUrl"`
+	Body       string `gorm:"not null" json:"body"`
+	Source     string `gorm:"size:50" json:"source"`
+	Origin     string `gorm:"size:20;not null" json:"origin"`
+	Current    bool   `gorm:"default:false" json:"current"`
+}
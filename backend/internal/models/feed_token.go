@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// FeedToken represents the private token a user subscribes to the feed of the new chapters of their library with.
+// Feed readers can't send the Authorization header, so the token is part of the URL of the feed instead.
+//
+// Fields:
+//   - ID (uint): The unique identifier for the feed token.
+//   - UserID (uint): The ID of the user the token belongs to. A user has at most one token.
+//   - Token (string): The token itself. Must be unique.
+//   - CreatedAt (time.Time): The time the token was created (automatically updated by GORM).
+//   - UpdatedAt (time.Time): The time the token was last regenerated (automatically updated by GORM).
+type FeedToken struct {
+	ID        uint      `gorm:"primaryKey" json:"-"`
+	UserID    uint      `gorm:"uniqueIndex;not null" json:"userId"`
+	Token     string    `gorm:"size:64;uniqueIndex;not null" json:"token"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
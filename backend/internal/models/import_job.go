@@ -0,0 +1,134 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ImportJobQueued is the state of a job waiting for the runner to pick it up.
+	ImportJobQueued = "queued"
+	// ImportJobRunning is the state of a job whose chapters are being imported.
+	ImportJobRunning = "running"
+	// ImportJobCompleted is the state of a job whose chapters were all processed.
+	ImportJobCompleted = "completed"
+	// ImportJobFailed is the state of a job that stopped because of an error that is not specific to a chapter.
+	ImportJobFailed = "failed"
+	// ImportJobCancelled is the state of a job that was cancelled by a user.
+	ImportJobCancelled = "cancelled"
+)
+
+const (
+	// ChapterPending is the status of a chapter that wasn't processed yet.
+	ChapterPending = "to download"
+	// ChapterDownloading is the status of a chapter being imported.
+	ChapterDownloading = "downloading"
+	// ChapterRetrying is the status of a chapter waiting to be imported again after a transient error.
+	ChapterRetrying = "retrying"
+	// ChapterDownloaded is the status of a chapter that was imported and saved.
+	ChapterDownloaded = "downloaded"
+	// ChapterSkipped is the status of a chapter that already existed.
+	ChapterSkipped = "skipped"
+	// ChapterError is the status of a chapter whose import failed.
+	ChapterError = "error"
+)
+
+const (
+	// ChapterKindGap is the kind of a missing chapter numbered below the latest stored chapter of the novel.
+	ChapterKindGap = "gap"
+	// ChapterKindNew is the kind of a chapter numbered above the latest stored chapter of the novel.
+	ChapterKindNew = "new"
+)
+
+// ImportJob represents a background import of a range of chapters of a novel.
+//
+// Fields:
+//   - NovelID (uint): The ID of the novel the chapters are imported into.
+//   - NovelUpdatesID (string): The NovelUpdates ID of the novel, used to fetch the chapters from the sources.
+//   - FromChapter (int): The first chapter of the range to import.
+//   - ToChapter (int): The last chapter of the range to import.
+//   - Delta (bool): Whether the job only contains the chapters of the range that were missing when it was created.
+//   - State (string): The state of the job (queued, running, completed, failed or cancelled).
+//   - Error (string): The error that made the job fail, if any.
+//   - StartedAt (*time.Time): When the job last started running.
+//   - FinishedAt (*time.Time): When the job last stopped running.
+//   - Chapters ([]ImportJobChapter): The outcome of each chapter of the range.
+//   - Sources ([]SourceStatus): The limits and the health of the sources the chapters are imported from, not stored.
+type ImportJob struct {
+	gorm.Model
+	NovelID        uint               `gorm:"index;not null" json:"novelId"`
+	NovelUpdatesID string             `gorm:"size:255;not null" json:"novelUpdatesId"`
+	FromChapter    int                `gorm:"not null" json:"fromChapter"`
+	ToChapter      int                `gorm:"not null" json:"toChapter"`
+	Delta          bool               `gorm:"default:false" json:"delta"`
+	State          string             `gorm:"size:20;index;not null" json:"state"`
+	Error          string             `json:"error,omitempty"`
+	StartedAt      *time.Time         `json:"startedAt"`
+	FinishedAt     *time.Time         `json:"finishedAt"`
+	Chapters       []ImportJobChapter `gorm:"constraint:OnDelete:CASCADE;" json:"chapters,omitempty"`
+	Sources        []SourceStatus     `gorm:"-" json:"sources,omitempty"`
+}
+
+// ImportJobChapter represents the outcome of a single chapter of an ImportJob.
+//
+// Fields:
+//   - ID (uint): The unique identifier of the entry.
+//   - ImportJobID (uint): The ID of the job the chapter belongs to.
+//   - ChapterNo (int): The number of the chapter.
+//   - Kind (string): Whether the chapter fills a gap or is newer than the stored chapters, only set by delta jobs.
+//   - Status (string): The status of the chapter (e.g., "to download", "downloaded", "error").
+//   - Attempts (int): How many times the import of the chapter was attempted.
+//   - Message (string): The error of the last attempt, if any.
+//   - ErrorCode (string): The class of the error of the last attempt (e.g., "CHAPTER_NOT_FOUND", "SOURCE_TIMEOUT").
+//   - Retryable (bool): Whether the error of the last attempt is transient, so importing the chapter again may work.
+//   - UpdatedAt (time.Time): When the entry was last updated.
+type ImportJobChapter struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	ImportJobID uint      `gorm:"uniqueIndex:idx_import_job_chapter;not null" json:"-"`
+	ChapterNo   int       `gorm:"uniqueIndex:idx_import_job_chapter;not null" json:"chapterNo"`
+	Kind        string    `gorm:"size:10" json:"kind,omitempty"`
+	Status      string    `gorm:"size:20;not null" json:"status"`
+	Attempts    int       `gorm:"default:0" json:"attempts"`
+	Message     string    `json:"message,omitempty"`
+	ErrorCode   string    `gorm:"size:50" json:"errorCode,omitempty"`
+	Retryable   bool      `gorm:"default:false" json:"retryable"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ChapterRange represents an inclusive range of chapter numbers.
+//
+// Fields:
+//   - From (int): The first chapter of the range.
+//   - To (int): The last chapter of the range.
+type ChapterRange struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+// Len returns the number of chapters in the range.
+//
+// Returns:
+//   - int: The number of chapters.
+func (r ChapterRange) Len() int {
+	return r.To - r.From + 1
+}
+
+// IsFinished reports whether the job reached a state it won't leave unless it is resumed.
+//
+// Returns:
+//   - bool: True if the job is completed, failed or cancelled.
+func (j *ImportJob) IsFinished() bool {
+	return j.State == ImportJobCompleted || j.State == ImportJobFailed || j.State == ImportJobCancelled
+}
+
+// IsChapterDone reports whether a chapter doesn't need to be imported again when its job is resumed.
+//
+// Parameters:
+//   - status (string): The status of the chapter.
+//
+// Returns:
+//   - bool: True if the chapter was downloaded or skipped.
+func IsChapterDone(status string) bool {
+	return status == ChapterDownloaded || status == ChapterSkipped
+}
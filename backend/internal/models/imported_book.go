@@ -0,0 +1,39 @@
+package models
+
+// ImportedBook represents a novel read from an uploaded book file, like an EPUB.
+//
+// Fields:
+//   - Title (string): The title of the novel.
+//   - Synopsis (string): A description of the novel, without HTML.
+//   - Language (string): The language of the novel.
+//   - Year (string): The year the book was published, if known.
+//   - Authors ([]Author): The authors of the novel.
+//   - Tags ([]Tag): The subjects of the book.
+//   - Cover ([]byte): The cover image, nil if the book has none.
+//   - CoverExtension (string): The file extension of the cover image (e.g., ".jpg").
+//   - Chapters ([]ImportedBookChapter): The documents of the book, in reading order.
+type ImportedBook struct {
+	Title          string                `json:"title"`
+	Synopsis       string                `json:"synopsis"`
+	Language       string                `json:"language"`
+	Year           string                `json:"year"`
+	Authors        []Author              `json:"authors"`
+	Tags           []Tag                 `json:"tags"`
+	Cover          []byte                `json:"-"`
+	CoverExtension string                `json:"-"`
+	Chapters       []ImportedBookChapter `json:"chapters"`
+}
+
+// ImportedBookChapter represents a document of the reading order of a book.
+//
+// Fields:
+//   - Href (string): The path of the document inside the book.
+//   - Title (string): The title of the chapter, from the table of contents or the headings of the document.
+//   - Body (string): The text of the chapter, one paragraph per line.
+//   - Err (error): Why the document could not be read as a chapter, nil if it could.
+type ImportedBookChapter struct {
+	Href  string `json:"href"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Err   error  `json:"-"`
+}
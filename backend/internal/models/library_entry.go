@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// LibraryEntry represents a bookmark of a user joined with the novel bookmarked, as listed in their library.
+//
+// Fields:
+//   - BookmarkedNovel (BookmarkedNovel): The bookmark, with its status, score and current chapter.
+//   - Novel (Novel): The novel bookmarked.
+//   - LastReadAt (*time.Time): When the user last synced their reading position in the novel, if they ever did.
+//   - UnreadChapters (int): The number of chapters after the current chapter, up to the latest chapter stored.
+//   - LastActivityAt (time.Time): When the user last read the novel or changed the bookmark.
+type LibraryEntry struct {
+	BookmarkedNovel
+	Novel          Novel      `gorm:"-" json:"novel"`
+	LastReadAt     *time.Time `json:"lastReadAt,omitempty"`
+	UnreadChapters int        `gorm:"-" json:"unreadChapters"`
+	LastActivityAt time.Time  `gorm:"-" json:"lastActivityAt"`
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"slices"
+	"strings"
+
 	"gorm.io/gorm"
 )
 
@@ -20,6 +23,10 @@ import (
 //   - Year (string): The year the novel was published or first released. Required field.
 //   - ReleaseFrequency (string): How often new chapters are released (e.g., "Weekly", "Daily"). Required field. Maximum length 255 characters.
 //   - LatestChapter (int): The number of the latest chapter. Required field.
+//   - SourcePriority (string): The comma separated names of the sources the chapters of the novel are fetched from
+//     first, in order. The other sources are tried afterwards. Maximum length 255 characters.
+//   - LockedFields (string): The comma separated names of the fields corrected by the administrators, which the imports
+//     of the novel don't overwrite. Maximum length 255 characters.
 type Novel struct {
 	gorm.Model
 	Title            string   `gorm:"size:200;uniqueIndex" json:"title"`
@@ -35,6 +42,111 @@ type Novel struct {
 	Year             string   `gorm:"not null" json:"year"`
 	ReleaseFrequency string   `gorm:"size:255;not null" json:"releaseFrequency"`
 	LatestChapter    int      `gorm:"not null" json:"latestChapter"`
+	SourcePriority   string   `gorm:"size:255" json:"sourcePriority"`
+	LockedFields     string   `gorm:"size:255" json:"lockedFields"`
+}
+
+// The fields of a novel the administrators can edit and lock, named as in its JSON representation.
+const (
+	NovelFieldTitle            = "title"
+	NovelFieldSynopsis         = "synopsis"
+	NovelFieldCoverUrl         = "coverUrl"
+	NovelFieldLanguage         = "language"
+	NovelFieldStatus           = "status"
+	NovelFieldYear             = "year"
+	NovelFieldReleaseFrequency = "releaseFrequency"
+	NovelFieldTags             = "tags"
+	NovelFieldGenres           = "genres"
+	NovelFieldAuthors          = "authors"
+)
+
+// LockableNovelFields are the fields of a novel that can be locked against the updates of the imports, in order.
+var LockableNovelFields = []string{
+	NovelFieldTitle,
+	NovelFieldSynopsis,
+	NovelFieldCoverUrl,
+	NovelFieldLanguage,
+	NovelFieldStatus,
+	NovelFieldYear,
+	NovelFieldReleaseFrequency,
+	NovelFieldTags,
+	NovelFieldGenres,
+	NovelFieldAuthors,
+}
+
+// GetSourcePriority returns the names of the sources the chapters of the novel are fetched from first.
+//
+// Returns:
+//   - []string: The names of the sources, in order. Empty if the novel uses the default order.
+func (n *Novel) GetSourcePriority() []string {
+	var names []string
+	for _, name := range strings.Split(n.SourcePriority, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// SetSourcePriority sets the names of the sources the chapters of the novel are fetched from first.
+//
+// Parameters:
+//   - names ([]string): The names of the sources, in order. Empty to use the default order.
+func (n *Novel) SetSourcePriority(names []string) {
+	n.SourcePriority = strings.Join(names, ",")
+}
+
+// GetLockedFields returns the names of the fields of the novel the imports don't overwrite.
+//
+// Returns:
+//   - []string: The names of the locked fields, in the order of LockableNovelFields.
+func (n *Novel) GetLockedFields() []string {
+	var fields []string
+	for _, field := range LockableNovelFields {
+		if n.IsFieldLocked(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// SetLockedFields sets the names of the fields of the novel the imports don't overwrite.
+//
+// Parameters:
+//   - fields ([]string): The names of the locked fields. Empty to unlock every field.
+func (n *Novel) SetLockedFields(fields []string) {
+	var locked []string
+	for _, field := range LockableNovelFields {
+		if slices.Contains(fields, field) {
+			locked = append(locked, field)
+		}
+	}
+	n.LockedFields = strings.Join(locked, ",")
+}
+
+// IsFieldLocked reports whether the imports of the novel don't overwrite a field.
+//
+// Parameters:
+//   - field (string): The name of the field, one of LockableNovelFields.
+//
+// Returns:
+//   - bool: True if the field is locked.
+func (n *Novel) IsFieldLocked(field string) bool {
+	for _, name := range strings.Split(n.LockedFields, ",") {
+		if strings.TrimSpace(name) == field {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCompleted reports whether the novel finished publishing, so its latest chapter is its last one. The statuses
+// scraped from NovelUpdates mention it among other details, such as "1432 Chapters (Completed)".
+//
+// Returns:
+//   - bool: True if the novel is completed.
+func (n *Novel) IsCompleted() bool {
+	return strings.Contains(strings.ToLower(n.Status), "completed")
 }
 
 // ImportedNovel represents a novel imported from an external source.
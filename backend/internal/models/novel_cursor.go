@@ -0,0 +1,15 @@
+package models
+
+// NovelCursor represents the position of a novel in a sorted listing, the next page of the listing starting after it.
+//
+// Fields:
+//   - Sort (string): The NovelSort of the listing.
+//   - Descending (bool): Whether the listing is sorted in descending order.
+//   - Value (string): The value the novel is sorted by, empty when sorted by the order they were added.
+//   - ID (uint): The ID of the novel, which orders the novels with the same value.
+type NovelCursor struct {
+	Sort       string `json:"s,omitempty"`
+	Descending bool   `json:"d,omitempty"`
+	Value      string `json:"v,omitempty"`
+	ID         uint   `json:"i"`
+}
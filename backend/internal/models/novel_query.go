@@ -0,0 +1,65 @@
+package models
+
+// The orders novels can be sorted in.
+const (
+	NovelSortTitle         = "title"
+	NovelSortCreated       = "created"
+	NovelSortUpdated       = "updated"
+	NovelSortLatestChapter = "latest_chapter"
+	NovelSortYear          = "year"
+	NovelSortPopularity    = "popularity"
+)
+
+// The ways the included tags, genres and authors of a NovelQuery are matched.
+const (
+	NovelMatchAll = "all"
+	NovelMatchAny = "any"
+)
+
+// NovelQuery represents the filters and the order of a listing of novels. Its zero value lists every novel in the
+// order they were added.
+//
+// Fields:
+//   - IncludeTags ([]string): The names of the tags the novels must have.
+//   - ExcludeTags ([]string): The names of the tags the novels must not have.
+//   - IncludeGenres ([]string): The names of the genres the novels must have.
+//   - ExcludeGenres ([]string): The names of the genres the novels must not have.
+//   - IncludeAuthors ([]string): The names of the authors the novels must be by.
+//   - ExcludeAuthors ([]string): The names of the authors the novels must not be by.
+//   - Match (string): NovelMatchAll if the novels must have all the included tags, genres and authors of each kind, or
+//     NovelMatchAny if one of each kind is enough. Empty means NovelMatchAll.
+//   - Status (string): The status the novels must have (e.g. "Completed"), ignoring case.
+//   - Language (string): The language the novels must be in, ignoring case.
+//   - YearFrom (int): The first year the novels may be from, or 0.
+//   - YearTo (int): The last year the novels may be from, or 0.
+//   - MinChapters (int): The minimum latest chapter of the novels, or 0.
+//   - Sort (string): The NovelSort the novels are sorted by. Empty means the order they were added.
+//   - Descending (bool): Whether the novels are sorted in descending order.
+type NovelQuery struct {
+	IncludeTags    []string
+	ExcludeTags    []string
+	IncludeGenres  []string
+	ExcludeGenres  []string
+	IncludeAuthors []string
+	ExcludeAuthors []string
+	Match          string
+	Status         string
+	Language       string
+	YearFrom       int
+	YearTo         int
+	MinChapters    int
+	Sort           string
+	Descending     bool
+}
+
+// IsEmpty reports whether the query neither filters nor sorts the novels.
+//
+// Returns:
+//   - bool: true if the query lists every novel in the order they were added.
+func (q *NovelQuery) IsEmpty() bool {
+	return len(q.IncludeTags) == 0 && len(q.ExcludeTags) == 0 &&
+		len(q.IncludeGenres) == 0 && len(q.ExcludeGenres) == 0 &&
+		len(q.IncludeAuthors) == 0 && len(q.ExcludeAuthors) == 0 &&
+		q.Status == "" && q.Language == "" && q.YearFrom == 0 && q.YearTo == 0 && q.MinChapters == 0 &&
+		q.Sort == "" && !q.Descending
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+const (
+	// ReadingEventOpened is the event of a user fetching a chapter.
+	ReadingEventOpened = "opened"
+	// ReadingEventFinished is the event of a user moving on from a chapter to a later chapter of the same novel.
+	ReadingEventFinished = "finished"
+)
+
+// ReadingEvent represents an entry of the reading history of a user, recorded when they fetch chapters.
+//
+// Fields:
+//   - ID (uint): The unique identifier for the event.
+//   - UserID (uint): The ID of the user reading.
+//   - NovelID (uint): The ID of the novel of the chapter.
+//   - ChapterNo (uint): The number of the chapter.
+//   - Event (string): What happened to the chapter ("opened" or "finished").
+//   - WordCount (int): The number of words of the chapter when it was read.
+//   - Seconds (int): The time spent reading the chapter, only known once it's finished.
+//   - CreatedAt (time.Time): When the event happened (automatically updated by GORM).
+type ReadingEvent struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"index:idx_reading_event_user;not null" json:"userId"`
+	NovelID   uint      `gorm:"not null" json:"novelId"`
+	ChapterNo uint      `gorm:"not null" json:"chapterNo"`
+	Event     string    `gorm:"size:20;not null" json:"event"`
+	WordCount int       `gorm:"default:0" json:"wordCount"`
+	Seconds   int       `gorm:"default:0" json:"seconds"`
+	CreatedAt time.Time `gorm:"index:idx_reading_event_user;autoCreateTime" json:"createdAt"`
+}
+
+// ReadingShare represents how many chapters a user finished of the novels of a genre or tag.
+//
+// Fields:
+//   - Name (string): The name of the genre or tag.
+//   - Chapters (int64): The number of chapters finished.
+type ReadingShare struct {
+	Name     string `json:"name"`
+	Chapters int64  `json:"chapters"`
+}
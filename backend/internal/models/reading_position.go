@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// ReadingPosition represents where a user is reading a novel, synced across the devices they read on. A user has a
+// single position per novel: the one read last.
+//
+// Fields:
+//   - ID (uint): The unique identifier for the reading position.
+//   - UserID (uint): The ID of the user reading the novel.
+//   - NovelID (uint): The ID of the novel being read.
+//   - ChapterNo (uint): The number of the chapter being read.
+//   - Paragraph (int): The index of the paragraph being read in the chapter, starting at 0.
+//   - Percent (float64): How far the chapter has been scrolled through, from 0 to 100.
+//   - DeviceID (string): The identifier the client gives to the device the chapter is read on (max 100 characters).
+//   - ReadAt (time.Time): When the position was reached on the device, which decides the position kept on conflicts.
+//   - CreatedAt (time.Time): The time the user started syncing the novel (automatically updated by GORM).
+//   - UpdatedAt (time.Time): The time the position was last synced (automatically updated by GORM).
+type ReadingPosition struct {
+	ID        uint      `gorm:"primaryKey" json:"-"`
+	UserID    uint      `gorm:"uniqueIndex:idx_reading_position;not null" json:"userId"`
+	NovelID   uint      `gorm:"uniqueIndex:idx_reading_position;not null" json:"novelId"`
+	ChapterNo uint      `gorm:"not null" json:"chapterNo"`
+	Paragraph int       `gorm:"default:0" json:"paragraph"`
+	Percent   float64   `gorm:"default:0" json:"percent"`
+	DeviceID  string    `gorm:"size:100;not null" json:"deviceId"`
+	ReadAt    time.Time `gorm:"not null" json:"readAt"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
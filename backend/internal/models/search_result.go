@@ -0,0 +1,31 @@
+package models
+
+// NovelSearchResult represents a novel matching a full-text search.
+//
+// Fields:
+//   - Novel (Novel): The novel, with its authors, genres and tags.
+//   - Rank (float64): The relevance of the novel to the search, higher first.
+//   - Snippet (string): The HTML-escaped passage of the title, authors or synopsis matching the search, with the
+//     matching words between <mark> and </mark>.
+type NovelSearchResult struct {
+	Novel   Novel   `json:"novel"`
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+// ChapterSearchResult represents a chapter whose text matches a full-text search.
+//
+// Fields:
+//   - ID (uint): The ID of the chapter.
+//   - ChapterNo (uint): The number of the chapter.
+//   - Title (string): The title of the chapter.
+//   - Rank (float64): The relevance of the chapter to the search, higher first.
+//   - Snippet (string): The HTML-escaped passage of the chapter matching the search, with the matching words between
+//     <mark> and </mark>.
+type ChapterSearchResult struct {
+	ID        uint    `json:"id"`
+	ChapterNo uint    `json:"chapterNo"`
+	Title     string  `json:"title"`
+	Rank      float64 `json:"rank"`
+	Snippet   string  `json:"snippet"`
+}
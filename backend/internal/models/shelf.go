@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Shelf represents a list of novels a user made to organize their library, independent of the status of their
+// bookmarks.
+//
+// Fields:
+//   - ID (uint): The unique identifier for the shelf.
+//   - UserID (uint): The ID of the user the shelf belongs to.
+//   - Name (string): The name of the shelf, unique for each user. Maximum length 100 characters.
+//   - Novels ([]Novel): The novels on the shelf.
+//   - CreatedAt (time.Time): The time the shelf was created (automatically managed by GORM).
+//   - UpdatedAt (time.Time): The time the shelf was last updated (automatically managed by GORM).
+type Shelf struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"uniqueIndex:idx_shelf_name;not null" json:"userId"`
+	Name      string    `gorm:"size:100;uniqueIndex:idx_shelf_name;not null" json:"name"`
+	Novels    []Novel   `gorm:"many2many:shelf_novels;" json:"novels"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// CircuitClosed is the state of a source whose requests are sent normally.
+	CircuitClosed = "closed"
+	// CircuitOpen is the state of a source paused after too many consecutive failures.
+	CircuitOpen = "open"
+	// CircuitHalfOpen is the state of a source whose pause is over, the next request decides whether it is resumed.
+	CircuitHalfOpen = "half-open"
+)
+
+// SourceLimits represents the limits applied to the requests sent to a source.
+//
+// Fields:
+//   - RequestsPerSecond (float64): The number of requests per second allowed to each host of the source.
+//   - Burst (int): The number of requests that can be sent at once before being throttled.
+//   - MaxRetries (int): The number of times a request is retried after a 429 or 5xx response or a network error.
+//   - BaseBackoff (time.Duration): The delay before the first retry, doubled on each following retry.
+//   - MaxBackoff (time.Duration): The longest delay between two retries.
+//   - FailureThreshold (int): The number of consecutive failed requests that pause the source.
+//   - Cooldown (time.Duration): How long the source is paused.
+type SourceLimits struct {
+	RequestsPerSecond float64       `json:"requestsPerSecond"`
+	Burst             int           `json:"burst"`
+	MaxRetries        int           `json:"maxRetries"`
+	BaseBackoff       time.Duration `json:"baseBackoff"`
+	MaxBackoff        time.Duration `json:"maxBackoff"`
+	FailureThreshold  int           `json:"failureThreshold"`
+	Cooldown          time.Duration `json:"cooldown"`
+}
+
+// MarshalJSON encodes the limits with their durations written as strings (e.g., "1.5s").
+//
+// Returns:
+//   - []byte: The JSON encoding of the limits.
+//   - error: An error if the limits could not be encoded.
+func (l SourceLimits) MarshalJSON() ([]byte, error) {
+	type limits SourceLimits
+	return json.Marshal(struct {
+		limits
+		BaseBackoff string `json:"baseBackoff"`
+		MaxBackoff  string `json:"maxBackoff"`
+		Cooldown    string `json:"cooldown"`
+	}{limits(l), l.BaseBackoff.String(), l.MaxBackoff.String(), l.Cooldown.String()})
+}
+
+// UnmarshalJSON decodes limits encoded by MarshalJSON.
+//
+// Parameters:
+//   - data ([]byte): The JSON encoding of the limits.
+//
+// Returns:
+//   - error: An error if the limits or their durations could not be decoded.
+func (l *SourceLimits) UnmarshalJSON(data []byte) error {
+	type limits SourceLimits
+	var decoded struct {
+		limits
+		BaseBackoff string `json:"baseBackoff"`
+		MaxBackoff  string `json:"maxBackoff"`
+		Cooldown    string `json:"cooldown"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*l = SourceLimits(decoded.limits)
+	for _, duration := range []struct {
+		value  string
+		target *time.Duration
+	}{
+		{decoded.BaseBackoff, &l.BaseBackoff},
+		{decoded.MaxBackoff, &l.MaxBackoff},
+		{decoded.Cooldown, &l.Cooldown},
+	} {
+		if duration.value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(duration.value)
+		if err != nil {
+			return err
+		}
+		*duration.target = parsed
+	}
+	return nil
+}
+
+// SourceStatus represents the limits and the health of a source.
+//
+// Fields:
+//   - Name (string): The name of the source.
+//   - Limits (SourceLimits): The limits applied to the requests sent to the source.
+//   - State (string): The state of the circuit breaker of the source (closed, open or half-open).
+//   - ConsecutiveFailures (int): The number of failed requests since the last successful one.
+//   - PausedUntil (*time.Time): When the source is resumed, if it is paused.
+//   - Requests (int64): The number of requests sent to the source, retries included.
+//   - Retries (int64): The number of retried requests.
+//   - Failures (int64): The number of requests that failed after all their retries.
+//   - Rejected (int64): The number of requests rejected while the source was paused.
+type SourceStatus struct {
+	Name                string       `json:"name"`
+	Limits              SourceLimits `json:"limits"`
+	State               string       `json:"state"`
+	ConsecutiveFailures int          `json:"consecutiveFailures"`
+	PausedUntil         *time.Time   `json:"pausedUntil"`
+	Requests            int64        `json:"requests"`
+	Retries             int64        `json:"retries"`
+	Failures            int64        `json:"failures"`
+	Rejected            int64        `json:"rejected"`
+}
@@ -0,0 +1,34 @@
+package models
+
+// TaxonomyEntry represents a genre, tag or author with the number of novels it is associated with.
+//
+// Fields:
+//   - ID (uint): The ID of the genre, tag or author.
+//   - Name (string): The name of the genre, tag or author.
+//   - Description (string): The description of the genre or tag, empty for authors.
+//   - NovelCount (int64): The number of novels associated with the genre, tag or author.
+type TaxonomyEntry struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	NovelCount  int64  `json:"novelCount"`
+}
+
+// TaxonomyKind is the kind of the entries of a taxonomy the novels are classified by.
+type TaxonomyKind string
+
+const (
+	TaxonomyGenres  TaxonomyKind = "genres"
+	TaxonomyTags    TaxonomyKind = "tags"
+	TaxonomyAuthors TaxonomyKind = "authors"
+)
+
+// IsValid reports whether the kind is one of the taxonomies the novels are classified by.
+func (k TaxonomyKind) IsValid() bool {
+	return k == TaxonomyGenres || k == TaxonomyTags || k == TaxonomyAuthors
+}
+
+// HasDescription reports whether the entries of the kind have a description. Authors have none.
+func (k TaxonomyKind) HasDescription() bool {
+	return k == TaxonomyGenres || k == TaxonomyTags
+}
@@ -38,6 +38,7 @@ var rolesWithPermissions = RolesWithPermissions{
 			"view":   alwaysAllow,
 			"create": alwaysAllow,
 			"update": alwaysAllow,
+			"delete": alwaysAllow,
 		},
 		"chapters": {
 			"view":   alwaysAllow,
@@ -45,6 +46,10 @@ var rolesWithPermissions = RolesWithPermissions{
 			"update": alwaysAllow,
 			"delete": alwaysAllow,
 		},
+		"taxonomy": {
+			"update": alwaysAllow,
+			"delete": alwaysAllow,
+		},
 		"tts": {
 			"generate": alwaysAllow,
 		},
@@ -54,6 +59,7 @@ var rolesWithPermissions = RolesWithPermissions{
 			"view":   alwaysAllow,
 			"create": alwaysAllow,
 			"update": alwaysAllow,
+			"delete": alwaysAllow,
 		},
 		"chapters": {
 			"view":   alwaysAllow,
@@ -61,6 +67,10 @@ var rolesWithPermissions = RolesWithPermissions{
 			"update": alwaysAllow,
 			"delete": alwaysAllow,
 		},
+		"taxonomy": {
+			"update": alwaysAllow,
+			"delete": alwaysAllow,
+		},
 		"tts": {
 			"generate": alwaysAllow,
 		},
@@ -70,6 +80,7 @@ var rolesWithPermissions = RolesWithPermissions{
 			"view":   alwaysAllow,
 			"create": alwaysDeny,
 			"update": alwaysDeny,
+			"delete": alwaysDeny,
 		},
 		"chapters": {
 			"view":   alwaysAllow,
@@ -77,6 +88,10 @@ var rolesWithPermissions = RolesWithPermissions{
 			"update": alwaysDeny,
 			"delete": alwaysDeny,
 		},
+		"taxonomy": {
+			"update": alwaysDeny,
+			"delete": alwaysDeny,
+		},
 		"tts": {
 			"generate": alwaysAllow,
 		},
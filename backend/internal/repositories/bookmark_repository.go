@@ -26,7 +26,7 @@ func NewBookmarkRepository(db *gorm.DB) *BookmarkRepository {
 	return &BookmarkRepository{db: db}
 }
 
-// GetBookmarkedNovelsByUserID gets the bookmarked novels by the given user
+// GetBookmarkedNovelsByUserID gets the library of the given user: their bookmarks joined with the novels bookmarked.
 //
 // Parameters:
 //   - userID uint (id of the user)
@@ -34,43 +34,150 @@ func NewBookmarkRepository(db *gorm.DB) *BookmarkRepository {
 //   - limit int (limit of novels per page)
 //
 // Returns:
-//   - []models.Novel (list of Novel structs)
+//   - []models.LibraryEntry (list of LibraryEntry structs, in the order the novels were bookmarked)
 //   - int64 (total number of novels)
-//   - INTERNAL_SERVER_ERROR if the novels could not be fetched
-//   - NO_NOVELS_ERROR if the novels could not be fetched
-func (b *BookmarkRepository) GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.Novel, int64, error) {
-	var novels []models.Novel
+//   - GETTING_TOTAL_BOOKMARKS if the total number of novels could not be fetched
+//   - GETTING_BOOKMARKS if the novels could not be fetched
+func (b *BookmarkRepository) GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.LibraryEntry, int64, error) {
 	var total int64
-
-	// Count total novels for the user
-	if err := b.db.Model(&models.Novel{}).
-		Joins("JOIN bookmarked_novels ON bookmarked_novels.novel_id = novels.id").
-		Where("bookmarked_novels.user_id = ?", userID).
-		Count(&total).Error; err != nil {
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoBookmarks
-		}
-
+	if err := b.libraryQuery(userID).Count(&total).Error; err != nil {
 		return nil, 0, types.WrapError(errors.GETTING_TOTAL_BOOKMARKS, "Failed to get the total number of bookmarked novels", http.StatusInternalServerError, err)
 	}
 
 	// Apply pagination and ordering
-	offset := (page - 1) * limit
-	if err := b.db.Model(&models.Novel{}).
-		Joins("JOIN bookmarked_novels ON bookmarked_novels.novel_id = novels.id").
-		Where("bookmarked_novels.user_id = ?", userID).
+	var entries []models.LibraryEntry
+	if err := b.libraryQuery(userID).
+		Order("bookmarked_novels.id ASC").
 		Limit(limit).
-		Offset(offset).
-		Find(&novels).Error; err != nil {
+		Offset((page - 1) * limit).
+		Scan(&entries).Error; err != nil {
+		return nil, 0, types.WrapError(errors.GETTING_BOOKMARKS, "Failed to fetch novels", http.StatusInternalServerError, err)
+	}
 
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoBookmarks
-		}
+	if err := b.attachLibraryNovels(entries); err != nil {
+		return nil, 0, err
+	}
 
-		return nil, 0, types.WrapError(errors.GETTING_BOOKMARKS, "Failed to fetch novels", http.StatusInternalServerError, err)
+	return entries, total, nil
+}
+
+// GetAllLibraryEntries gets the whole library of the given user, in the order the novels were bookmarked.
+//
+// Parameters:
+//   - userID uint (id of the user)
+//
+// Returns:
+//   - []models.LibraryEntry (list of LibraryEntry structs)
+//   - GETTING_BOOKMARKS if the novels could not be fetched
+func (b *BookmarkRepository) GetAllLibraryEntries(userID uint) ([]models.LibraryEntry, error) {
+	entries := []models.LibraryEntry{}
+	if err := b.libraryQuery(userID).Order("bookmarked_novels.id ASC").Scan(&entries).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_BOOKMARKS, "Failed to fetch novels", http.StatusInternalServerError, err)
+	}
+
+	if err := b.attachLibraryNovels(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetContinueReading gets the bookmarks of the given user with the given statuses joined with the novels bookmarked,
+// the ones the user read or changed most recently first.
+//
+// Parameters:
+//   - userID uint (id of the user)
+//   - statuses []string (statuses of the bookmarks)
+//   - limit int (maximum number of novels)
+//
+// Returns:
+//   - []models.LibraryEntry (list of LibraryEntry structs)
+//   - GETTING_BOOKMARKS if the novels could not be fetched
+func (b *BookmarkRepository) GetContinueReading(userID uint, statuses []string, limit int) ([]models.LibraryEntry, error) {
+	var entries []models.LibraryEntry
+	if err := b.libraryQuery(userID).
+		Where("bookmarked_novels.status IN ?", statuses).
+		Order("CASE WHEN reading_positions.read_at > bookmarked_novels.updated_at THEN reading_positions.read_at ELSE bookmarked_novels.updated_at END DESC").
+		Order("bookmarked_novels.id DESC").
+		Limit(limit).
+		Scan(&entries).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_BOOKMARKS, "Failed to fetch novels", http.StatusInternalServerError, err)
+	}
+
+	if err := b.attachLibraryNovels(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// libraryQuery builds the query of the bookmarks of a user whose novels still exist, with the time the user last
+// synced their reading position in each novel.
+//
+// Parameters:
+//   - userID uint (id of the user)
+//
+// Returns:
+//   - *gorm.DB (query of the bookmarks)
+func (b *BookmarkRepository) libraryQuery(userID uint) *gorm.DB {
+	return b.db.Model(&models.BookmarkedNovel{}).
+		Select("bookmarked_novels.*, reading_positions.read_at AS last_read_at").
+		Joins("JOIN novels ON novels.id = bookmarked_novels.novel_id AND novels.deleted_at IS NULL").
+		Joins("LEFT JOIN reading_positions ON reading_positions.user_id = bookmarked_novels.user_id AND reading_positions.novel_id = bookmarked_novels.novel_id").
+		Where("bookmarked_novels.user_id = ?", userID)
+}
+
+// attachLibraryNovels fetches the novels of library entries and sets them in the entries.
+//
+// Parameters:
+//   - entries []models.LibraryEntry (library entries)
+//
+// Returns:
+//   - GETTING_BOOKMARKS if the novels could not be fetched
+func (b *BookmarkRepository) attachLibraryNovels(entries []models.LibraryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	novelIDs := make([]int, len(entries))
+	for i, entry := range entries {
+		novelIDs[i] = entry.NovelID
+	}
+
+	var novels []models.Novel
+	if err := b.db.Where("id IN ?", novelIDs).Find(&novels).Error; err != nil {
+		return types.WrapError(errors.GETTING_BOOKMARKS, "Failed to fetch novels", http.StatusInternalServerError, err)
 	}
 
-	return novels, total, nil
+	byID := make(map[uint]models.Novel, len(novels))
+	for _, novel := range novels {
+		byID[novel.ID] = novel
+	}
+	for i := range entries {
+		entries[i].Novel = byID[uint(entries[i].NovelID)]
+	}
+
+	return nil
+}
+
+// GetAllBookmarkedNovels gets every novel bookmarked by the given user, without pagination or relationships.
+//
+// Parameters:
+//   - userID uint (id of the user)
+//
+// Returns:
+//   - []models.Novel (list of Novel structs)
+//   - GETTING_BOOKMARKS if the novels could not be fetched
+func (b *BookmarkRepository) GetAllBookmarkedNovels(userID uint) ([]models.Novel, error) {
+	var novels []models.Novel
+	if err := b.db.Model(&models.Novel{}).
+		Joins("JOIN bookmarked_novels ON bookmarked_novels.novel_id = novels.id").
+		Where("bookmarked_novels.user_id = ? AND bookmarked_novels.deleted_at IS NULL", userID).
+		Find(&novels).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_BOOKMARKS, "Failed to fetch novels", http.StatusInternalServerError, err)
+	}
+
+	return novels, nil
 }
 
 // GetBookmarkByUserIDAndNovelID gets a bookmarked novel by user ID and novel ID.
@@ -99,6 +206,26 @@ func (b *BookmarkRepository) GetBookmarkByUserIDAndNovelID(userID uint, novelID
 	return novel, nil
 }
 
+// GetBookmark gets the bookmark of a novel by a user, if they bookmarked it.
+//
+// Parameters:
+//   - userID uint (ID of the user)
+//   - novelID uint (ID of the novel)
+//
+// Returns:
+//   - *models.BookmarkedNovel (pointer to BookmarkedNovel struct, nil if the user didn't bookmark the novel)
+//   - GETTING_BOOKMARK if the bookmarked novel could not be fetched
+func (b *BookmarkRepository) GetBookmark(userID, novelID uint) (*models.BookmarkedNovel, error) {
+	var bookmarks []models.BookmarkedNovel
+	if err := b.db.Where("user_id = ? AND novel_id = ?", userID, novelID).Limit(1).Find(&bookmarks).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_BOOKMARK, "Failed to fetch bookmarked novel", http.StatusInternalServerError, err)
+	}
+	if len(bookmarks) == 0 {
+		return nil, nil
+	}
+	return &bookmarks[0], nil
+}
+
 // UpdateBookmark updates a bookmarked novel in the database.
 //
 // Parameters:
@@ -42,6 +42,93 @@ func (c *ChapterRepository) IsChapterCreated(chapterNo uint, novelID uint) bool
 	return existingChapter.ID != 0
 }
 
+// GetChapterNumbers gets the numbers of all the stored chapters of a novel in a single query.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//
+// Returns:
+//   - []uint (chapter numbers in ascending order)
+//   - GETTING_CHAPTERS if the chapter numbers could not be fetched
+func (c *ChapterRepository) GetChapterNumbers(novelID uint) ([]uint, error) {
+	var chapterNumbers []uint
+	if err := c.db.Model(&models.Chapter{}).
+		Where("novel_id = ?", novelID).
+		Order("chapter_no ASC").
+		Pluck("chapter_no", &chapterNumbers).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_CHAPTERS, "Failed to fetch chapter numbers", http.StatusInternalServerError, err)
+	}
+	return chapterNumbers, nil
+}
+
+// CountChaptersInRange counts the stored chapters of a novel whose numbers are in a range.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//   - from uint (first chapter number of the range)
+//   - to uint (last chapter number of the range)
+//
+// Returns:
+//   - int64 (number of stored chapters in the range)
+//   - GETTING_TOTAL_CHAPTERS if the chapters could not be counted
+func (c *ChapterRepository) CountChaptersInRange(novelID uint, from, to uint) (int64, error) {
+	var total int64
+	if err := c.db.Model(&models.Chapter{}).
+		Where("novel_id = ? AND chapter_no BETWEEN ? AND ?", novelID, from, to).
+		Count(&total).Error; err != nil {
+		return 0, types.WrapError(errors.GETTING_TOTAL_CHAPTERS, "Failed to count the chapters", http.StatusInternalServerError, err)
+	}
+	return total, nil
+}
+
+// GetChaptersInRange gets the first stored chapters of a novel whose numbers are in a range. Reading a large range
+// a batch at a time, starting each batch after the last chapter of the previous one, keeps only a batch in memory.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//   - from uint (first chapter number of the range)
+//   - to uint (last chapter number of the range)
+//   - limit int (maximum number of chapters to get)
+//
+// Returns:
+//   - []models.Chapter (chapters in ascending order of chapter number)
+//   - GETTING_CHAPTERS if the chapters could not be fetched
+func (c *ChapterRepository) GetChaptersInRange(novelID uint, from, to uint, limit int) ([]models.Chapter, error) {
+	var chapters []models.Chapter
+	if err := c.db.Where("novel_id = ? AND chapter_no BETWEEN ? AND ?", novelID, from, to).
+		Order("chapter_no ASC").
+		Limit(limit).
+		Find(&chapters).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_CHAPTERS, "Failed to fetch chapters", http.StatusInternalServerError, err)
+	}
+	return chapters, nil
+}
+
+// GetLatestChapters gets the most recently stored chapters of a set of novels, newest first.
+//
+// Parameters:
+//   - novelIDs []uint (IDs of the novels)
+//   - limit int (maximum number of chapters to get)
+//
+// Returns:
+//   - []models.Chapter (chapters, newest first)
+//   - GETTING_CHAPTERS if the chapters could not be fetched
+func (c *ChapterRepository) GetLatestChapters(novelIDs []uint, limit int) ([]models.Chapter, error) {
+	chapters := []models.Chapter{}
+	if len(novelIDs) == 0 {
+		return chapters, nil
+	}
+
+	if err := c.db.Where("novel_id IN ?", novelIDs).
+		Order("created_at DESC").
+		Order("chapter_no DESC").
+		Limit(limit).
+		Find(&chapters).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_CHAPTERS, "Failed to fetch the latest chapters", http.StatusInternalServerError, err)
+	}
+	return chapters, nil
+}
+
 // CreateChapter creates a new chapter in the database.
 //
 // Parameters:
@@ -52,14 +139,15 @@ func (c *ChapterRepository) IsChapterCreated(chapterNo uint, novelID uint) bool
 //   - CONFLICT_ERROR if the chapter already exists
 //   - INTERNAL_SERVER_ERROR if the chapter could not be created
 func (c *ChapterRepository) CreateChapter(chapter models.Chapter) (*models.Chapter, error) {
-	c.db.Logger = c.db.Logger.LogMode(logger.Silent)
+	// Use a silent session instead of changing the shared logger, chapters are created concurrently by import jobs
+	db := c.db.Session(&gorm.Session{Logger: c.db.Logger.LogMode(logger.Silent)})
 
 	if IsChapterCreated := c.IsChapterCreated(chapter.ChapterNo, *chapter.NovelID); IsChapterCreated {
 		return nil, errors.ErrChapterConflict
 	}
 
 	// Save the chapter
-	if err := c.db.Create(&chapter).Error; err != nil {
+	if err := db.Create(&chapter).Error; err != nil {
 		log.Println(err)
 		return nil, types.WrapError(errors.IMPORTING_CHAPTER, "Failed to create chapter", http.StatusInternalServerError, err)
 	}
@@ -80,7 +168,7 @@ func (c *ChapterRepository) CreateChapter(chapter models.Chapter) (*models.Chapt
 func (c *ChapterRepository) GetChapterByNovelUpdatesIDAndChapterNo(novelTitle string, chapterNo uint) (*models.Chapter, error) {
 	var chapter models.Chapter
 	if err := c.db.Model(&models.Chapter{}).
-		Joins("JOIN novels ON novels.id = chapters.novel_id").
+		Joins("JOIN novels ON novels.id = chapters.novel_id AND novels.deleted_at IS NULL").
 		Where("novels.novel_updates_id = ? AND chapters.chapter_no = ?", novelTitle, chapterNo).First(&chapter).Error; err != nil {
 		if err.Error() == "record not found" {
 			return nil, errors.ErrChapterNotFound
@@ -105,7 +193,7 @@ func (c *ChapterRepository) GetChaptersByNovelUpdatesID(novelTitle string, page,
 	var total int64
 
 	if err := c.db.Model(&models.Chapter{}).
-		Joins("JOIN novels ON novels.id = chapters.novel_id").
+		Joins("JOIN novels ON novels.id = chapters.novel_id AND novels.deleted_at IS NULL").
 		Where("novels.novel_updates_id = ?", novelTitle).
 		Count(&total).Error; err != nil {
 		if err.Error() == "record not found" {
@@ -117,7 +205,7 @@ func (c *ChapterRepository) GetChaptersByNovelUpdatesID(novelTitle string, page,
 	// Apply pagination and ordering
 	offset := (page - 1) * limit
 	if err := c.db.Model(&models.Chapter{}).
-		Joins("JOIN novels ON novels.id = chapters.novel_id").
+		Joins("JOIN novels ON novels.id = chapters.novel_id AND novels.deleted_at IS NULL").
 		Where("novels.novel_updates_id = ?", novelTitle).
 		Order("chapter_no ASC").
 		Limit(limit).Offset(offset).
@@ -132,3 +220,194 @@ func (c *ChapterRepository) GetChaptersByNovelUpdatesID(novelTitle string, page,
 	}
 	return chapters, total, nil
 }
+
+// DeleteChapters deletes the stored chapters of a novel whose numbers are in a range.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//   - from uint (first chapter number of the range)
+//   - to uint (last chapter number of the range)
+//
+// Returns:
+//   - int64 (number of deleted chapters)
+//   - DELETING_CHAPTERS if the chapters could not be deleted
+func (c *ChapterRepository) DeleteChapters(novelID uint, from, to uint) (int64, error) {
+	result := c.db.Where("novel_id = ? AND chapter_no BETWEEN ? AND ?", novelID, from, to).Delete(&models.Chapter{})
+	if result.Error != nil {
+		return 0, types.WrapError(errors.DELETING_CHAPTERS, "Failed to delete the chapters", http.StatusInternalServerError, result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// RenumberChapters moves the stored chapters of a novel whose numbers are in a range by an offset, keeping their
+// revisions. The latest chapter of the novel is raised if chapters are moved past it.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//   - from uint (first chapter number of the range)
+//   - to uint (last chapter number of the range)
+//   - offset int (number added to the chapter numbers, negative to move the chapters down)
+//
+// Returns:
+//   - int64 (number of renumbered chapters)
+//   - CHAPTER_CONFLICT if chapters outside the range already have the new numbers
+//   - RENUMBERING_CHAPTERS if the chapters could not be renumbered
+func (c *ChapterRepository) RenumberChapters(novelID uint, from, to uint, offset int) (int64, error) {
+	var renumbered int64
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		var conflicts int64
+		if err := tx.Model(&models.Chapter{}).
+			Where("novel_id = ? AND chapter_no BETWEEN ? AND ?", novelID, int(from)+offset, int(to)+offset).
+			Where("chapter_no NOT BETWEEN ? AND ?", from, to).
+			Count(&conflicts).Error; err != nil {
+			return types.WrapError(errors.RENUMBERING_CHAPTERS, "Failed to check the new chapter numbers", http.StatusInternalServerError, err)
+		}
+		if conflicts > 0 {
+			return errors.ErrChapterRenumberingConflict
+		}
+
+		result := tx.Model(&models.Chapter{}).
+			Where("novel_id = ? AND chapter_no BETWEEN ? AND ?", novelID, from, to).
+			Update("chapter_no", gorm.Expr("chapter_no + ?", offset))
+		if result.Error != nil {
+			return types.WrapError(errors.RENUMBERING_CHAPTERS, "Failed to renumber the chapters", http.StatusInternalServerError, result.Error)
+		}
+		renumbered = result.RowsAffected
+		return raiseLatestChapter(tx, novelID, errors.RENUMBERING_CHAPTERS)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return renumbered, nil
+}
+
+// InsertChapter stores a chapter at a number, optionally moving the chapters from that number onwards one number up
+// to make room for it. The latest chapter of the novel is raised if the chapters go past it.
+//
+// Parameters:
+//   - chapter models.Chapter (Chapter struct)
+//   - shift bool (whether the following chapters are moved up)
+//
+// Returns:
+//   - *models.Chapter (pointer to the stored Chapter struct)
+//   - CHAPTER_CONFLICT if a chapter has the number and the following chapters are not moved
+//   - INSERTING_CHAPTER if the chapter could not be stored
+func (c *ChapterRepository) InsertChapter(chapter models.Chapter, shift bool) (*models.Chapter, error) {
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		if shift {
+			if err := tx.Model(&models.Chapter{}).
+				Where("novel_id = ? AND chapter_no >= ?", *chapter.NovelID, chapter.ChapterNo).
+				Update("chapter_no", gorm.Expr("chapter_no + 1")).Error; err != nil {
+				return types.WrapError(errors.INSERTING_CHAPTER, "Failed to move the following chapters", http.StatusInternalServerError, err)
+			}
+		} else {
+			var existing int64
+			if err := tx.Model(&models.Chapter{}).
+				Where("novel_id = ? AND chapter_no = ?", *chapter.NovelID, chapter.ChapterNo).
+				Count(&existing).Error; err != nil {
+				return types.WrapError(errors.INSERTING_CHAPTER, "Failed to check the chapter number", http.StatusInternalServerError, err)
+			}
+			if existing > 0 {
+				return errors.ErrChapterConflict
+			}
+		}
+
+		if err := tx.Create(&chapter).Error; err != nil {
+			return types.WrapError(errors.INSERTING_CHAPTER, "Failed to create chapter", http.StatusInternalServerError, err)
+		}
+		return raiseLatestChapter(tx, *chapter.NovelID, errors.INSERTING_CHAPTER)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chapter, nil
+}
+
+// raiseLatestChapter sets the latest chapter of a novel to its highest stored chapter number if it is higher, so the
+// chapters moved past the latest chapter are counted.
+//
+// Parameters:
+//   - tx *gorm.DB (transaction the chapters were changed in)
+//   - novelID uint (ID of the novel)
+//   - code string (error code returned if the latest chapter could not be updated)
+//
+// Returns:
+//   - error (the error with the code if the latest chapter could not be updated)
+func raiseLatestChapter(tx *gorm.DB, novelID uint, code string) error {
+	var latest int
+	if err := tx.Model(&models.Chapter{}).
+		Where("novel_id = ?", novelID).
+		Select("COALESCE(MAX(chapter_no), 0)").
+		Scan(&latest).Error; err != nil {
+		return types.WrapError(code, "Failed to get the highest chapter number", http.StatusInternalServerError, err)
+	}
+
+	if err := tx.Model(&models.Novel{}).
+		Where("id = ? AND latest_chapter < ?", novelID, latest).
+		Update("latest_chapter", latest).Error; err != nil {
+		return types.WrapError(code, "Failed to update the latest chapter of the novel", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// GetChapterListing gets the table of contents of a novel: its stored chapters without their bodies, starting after a
+// chapter number.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//   - after uint (chapter number to start after, 0 to start at the first chapter)
+//   - limit int (maximum number of chapters to get)
+//
+// Returns:
+//   - []models.Chapter (chapters in ascending order of chapter number, with empty bodies)
+//   - GETTING_CHAPTERS if the chapters could not be fetched
+func (c *ChapterRepository) GetChapterListing(novelID uint, after uint, limit int) ([]models.Chapter, error) {
+	var chapters []models.Chapter
+	if err := c.db.Select("id", "created_at", "updated_at", "chapter_no", "novel_id", "title", "word_count").
+		Where("novel_id = ? AND chapter_no > ?", novelID, after).
+		Order("chapter_no ASC").
+		Limit(limit).
+		Find(&chapters).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_CHAPTERS, "Failed to fetch the table of contents", http.StatusInternalServerError, err)
+	}
+	return chapters, nil
+}
+
+// GetAdjacentChapterNumbers gets the numbers of the stored chapters of a novel right before and after a chapter,
+// skipping the numbers with no chapter.
+//
+// Parameters:
+//   - novelID uint (ID of the novel)
+//   - chapterNo uint (chapter number)
+//
+// Returns:
+//   - *uint (number of the previous chapter, nil if there is none)
+//   - *uint (number of the next chapter, nil if there is none)
+//   - GETTING_CHAPTERS if the chapter numbers could not be fetched
+func (c *ChapterRepository) GetAdjacentChapterNumbers(novelID uint, chapterNo uint) (*uint, *uint, error) {
+	var previous, next []uint
+	if err := c.db.Model(&models.Chapter{}).
+		Where("novel_id = ? AND chapter_no < ?", novelID, chapterNo).
+		Order("chapter_no DESC").
+		Limit(1).
+		Pluck("chapter_no", &previous).Error; err != nil {
+		return nil, nil, types.WrapError(errors.GETTING_CHAPTERS, "Failed to fetch the previous chapter", http.StatusInternalServerError, err)
+	}
+
+	if err := c.db.Model(&models.Chapter{}).
+		Where("novel_id = ? AND chapter_no > ?", novelID, chapterNo).
+		Order("chapter_no ASC").
+		Limit(1).
+		Pluck("chapter_no", &next).Error; err != nil {
+		return nil, nil, types.WrapError(errors.GETTING_CHAPTERS, "Failed to fetch the next chapter", http.StatusInternalServerError, err)
+	}
+
+	var previousNo, nextNo *uint
+	if len(previous) > 0 {
+		previousNo = &previous[0]
+	}
+	if len(next) > 0 {
+		nextNo = &next[0]
+	}
+	return previousNo, nextNo, nil
+}
@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+// ChapterRevisionRepository represents a repository for interacting with chapter revision data.
+// It embeds the BaseRepository to inherit common database operations.
+type ChapterRevisionRepository struct {
+	*BaseRepository
+}
+
+// NewChapterRevisionRepository creates a new ChapterRevisionRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *ChapterRevisionRepository: A pointer to the newly created ChapterRevisionRepository.
+func NewChapterRevisionRepository(db *gorm.DB) *ChapterRevisionRepository {
+	return &ChapterRevisionRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// GetChapterRevisions retrieves the revisions of a chapter, ordered by revision number.
+//
+// Parameters:
+//   - chapterID (uint): The ID of the chapter.
+//
+// Returns:
+//   - []models.ChapterRevision: The revisions of the chapter, empty if it was never scraped again.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_CHAPTER_REVISIONS: Returned if the revisions could not be retrieved.
+func (r *ChapterRevisionRepository) GetChapterRevisions(chapterID uint) ([]models.ChapterRevision, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var revisions []models.ChapterRevision
+	if err := r.db.Where("chapter_id = ?", chapterID).Order("revision ASC").Find(&revisions).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_CHAPTER_REVISIONS, "Failed to get chapter revisions", http.StatusInternalServerError, err)
+	}
+	return revisions, nil
+}
+
+// CreateChapterRevision adds a revision to a chapter, numbered after its latest revision. The first time a chapter gets
+// a revision, its current content is stored as the current revision 1 before.
+//
+// Parameters:
+//   - chapter (*models.Chapter): The chapter the revision belongs to.
+//   - revision (models.ChapterRevision): The content of the revision.
+//
+// Returns:
+//   - *models.ChapterRevision: A pointer to the created revision.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - CREATING_CHAPTER_REVISION: Returned if the revision could not be created.
+func (r *ChapterRevisionRepository) CreateChapterRevision(chapter *models.Chapter, revision models.ChapterRevision) (*models.ChapterRevision, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var latest int
+		if err := tx.Model(&models.ChapterRevision{}).
+			Where("chapter_id = ?", chapter.ID).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+
+		if latest == 0 {
+			latest = 1
+			if err := tx.Create(&models.ChapterRevision{
+				ChapterID:  chapter.ID,
+				Revision:   latest,
+				Title:      chapter.Title,
+				ChapterUrl: chapter.ChapterUrl,
+				Body:       chapter.Body,
+				Source:     chapter.Source,
+				Origin:     models.RevisionOriginImport,
+				Current:    true,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		revision.ChapterID = chapter.ID
+		revision.Revision = latest + 1
+		revision.Current = false
+		return tx.Create(&revision).Error
+	})
+	if err != nil {
+		return nil, types.WrapError(errors.CREATING_CHAPTER_REVISION, "Failed to create chapter revision", http.StatusInternalServerError, err)
+	}
+
+	return &revision, nil
+}
+
+// SetCurrentChapterRevision marks a revision of a chapter as the current one and copies its content into the chapter,
+// in a single transaction.
+//
+// Parameters:
+//   - chapter (*models.Chapter): The chapter the revision belongs to.
+//   - revision (int): The number of the revision.
+//
+// Returns:
+//   - *models.ChapterRevision: A pointer to the current revision.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrChapterRevisionNotFound: Returned if the chapter has no such revision.
+//   - UPDATING_CHAPTER_REVISION: Returned if the revision or the chapter could not be updated.
+func (r *ChapterRevisionRepository) SetCurrentChapterRevision(chapter *models.Chapter, revision int) (*models.ChapterRevision, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var current models.ChapterRevision
+	if err := r.db.Where("chapter_id = ? AND revision = ?", chapter.ID, revision).First(&current).Error; err != nil {
+		if err.Error() == "record not found" {
+			return nil, errors.ErrChapterRevisionNotFound
+		}
+		return nil, types.WrapError(errors.GETTING_CHAPTER_REVISIONS, "Failed to get chapter revision", http.StatusInternalServerError, err)
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ChapterRevision{}).
+			Where("chapter_id = ? AND revision <> ?", chapter.ID, revision).
+			Update("current", false).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&current).Update("current", true).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(chapter).Updates(map[string]interface{}{
+			"title":       current.Title,
+			"chapter_url": current.ChapterUrl,
+			"body":        current.Body,
+			"source":      current.Source,
+			"word_count":  models.CountWords(current.Body),
+		}).Error
+	})
+	if err != nil {
+		return nil, types.WrapError(errors.UPDATING_CHAPTER_REVISION, "Failed to update the current chapter revision", http.StatusInternalServerError, err)
+	}
+
+	current.Current = true
+	return &current, nil
+}
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"net/http"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FeedTokenRepository represents a repository for the tokens of the private feeds of the users.
+// It embeds the BaseRepository to inherit common database operations.
+type FeedTokenRepository struct {
+	*BaseRepository
+}
+
+// NewFeedTokenRepository creates a new FeedTokenRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *FeedTokenRepository: A pointer to the newly created FeedTokenRepository.
+func NewFeedTokenRepository(db *gorm.DB) *FeedTokenRepository {
+	return &FeedTokenRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// GetFeedTokenByUserID retrieves the feed token of a user.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - *models.FeedToken: A pointer to the feed token, or nil if the user has none.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+func (f *FeedTokenRepository) GetFeedTokenByUserID(userID uint) (*models.FeedToken, error) {
+	if f.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var feedTokens []models.FeedToken
+	if err := f.db.Where("user_id = ?", userID).Limit(1).Find(&feedTokens).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_FEED_TOKEN, "Failed to fetch the feed token", http.StatusInternalServerError, err)
+	}
+	if len(feedTokens) == 0 {
+		return nil, nil
+	}
+	return &feedTokens[0], nil
+}
+
+// GetFeedTokenByToken retrieves a feed token from its value.
+//
+// Parameters:
+//   - token (string): The token.
+//
+// Returns:
+//   - *models.FeedToken: A pointer to the feed token.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrInvalidFeedToken: Returned if no user has the token.
+//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+func (f *FeedTokenRepository) GetFeedTokenByToken(token string) (*models.FeedToken, error) {
+	if f.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var feedToken models.FeedToken
+	if err := f.db.Where("token = ?", token).First(&feedToken).Error; err != nil {
+		if err.Error() == "record not found" {
+			return nil, errors.ErrInvalidFeedToken
+		}
+		return nil, types.WrapError(errors.GETTING_FEED_TOKEN, "Failed to fetch the feed token", http.StatusInternalServerError, err)
+	}
+	return &feedToken, nil
+}
+
+// SaveFeedToken sets the feed token of a user, replacing the previous one so the feeds subscribed to with it stop
+// working.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - token (string): The new token.
+//
+// Returns:
+//   - *models.FeedToken: A pointer to the saved feed token.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_FEED_TOKEN: Returned if the feed token could not be saved.
+func (f *FeedTokenRepository) SaveFeedToken(userID uint, token string) (*models.FeedToken, error) {
+	if f.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	feedToken := models.FeedToken{UserID: userID, Token: token}
+	if err := f.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token", "updated_at"}),
+	}).Create(&feedToken).Error; err != nil {
+		return nil, types.WrapError(errors.SAVING_FEED_TOKEN, "Failed to save the feed token", http.StatusInternalServerError, err)
+	}
+
+	return f.GetFeedTokenByUserID(userID)
+}
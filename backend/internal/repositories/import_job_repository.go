@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+// importJobChapterBatchSize is the number of chapters of a job inserted per statement.
+const importJobChapterBatchSize = 500
+
+// ImportJobRepository represents a repository for interacting with import job data.
+// It embeds the BaseRepository to inherit common database operations.
+type ImportJobRepository struct {
+	*BaseRepository
+}
+
+// NewImportJobRepository creates a new ImportJobRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *ImportJobRepository: A pointer to the newly created ImportJobRepository.
+func NewImportJobRepository(db *gorm.DB) *ImportJobRepository {
+	return &ImportJobRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// CreateImportJob creates a new import job and the entries of its chapters in a single transaction.
+//
+// Parameters:
+//   - job (models.ImportJob): The job to create, including its chapters.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the created job.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - CREATING_IMPORT_JOB: Returned if the job or its chapters could not be created.
+func (r *ImportJobRepository) CreateImportJob(job models.ImportJob) (*models.ImportJob, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	chapters := job.Chapters
+	job.Chapters = nil
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&job).Error; err != nil {
+			return err
+		}
+
+		for i := range chapters {
+			chapters[i].ImportJobID = job.ID
+		}
+
+		if len(chapters) > 0 {
+			return tx.CreateInBatches(&chapters, importJobChapterBatchSize).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, types.WrapError(errors.CREATING_IMPORT_JOB, "Failed to create import job", http.StatusInternalServerError, err)
+	}
+
+	job.Chapters = chapters
+	return &job, nil
+}
+
+// GetImportJobByID retrieves an import job and its chapters, ordered by chapter number.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the retrieved job.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrImportJobNotFound: Returned if no job with the given ID exists.
+//   - GETTING_IMPORT_JOB: Returned if an error occurred while retrieving the job.
+func (r *ImportJobRepository) GetImportJobByID(id uint) (*models.ImportJob, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var job models.ImportJob
+	if err := r.db.Preload("Chapters", func(db *gorm.DB) *gorm.DB {
+		return db.Order("chapter_no ASC")
+	}).First(&job, id).Error; err != nil {
+		if err.Error() == "record not found" {
+			return nil, errors.ErrImportJobNotFound
+		}
+
+		return nil, types.WrapError(errors.GETTING_IMPORT_JOB, "Failed to fetch import job", http.StatusInternalServerError, err)
+	}
+	return &job, nil
+}
+
+// GetImportJobs retrieves a paginated list of import jobs, newest first. The chapters of the jobs are not loaded.
+//
+// Parameters:
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of jobs to return per page.
+//
+// Returns:
+//   - []models.ImportJob: A slice of import jobs.
+//   - int64: The total number of import jobs.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_IMPORT_JOBS: Returned if an error occurred while retrieving the jobs.
+func (r *ImportJobRepository) GetImportJobs(page, limit int) ([]models.ImportJob, int64, error) {
+	if r.IsDown() {
+		return nil, 0, errors.ErrDatabaseOffline
+	}
+
+	var jobs []models.ImportJob
+	var total int64
+
+	if err := r.db.Model(&models.ImportJob{}).Count(&total).Error; err != nil {
+		return nil, 0, types.WrapError(errors.GETTING_IMPORT_JOBS, "Failed to get the total number of import jobs", http.StatusInternalServerError, err)
+	}
+
+	offset := (page - 1) * limit
+	if err := r.db.Model(&models.ImportJob{}).
+		Order("created_at DESC").Order("id DESC").
+		Limit(limit).Offset(offset).
+		Find(&jobs).Error; err != nil {
+		return nil, 0, types.WrapError(errors.GETTING_IMPORT_JOBS, "Failed to fetch import jobs", http.StatusInternalServerError, err)
+	}
+	return jobs, total, nil
+}
+
+// GetImportJobsByState retrieves all the import jobs in any of the given states, with their chapters.
+//
+// Parameters:
+//   - states (...string): The states to look for.
+//
+// Returns:
+//   - []models.ImportJob: A slice of import jobs, oldest first.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_IMPORT_JOBS: Returned if an error occurred while retrieving the jobs.
+func (r *ImportJobRepository) GetImportJobsByState(states ...string) ([]models.ImportJob, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var jobs []models.ImportJob
+	if err := r.db.Preload("Chapters", func(db *gorm.DB) *gorm.DB {
+		return db.Order("chapter_no ASC")
+	}).Where("state IN ?", states).Order("id ASC").Find(&jobs).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_IMPORT_JOBS, "Failed to fetch import jobs", http.StatusInternalServerError, err)
+	}
+	return jobs, nil
+}
+
+// GetActiveImportJobByNovelID retrieves the queued or running import job of a novel.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the active job, without its chapters.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrImportJobNotFound: Returned if the novel has no active job.
+//   - GETTING_IMPORT_JOB: Returned if an error occurred while retrieving the job.
+func (r *ImportJobRepository) GetActiveImportJobByNovelID(novelID uint) (*models.ImportJob, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var job models.ImportJob
+	if err := r.db.Where("novel_id = ? AND state IN ?", novelID, []string{models.ImportJobQueued, models.ImportJobRunning}).
+		Order("id DESC").
+		First(&job).Error; err != nil {
+		if err.Error() == "record not found" {
+			return nil, errors.ErrImportJobNotFound
+		}
+
+		return nil, types.WrapError(errors.GETTING_IMPORT_JOB, "Failed to fetch import job", http.StatusInternalServerError, err)
+	}
+	return &job, nil
+}
+
+// UpdateImportJob saves the state, error and timestamps of an import job. Its chapters are not saved.
+//
+// Parameters:
+//   - job (*models.ImportJob): The job to save.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - UPDATING_IMPORT_JOB: Returned if the job could not be saved.
+func (r *ImportJobRepository) UpdateImportJob(job *models.ImportJob) error {
+	if err := r.db.Model(job).
+		Select("State", "Error", "StartedAt", "FinishedAt").
+		Updates(job).Error; err != nil {
+		return types.WrapError(errors.UPDATING_IMPORT_JOB, "Failed to update import job", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// UpdateImportJobChapter saves the status, attempts and message of a chapter of an import job.
+//
+// Parameters:
+//   - chapter (*models.ImportJobChapter): The chapter to save.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - UPDATING_IMPORT_JOB: Returned if the chapter could not be saved.
+func (r *ImportJobRepository) UpdateImportJobChapter(chapter *models.ImportJobChapter) error {
+	if err := r.db.Model(chapter).
+		Select("Status", "Attempts", "Message", "ErrorCode", "Retryable", "UpdatedAt").
+		Updates(chapter).Error; err != nil {
+		return types.WrapError(errors.UPDATING_IMPORT_JOB, "Failed to update import job chapter", http.StatusInternalServerError, err)
+	}
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 )
 
 type BookmarkRepositoryInterface interface {
-	// GetBookmarkedNovelsByUserID gets the bookmarked novels by the given user
+	// GetBookmarkedNovelsByUserID gets the library of the given user: their bookmarks joined with the novels bookmarked.
 	//
 	// Parameters:
 	//   - userID uint (id of the user)
@@ -13,11 +13,44 @@ type BookmarkRepositoryInterface interface {
 	//   - limit int (limit of novels per page)
 	//
 	// Returns:
-	//   - []models.Novel (list of Novel structs)
+	//   - []models.LibraryEntry (list of LibraryEntry structs, in the order the novels were bookmarked)
 	//   - int64 (total number of novels)
-	//   - INTERNAL_SERVER_ERROR if the novels could not be fetched
-	//   - NO_NOVELS_ERROR if the novels could not be fetched
-	GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.Novel, int64, error)
+	//   - GETTING_TOTAL_BOOKMARKS if the total number of novels could not be fetched
+	//   - GETTING_BOOKMARKS if the novels could not be fetched
+	GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.LibraryEntry, int64, error)
+
+	// GetAllLibraryEntries gets the whole library of the given user, in the order the novels were bookmarked.
+	//
+	// Parameters:
+	//   - userID uint (id of the user)
+	//
+	// Returns:
+	//   - []models.LibraryEntry (list of LibraryEntry structs)
+	//   - GETTING_BOOKMARKS if the novels could not be fetched
+	GetAllLibraryEntries(userID uint) ([]models.LibraryEntry, error)
+
+	// GetContinueReading gets the bookmarks of the given user with the given statuses joined with the novels bookmarked,
+	// the ones the user read or changed most recently first.
+	//
+	// Parameters:
+	//   - userID uint (id of the user)
+	//   - statuses []string (statuses of the bookmarks)
+	//   - limit int (maximum number of novels)
+	//
+	// Returns:
+	//   - []models.LibraryEntry (list of LibraryEntry structs)
+	//   - GETTING_BOOKMARKS if the novels could not be fetched
+	GetContinueReading(userID uint, statuses []string, limit int) ([]models.LibraryEntry, error)
+
+	// GetAllBookmarkedNovels gets every novel bookmarked by the given user, without pagination or relationships.
+	//
+	// Parameters:
+	//   - userID uint (id of the user)
+	//
+	// Returns:
+	//   - []models.Novel (list of Novel structs)
+	//   - GETTING_BOOKMARKS if the novels could not be fetched
+	GetAllBookmarkedNovels(userID uint) ([]models.Novel, error)
 
 	// GetBookmarkByUserIDAndNovelID gets a bookmarked novel by user ID and novel ID.
 	//
@@ -31,6 +64,17 @@ type BookmarkRepositoryInterface interface {
 	//   - NOVEL_NOT_FOUND_ERROR if the bookmarked novel could not be fetched
 	GetBookmarkByUserIDAndNovelID(userID uint, novelID string) (models.BookmarkedNovel, error)
 
+	// GetBookmark gets the bookmark of a novel by a user, if they bookmarked it.
+	//
+	// Parameters:
+	//   - userID uint (ID of the user)
+	//   - novelID uint (ID of the novel)
+	//
+	// Returns:
+	//   - *models.BookmarkedNovel (pointer to BookmarkedNovel struct, nil if the user didn't bookmark the novel)
+	//   - GETTING_BOOKMARK if the bookmarked novel could not be fetched
+	GetBookmark(userID, novelID uint) (*models.BookmarkedNovel, error)
+
 	// UpdateBookmark updates a bookmarked novel in the database.
 	//
 	// Parameters:
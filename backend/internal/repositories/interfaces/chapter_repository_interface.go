@@ -14,6 +14,52 @@ type ChapterRepositoryInterface interface {
 	//   - INTERNAL_SERVER_ERROR if the chapter could not be fetched
 	IsChapterCreated(chapterNo uint, novelID uint) bool
 
+	// GetChapterNumbers gets the numbers of all the stored chapters of a novel in a single query.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//
+	// Returns:
+	//   - []uint (chapter numbers in ascending order)
+	//   - GETTING_CHAPTERS if the chapter numbers could not be fetched
+	GetChapterNumbers(novelID uint) ([]uint, error)
+
+	// CountChaptersInRange counts the stored chapters of a novel whose numbers are in a range.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//   - from uint (first chapter number of the range)
+	//   - to uint (last chapter number of the range)
+	//
+	// Returns:
+	//   - int64 (number of stored chapters in the range)
+	//   - GETTING_TOTAL_CHAPTERS if the chapters could not be counted
+	CountChaptersInRange(novelID uint, from, to uint) (int64, error)
+
+	// GetChaptersInRange gets the first stored chapters of a novel whose numbers are in a range.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//   - from uint (first chapter number of the range)
+	//   - to uint (last chapter number of the range)
+	//   - limit int (maximum number of chapters to get)
+	//
+	// Returns:
+	//   - []models.Chapter (chapters in ascending order of chapter number)
+	//   - GETTING_CHAPTERS if the chapters could not be fetched
+	GetChaptersInRange(novelID uint, from, to uint, limit int) ([]models.Chapter, error)
+
+	// GetLatestChapters gets the most recently stored chapters of a set of novels, newest first.
+	//
+	// Parameters:
+	//   - novelIDs []uint (IDs of the novels)
+	//   - limit int (maximum number of chapters to get)
+	//
+	// Returns:
+	//   - []models.Chapter (chapters, newest first)
+	//   - GETTING_CHAPTERS if the chapters could not be fetched
+	GetLatestChapters(novelIDs []uint, limit int) ([]models.Chapter, error)
+
 	// CreateChapter creates a new chapter in the database.
 	//
 	// Parameters:
@@ -48,4 +94,70 @@ type ChapterRepositoryInterface interface {
 	//   - INTERNAL_SERVER_ERROR if the chapters could not be fetched
 	//   - NO_CHAPTERS_ERROR if the chapters could not be fetched
 	GetChaptersByNovelUpdatesID(novelTitle string, page, limit int) ([]models.Chapter, int64, error)
+
+	// DeleteChapters deletes the stored chapters of a novel whose numbers are in a range.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//   - from uint (first chapter number of the range)
+	//   - to uint (last chapter number of the range)
+	//
+	// Returns:
+	//   - int64 (number of deleted chapters)
+	//   - DELETING_CHAPTERS if the chapters could not be deleted
+	DeleteChapters(novelID uint, from, to uint) (int64, error)
+
+	// RenumberChapters moves the stored chapters of a novel whose numbers are in a range by an offset, keeping their
+	// revisions.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//   - from uint (first chapter number of the range)
+	//   - to uint (last chapter number of the range)
+	//   - offset int (number added to the chapter numbers, negative to move the chapters down)
+	//
+	// Returns:
+	//   - int64 (number of renumbered chapters)
+	//   - CHAPTER_CONFLICT if chapters outside the range already have the new numbers
+	//   - RENUMBERING_CHAPTERS if the chapters could not be renumbered
+	RenumberChapters(novelID uint, from, to uint, offset int) (int64, error)
+
+	// InsertChapter stores a chapter at a number, optionally moving the chapters from that number onwards one number up
+	// to make room for it.
+	//
+	// Parameters:
+	//   - chapter models.Chapter (Chapter struct)
+	//   - shift bool (whether the following chapters are moved up)
+	//
+	// Returns:
+	//   - *models.Chapter (pointer to the stored Chapter struct)
+	//   - CHAPTER_CONFLICT if a chapter has the number and the following chapters are not moved
+	//   - INSERTING_CHAPTER if the chapter could not be stored
+	InsertChapter(chapter models.Chapter, shift bool) (*models.Chapter, error)
+
+	// GetChapterListing gets the table of contents of a novel: its stored chapters without their bodies, starting after a
+	// chapter number.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//   - after uint (chapter number to start after, 0 to start at the first chapter)
+	//   - limit int (maximum number of chapters to get)
+	//
+	// Returns:
+	//   - []models.Chapter (chapters in ascending order of chapter number, with empty bodies)
+	//   - GETTING_CHAPTERS if the chapters could not be fetched
+	GetChapterListing(novelID uint, after uint, limit int) ([]models.Chapter, error)
+
+	// GetAdjacentChapterNumbers gets the numbers of the stored chapters of a novel right before and after a chapter,
+	// skipping the numbers with no chapter.
+	//
+	// Parameters:
+	//   - novelID uint (ID of the novel)
+	//   - chapterNo uint (chapter number)
+	//
+	// Returns:
+	//   - *uint (number of the previous chapter, nil if there is none)
+	//   - *uint (number of the next chapter, nil if there is none)
+	//   - GETTING_CHAPTERS if the chapter numbers could not be fetched
+	GetAdjacentChapterNumbers(novelID uint, chapterNo uint) (*uint, *uint, error)
 }
@@ -0,0 +1,55 @@
+package interfaces
+
+import "backend/internal/models"
+
+// ChapterRevisionRepositoryInterface defines methods for storing the revisions of the content of the chapters.
+type ChapterRevisionRepositoryInterface interface {
+	BaseRepositoryInterface
+
+	// GetChapterRevisions retrieves the revisions of a chapter, ordered by revision number.
+	//
+	// Parameters:
+	//   - chapterID (uint): The ID of the chapter.
+	//
+	// Returns:
+	//   - []models.ChapterRevision: The revisions of the chapter, empty if it was never scraped again.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_CHAPTER_REVISIONS: Returned if the revisions could not be retrieved.
+	GetChapterRevisions(chapterID uint) ([]models.ChapterRevision, error)
+
+	// CreateChapterRevision adds a revision to a chapter, numbered after its latest revision. The first time a chapter
+	// gets a revision, its current content is stored as the current revision 1 before.
+	//
+	// Parameters:
+	//   - chapter (*models.Chapter): The chapter the revision belongs to.
+	//   - revision (models.ChapterRevision): The content of the revision.
+	//
+	// Returns:
+	//   - *models.ChapterRevision: A pointer to the created revision.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - CREATING_CHAPTER_REVISION: Returned if the revision could not be created.
+	CreateChapterRevision(chapter *models.Chapter, revision models.ChapterRevision) (*models.ChapterRevision, error)
+
+	// SetCurrentChapterRevision marks a revision of a chapter as the current one and copies its content into the
+	// chapter, in a single transaction.
+	//
+	// Parameters:
+	//   - chapter (*models.Chapter): The chapter the revision belongs to.
+	//   - revision (int): The number of the revision.
+	//
+	// Returns:
+	//   - *models.ChapterRevision: A pointer to the current revision.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrChapterRevisionNotFound: Returned if the chapter has no such revision.
+	//   - UPDATING_CHAPTER_REVISION: Returned if the revision or the chapter could not be updated.
+	SetCurrentChapterRevision(chapter *models.Chapter, revision int) (*models.ChapterRevision, error)
+}
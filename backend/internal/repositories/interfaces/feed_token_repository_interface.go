@@ -0,0 +1,54 @@
+package interfaces
+
+import "backend/internal/models"
+
+// FeedTokenRepositoryInterface defines the contract for managing the tokens of the private feeds of the users in the
+// repository layer.
+type FeedTokenRepositoryInterface interface {
+	BaseRepositoryInterface
+
+	// GetFeedTokenByUserID retrieves the feed token of a user.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - *models.FeedToken: A pointer to the feed token, or nil if the user has none.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+	GetFeedTokenByUserID(userID uint) (*models.FeedToken, error)
+
+	// GetFeedTokenByToken retrieves a feed token from its value.
+	//
+	// Parameters:
+	//   - token (string): The token.
+	//
+	// Returns:
+	//   - *models.FeedToken: A pointer to the feed token.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrInvalidFeedToken: Returned if no user has the token.
+	//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+	GetFeedTokenByToken(token string) (*models.FeedToken, error)
+
+	// SaveFeedToken sets the feed token of a user, replacing the previous one so the feeds subscribed to with it stop
+	// working.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - token (string): The new token.
+	//
+	// Returns:
+	//   - *models.FeedToken: A pointer to the saved feed token.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_FEED_TOKEN: Returned if the feed token could not be saved.
+	SaveFeedToken(userID uint, token string) (*models.FeedToken, error)
+}
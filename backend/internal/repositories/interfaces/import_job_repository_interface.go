@@ -0,0 +1,106 @@
+package interfaces
+
+import "backend/internal/models"
+
+// ImportJobRepositoryInterface defines methods for persisting chapter import jobs and the outcome of their chapters.
+type ImportJobRepositoryInterface interface {
+	BaseRepositoryInterface
+
+	// CreateImportJob creates a new import job and the entries of its chapters in a single transaction.
+	//
+	// Parameters:
+	//   - job (models.ImportJob): The job to create, including its chapters.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the created job.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - CREATING_IMPORT_JOB: Returned if the job or its chapters could not be created.
+	CreateImportJob(job models.ImportJob) (*models.ImportJob, error)
+
+	// GetImportJobByID retrieves an import job and its chapters, ordered by chapter number.
+	//
+	// Parameters:
+	//   - id (uint): The ID of the job.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the retrieved job.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrImportJobNotFound: Returned if no job with the given ID exists.
+	//   - GETTING_IMPORT_JOB: Returned if an error occurred while retrieving the job.
+	GetImportJobByID(id uint) (*models.ImportJob, error)
+
+	// GetImportJobs retrieves a paginated list of import jobs, newest first. The chapters of the jobs are not loaded.
+	//
+	// Parameters:
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of jobs to return per page.
+	//
+	// Returns:
+	//   - []models.ImportJob: A slice of import jobs.
+	//   - int64: The total number of import jobs.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_IMPORT_JOBS: Returned if an error occurred while retrieving the jobs.
+	GetImportJobs(page, limit int) ([]models.ImportJob, int64, error)
+
+	// GetImportJobsByState retrieves all the import jobs in any of the given states, with their chapters.
+	//
+	// Parameters:
+	//   - states (...string): The states to look for.
+	//
+	// Returns:
+	//   - []models.ImportJob: A slice of import jobs, oldest first.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_IMPORT_JOBS: Returned if an error occurred while retrieving the jobs.
+	GetImportJobsByState(states ...string) ([]models.ImportJob, error)
+
+	// GetActiveImportJobByNovelID retrieves the queued or running import job of a novel.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the active job, without its chapters.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrImportJobNotFound: Returned if the novel has no active job.
+	//   - GETTING_IMPORT_JOB: Returned if an error occurred while retrieving the job.
+	GetActiveImportJobByNovelID(novelID uint) (*models.ImportJob, error)
+
+	// UpdateImportJob saves the state, error and timestamps of an import job. Its chapters are not saved.
+	//
+	// Parameters:
+	//   - job (*models.ImportJob): The job to save.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - UPDATING_IMPORT_JOB: Returned if the job could not be saved.
+	UpdateImportJob(job *models.ImportJob) error
+
+	// UpdateImportJobChapter saves the status, attempts and message of a chapter of an import job.
+	//
+	// Parameters:
+	//   - chapter (*models.ImportJobChapter): The chapter to save.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - UPDATING_IMPORT_JOB: Returned if the chapter could not be saved.
+	UpdateImportJobChapter(chapter *models.ImportJobChapter) error
+}
@@ -27,6 +27,18 @@ type NovelRepositoryInterface interface {
 	//   - errors.ErrImportingNovel: A generic error indicating failure during novel creation.
 	CreateNovel(novel models.Novel) (*models.Novel, error)
 
+	// GetOngoingNovels retrieves all the novels whose status is not completed, without their relationships. It is used
+	// to find the novels that can still get new chapters.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of novels, ordered by ID.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingNovels: Returned if an error occurred while retrieving the novels.
+	GetOngoingNovels() ([]models.Novel, error)
+
 	// GetNovels retrieves a paginated list of all the novels
 	//
 	// Parameters:
@@ -45,6 +57,65 @@ type NovelRepositoryInterface interface {
 	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
 	GetNovels(page, limit int) ([]models.Novel, int64, error)
 
+	// GetNovelsByQuery retrieves a paginated list of the novels matching the filters of a query, in its order.
+	//
+	// Parameters:
+	//   - query (models.NovelQuery): The filters and the order of the novels. The names of the tags, genres and authors
+	//     are matched ignoring case.
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of novels to return per page.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of novels matching the query.
+	//   - int64: The total number of novels matching the query.
+	//   - error: An error object indicating any issues encountered during the retrieval process.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNoNovels: Returned if no novels are found.
+	//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+	GetNovelsByQuery(query models.NovelQuery, page, limit int) ([]models.Novel, int64, error)
+
+	// GetNovelsPage retrieves a page of the novels matching the filters of a query, in its order, starting after the
+	// novel of a cursor instead of skipping a number of novels, so that deep pages are as fast as the first ones and
+	// novels added while paginating are neither skipped nor repeated.
+	//
+	// Parameters:
+	//   - query (models.NovelQuery): The filters and the order of the novels.
+	//   - after (*models.NovelCursor): The position of the last novel of the previous page, or nil for the first page.
+	//     It must have been returned for a query with the same order.
+	//   - limit (int): The maximum number of novels to return.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of novels matching the query.
+	//   - *models.NovelCursor: The position of the last novel of the page, or nil if it is the last page.
+	//   - error: An error object indicating any issues encountered during the retrieval process.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrInvalidCursor: Returned if the value of the cursor doesn't match the order of the query.
+	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels.
+	GetNovelsPage(query models.NovelQuery, after *models.NovelCursor, limit int) ([]models.Novel, *models.NovelCursor, error)
+
+	// SearchNovelsByTitle retrieves a paginated list of the novels whose title contains a query, ignoring case.
+	//
+	// Parameters:
+	//   - query (string): The text to search for in the titles.
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of novels to return per page.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of novels matching the query, ordered by title.
+	//   - int64: The total number of novels matching the query.
+	//   - error: An error object indicating any issues encountered during the retrieval process.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+	SearchNovelsByTitle(query string, page, limit int) ([]models.Novel, int64, error)
+
 	// GetNovelsByAuthorName retrieves a paginated list of novels by a given author name.
 	//
 	// Parameters:
@@ -132,4 +203,68 @@ type NovelRepositoryInterface interface {
 	//   - errors.ErrNovelNotFound: Returned if no novel with the given NovelUpdates ID exists in the database.
 	//   - errors.ErrGettingNovel: Returned if an error occurred while retrieving the novel from the database.
 	GetNovelByUpdatesID(title string) (*models.Novel, error)
+
+	// GetNovelByTitle retrieves a novel from the database based on its title, ignoring case, without its relationships.
+	//
+	// Parameters:
+	//   - title (string): The title of the novel.
+	//
+	// Returns:
+	//   - *models.Novel: A pointer to the retrieved novel, or nil if not found.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if no novel with the given title exists in the database.
+	//   - errors.ErrGettingNovel: Returned if an error occurred while retrieving the novel from the database.
+	GetNovelByTitle(title string) (*models.Novel, error)
+
+	// UpdateNovelSourcePriority updates the source priority of a novel.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - sourcePriority (string): The comma separated names of the sources to try first, in order.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - UPDATING_SOURCE_PRIORITY: Returned if the source priority could not be updated.
+	UpdateNovelSourcePriority(novelID uint, sourcePriority string) error
+
+	// UpdateNovel saves fields of a novel edited by an administrator, and the fields locked against the updates of the
+	// imports.
+	//
+	// Parameters:
+	//   - novel (models.Novel): The novel with the edited fields and the locked fields.
+	//   - fields ([]string): The names of the edited fields, among models.LockableNovelFields.
+	//
+	// Returns:
+	//   - *models.Novel: The updated novel with its relationships.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelTitleTaken: Returned if another novel has the new title.
+	//   - TAG_ASSOCIATION_ERROR, GENRE_ASSOCIATION_ERROR, AUTHOR_ASSOCIATION_ERROR: Returned if the new tags, genres or
+	//     authors could not be created.
+	//   - UPDATING_NOVEL: Returned if the novel could not be updated.
+	//   - errors.ErrNovelNotFound, errors.ErrGettingNovel: Returned if the updated novel could not be retrieved.
+	UpdateNovel(novel models.Novel, fields []string) (*models.Novel, error)
+
+	// DeleteNovel deletes a novel. The novel is soft deleted: it's hidden with its chapters, and it's restored if it's
+	// imported again.
+	//
+	// Parameters:
+	//   - id (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - DELETING_NOVEL: Returned if the novel could not be deleted.
+	DeleteNovel(id uint) error
 }
@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"backend/internal/models"
+	"time"
+)
+
+// ReadingEventRepositoryInterface defines the contract for managing the reading history of the users in the
+// repository layer.
+type ReadingEventRepositoryInterface interface {
+	BaseRepositoryInterface
+
+	// CreateReadingEvent adds an event to the reading history of a user.
+	//
+	// Parameters:
+	//   - event (models.ReadingEvent): The event.
+	//
+	// Returns:
+	//   - *models.ReadingEvent: A pointer to the created event.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_READING_EVENT: Returned if the event could not be created.
+	CreateReadingEvent(event models.ReadingEvent) (*models.ReadingEvent, error)
+
+	// GetLastOpenedReadingEvent retrieves the event of the chapter a user fetched last.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - *models.ReadingEvent: A pointer to the event, or nil if the user never fetched a chapter.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_EVENTS: Returned if the event could not be retrieved.
+	GetLastOpenedReadingEvent(userID uint) (*models.ReadingEvent, error)
+
+	// GetReadingEvents retrieves the reading history of a user since a time, oldest first.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - since (time.Time): The time to start at, the zero time for the whole history.
+	//
+	// Returns:
+	//   - []models.ReadingEvent: The events of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_EVENTS: Returned if the events could not be retrieved.
+	GetReadingEvents(userID uint, since time.Time) ([]models.ReadingEvent, error)
+
+	// GetReadingShares counts the chapters a user finished since a time by genre or tag of their novels, most read first.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - kind (models.TaxonomyKind): The taxonomy to count by.
+	//   - since (time.Time): The time to start at.
+	//   - limit (int): The maximum number of genres or tags.
+	//
+	// Returns:
+	//   - []models.ReadingShare: The chapters finished by genre or tag.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+	//   - GETTING_READING_EVENTS: Returned if the chapters could not be counted.
+	GetReadingShares(userID uint, kind models.TaxonomyKind, since time.Time, limit int) ([]models.ReadingShare, error)
+}
@@ -0,0 +1,55 @@
+package interfaces
+
+import "backend/internal/models"
+
+// ReadingPositionRepositoryInterface defines the contract for managing the reading positions the users sync across
+// their devices in the repository layer.
+type ReadingPositionRepositoryInterface interface {
+	BaseRepositoryInterface
+
+	// GetReadingPosition retrieves the reading position of a user in a novel.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - *models.ReadingPosition: A pointer to the reading position, or nil if the user never synced the novel.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_POSITION: Returned if the reading position could not be retrieved.
+	GetReadingPosition(userID, novelID uint) (*models.ReadingPosition, error)
+
+	// GetReadingPositions retrieves the reading positions of a user in all the novels they synced, most recently read
+	// first.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - []models.ReadingPosition: The reading positions of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_POSITION: Returned if the reading positions could not be retrieved.
+	GetReadingPositions(userID uint) ([]models.ReadingPosition, error)
+
+	// SaveReadingPosition stores the reading position of a user in a novel unless the stored one was reached later, so the
+	// last position read wins whatever order the devices sync in. The current chapter of the bookmark of the novel follows
+	// the stored position, in the same transaction.
+	//
+	// Parameters:
+	//   - position (models.ReadingPosition): The reading position.
+	//
+	// Returns:
+	//   - bool: Whether the position was stored, false if the stored one was reached later.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_READING_POSITION: Returned if the reading position could not be stored.
+	SaveReadingPosition(position models.ReadingPosition) (bool, error)
+}
@@ -0,0 +1,46 @@
+package interfaces
+
+import "backend/internal/models"
+
+// SearchRepositoryInterface defines the contract for the full-text search of the novels and their chapters in the
+// repository layer.
+type SearchRepositoryInterface interface {
+	BaseRepositoryInterface
+
+	// SearchNovels retrieves a paginated list of the novels whose title, synopsis or authors contain all the terms of a
+	// search, as words or word prefixes, ordered by relevance.
+	//
+	// Parameters:
+	//   - terms ([]string): The lowercase words to search for, as returned by utils.SearchTerms.
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of novels to return per page.
+	//
+	// Returns:
+	//   - []models.NovelSearchResult: A slice of the matching novels, most relevant first.
+	//   - int64: The total number of matching novels.
+	//   - error: An error object indicating any issues encountered during the search.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SEARCHING_NOVELS: Returned if an error occurs while searching the novels.
+	SearchNovels(terms []string, page, limit int) ([]models.NovelSearchResult, int64, error)
+
+	// SearchChapters retrieves a paginated list of the chapters of a novel whose text contains all the terms of a search,
+	// as words or word prefixes, ordered by relevance.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - terms ([]string): The lowercase words to search for, as returned by utils.SearchTerms.
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of chapters to return per page.
+	//
+	// Returns:
+	//   - []models.ChapterSearchResult: A slice of the matching chapters, most relevant first.
+	//   - int64: The total number of matching chapters.
+	//   - error: An error object indicating any issues encountered during the search.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SEARCHING_CHAPTERS: Returned if an error occurs while searching the chapters.
+	SearchChapters(novelID uint, terms []string, page, limit int) ([]models.ChapterSearchResult, int64, error)
+}
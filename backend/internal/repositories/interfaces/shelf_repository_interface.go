@@ -0,0 +1,119 @@
+package interfaces
+
+import "backend/internal/models"
+
+// ShelfRepositoryInterface defines methods for storing the shelves the users organize their libraries with.
+type ShelfRepositoryInterface interface {
+	// GetShelves retrieves the shelves of a user with their novels, ordered by name.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - []models.Shelf: The shelves of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES: Returned if the shelves could not be retrieved.
+	GetShelves(userID uint) ([]models.Shelf, error)
+
+	// GetShelf retrieves a shelf of a user with its novels.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - shelfID (uint): The ID of the shelf.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the shelf, or nil if the user has no shelf with this ID.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES: Returned if the shelf could not be retrieved.
+	GetShelf(userID, shelfID uint) (*models.Shelf, error)
+
+	// GetShelfByName retrieves a shelf of a user by its name, without its novels.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - name (string): The name of the shelf.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the shelf, or nil if the user has no shelf with this name.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES: Returned if the shelf could not be retrieved.
+	GetShelfByName(userID uint, name string) (*models.Shelf, error)
+
+	// CreateShelf stores a new shelf.
+	//
+	// Parameters:
+	//   - shelf (models.Shelf): The shelf to store.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the stored shelf.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_SHELF: Returned if the shelf could not be stored.
+	CreateShelf(shelf models.Shelf) (*models.Shelf, error)
+
+	// RenameShelf changes the name of a shelf.
+	//
+	// Parameters:
+	//   - shelfID (uint): The ID of the shelf.
+	//   - name (string): The new name of the shelf.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_SHELF: Returned if the shelf could not be renamed.
+	RenameShelf(shelfID uint, name string) error
+
+	// DeleteShelf deletes a shelf. The novels on it are only taken off the shelf.
+	//
+	// Parameters:
+	//   - shelf (*models.Shelf): The shelf to delete.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - DELETING_SHELF: Returned if the shelf could not be deleted.
+	DeleteShelf(shelf *models.Shelf) error
+
+	// AddNovelToShelf puts a novel on a shelf. A novel already on the shelf stays there once.
+	//
+	// Parameters:
+	//   - shelf (*models.Shelf): The shelf.
+	//   - novel (*models.Novel): The novel to put on the shelf.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_SHELF: Returned if the novel could not be put on the shelf.
+	AddNovelToShelf(shelf *models.Shelf, novel *models.Novel) error
+
+	// RemoveNovelFromShelf takes a novel off a shelf.
+	//
+	// Parameters:
+	//   - shelf (*models.Shelf): The shelf.
+	//   - novel (*models.Novel): The novel to take off the shelf.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SAVING_SHELF: Returned if the novel could not be taken off the shelf.
+	RemoveNovelFromShelf(shelf *models.Shelf, novel *models.Novel) error
+}
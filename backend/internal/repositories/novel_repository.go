@@ -4,10 +4,16 @@ import (
 	"backend/internal/models"
 	"backend/internal/types"
 	"backend/internal/types/errors"
+	"fmt"
 	"log"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
@@ -53,6 +59,15 @@ func (n *NovelRepository) CreateNovel(novel models.Novel) (*models.Novel, error)
 
 	n.db.Logger = n.db.Logger.LogMode(logger.Silent)
 
+	// Keep the fields corrected by the administrators
+	existing, err := n.restoreImportedNovel(novel.NovelUpdatesID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		keepLockedFields(&novel, existing)
+	}
+
 	// Process relationships
 	newTags, err := n.processTags(novel.Tags)
 	if err != nil {
@@ -115,6 +130,200 @@ func (n *NovelRepository) CreateNovel(novel models.Novel) (*models.Novel, error)
 	return &novel, nil
 }
 
+// restoreImportedNovel retrieves the novel a re-import updates, with its relationships, restoring it if it was
+// deleted so it can be imported again.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the imported novel.
+//
+// Returns:
+//   - *models.Novel: The novel, or nil if it was never imported.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrImportingNovel: Returned if the novel could not be retrieved or restored.
+func (n *NovelRepository) restoreImportedNovel(novelUpdatesID string) (*models.Novel, error) {
+	if novelUpdatesID == "" {
+		return nil, nil
+	}
+
+	var existing models.Novel
+	if err := n.db.Unscoped().
+		Where("novel_updates_id = ?", novelUpdatesID).
+		Preload("Authors").
+		Preload("Genres").
+		Preload("Tags").
+		Limit(1).
+		Find(&existing).Error; err != nil {
+		log.Println(err)
+		return nil, errors.ErrImportingNovel
+	}
+
+	if existing.ID == 0 {
+		return nil, nil
+	}
+
+	if existing.DeletedAt.Valid {
+		if err := n.db.Unscoped().Model(&existing).Update("deleted_at", nil).Error; err != nil {
+			log.Println(err)
+			return nil, errors.ErrImportingNovel
+		}
+	}
+
+	return &existing, nil
+}
+
+// keepLockedFields replaces the fields of an imported novel the administrators locked with their current values.
+//
+// Parameters:
+//   - novel (*models.Novel): The imported novel.
+//   - existing (*models.Novel): The novel as currently stored, with its relationships.
+func keepLockedFields(novel *models.Novel, existing *models.Novel) {
+	for _, field := range existing.GetLockedFields() {
+		switch field {
+		case models.NovelFieldTitle:
+			novel.Title = existing.Title
+		case models.NovelFieldSynopsis:
+			novel.Synopsis = existing.Synopsis
+		case models.NovelFieldCoverUrl:
+			novel.CoverUrl = existing.CoverUrl
+		case models.NovelFieldLanguage:
+			novel.Language = existing.Language
+		case models.NovelFieldStatus:
+			novel.Status = existing.Status
+		case models.NovelFieldYear:
+			novel.Year = existing.Year
+		case models.NovelFieldReleaseFrequency:
+			novel.ReleaseFrequency = existing.ReleaseFrequency
+		case models.NovelFieldTags:
+			novel.Tags = existing.Tags
+		case models.NovelFieldGenres:
+			novel.Genres = existing.Genres
+		case models.NovelFieldAuthors:
+			novel.Authors = existing.Authors
+		}
+	}
+}
+
+// novelFieldColumns are the columns of the editable fields of a novel that are not relationships.
+var novelFieldColumns = map[string]string{
+	models.NovelFieldTitle:            "title",
+	models.NovelFieldSynopsis:         "synopsis",
+	models.NovelFieldCoverUrl:         "cover_url",
+	models.NovelFieldLanguage:         "language",
+	models.NovelFieldStatus:           "status",
+	models.NovelFieldYear:             "year",
+	models.NovelFieldReleaseFrequency: "release_frequency",
+}
+
+// UpdateNovel saves fields of a novel edited by an administrator, and the fields locked against the updates of the
+// imports.
+//
+// Parameters:
+//   - novel (models.Novel): The novel with the edited fields and the locked fields.
+//   - fields ([]string): The names of the edited fields, among models.LockableNovelFields.
+//
+// Returns:
+//   - *models.Novel: The updated novel with its relationships.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelTitleTaken: Returned if another novel has the new title.
+//   - TAG_ASSOCIATION_ERROR, GENRE_ASSOCIATION_ERROR, AUTHOR_ASSOCIATION_ERROR: Returned if the new tags, genres or
+//     authors could not be created.
+//   - UPDATING_NOVEL: Returned if the novel could not be updated.
+//   - errors.ErrNovelNotFound, errors.ErrGettingNovel: Returned if the updated novel could not be retrieved.
+func (n *NovelRepository) UpdateNovel(novel models.Novel, fields []string) (*models.Novel, error) {
+	if n.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	columns := []string{"locked_fields"}
+	associations := make(map[string]any)
+	for _, field := range fields {
+		if column, ok := novelFieldColumns[field]; ok {
+			columns = append(columns, column)
+			continue
+		}
+
+		var err error
+		switch field {
+		case models.NovelFieldTags:
+			associations["Tags"], err = n.processTags(novel.Tags)
+		case models.NovelFieldGenres:
+			associations["Genres"], err = n.processGenres(novel.Genres)
+		case models.NovelFieldAuthors:
+			associations["Authors"], err = n.processAuthors(novel.Authors)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if slices.Contains(fields, models.NovelFieldTitle) {
+		var taken int64
+		if err := n.db.Unscoped().Model(&models.Novel{}).Where("title = ? AND id <> ?", novel.Title, novel.ID).Count(&taken).Error; err != nil {
+			return nil, types.WrapError(errors.UPDATING_NOVEL, "Failed to check the title of the novel", http.StatusInternalServerError, err)
+		}
+		if taken > 0 {
+			return nil, errors.ErrNovelTitleTaken
+		}
+	}
+
+	err := n.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&novel).Select(columns).Omit(clause.Associations).Updates(&novel).Error; err != nil {
+			return types.WrapError(errors.UPDATING_NOVEL, "Failed to update the novel", http.StatusInternalServerError, err)
+		}
+
+		for name, values := range associations {
+			if err := tx.Model(&novel).Association(name).Clear(); err != nil {
+				return types.WrapError(errors.UPDATING_NOVEL, fmt.Sprintf("Failed to clear the %s of the novel", strings.ToLower(name)), http.StatusInternalServerError, err)
+			}
+			if err := tx.Model(&novel).Association(name).Append(values); err != nil {
+				return types.WrapError(errors.UPDATING_NOVEL, fmt.Sprintf("Failed to update the %s of the novel", strings.ToLower(name)), http.StatusInternalServerError, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetNovelByID(novel.ID)
+}
+
+// DeleteNovel deletes a novel. The novel is soft deleted: it's hidden with its chapters, and it's restored if it's
+// imported again.
+//
+// Parameters:
+//   - id (uint): The ID of the novel.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - DELETING_NOVEL: Returned if the novel could not be deleted.
+func (n *NovelRepository) DeleteNovel(id uint) error {
+	if n.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	result := n.db.Delete(&models.Novel{}, id)
+	if result.Error != nil {
+		return types.WrapError(errors.DELETING_NOVEL, "Failed to delete the novel", http.StatusInternalServerError, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.ErrNovelNotFound
+	}
+
+	return nil
+}
+
 // isNovelCreated checks if a novel with the given URL already exists in the database.
 //
 // Parameters:
@@ -147,6 +356,44 @@ func (n *NovelRepository) isNovelCreated(novel models.Novel) bool {
 //   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
 //   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
 func (n *NovelRepository) GetNovels(page, limit int) ([]models.Novel, int64, error) {
+	return n.GetNovelsByQuery(models.NovelQuery{}, page, limit)
+}
+
+// novelSortColumns are the columns the novels are sorted by for each models.NovelSort.
+var novelSortColumns = map[string]string{
+	models.NovelSortTitle:         "novels.title",
+	models.NovelSortCreated:       "novels.created_at",
+	models.NovelSortUpdated:       "novels.updated_at",
+	models.NovelSortLatestChapter: "novels.latest_chapter",
+	models.NovelSortYear:          "novels.year",
+	models.NovelSortPopularity:    novelPopularity,
+}
+
+// novelKnownYear matches the novels whose year is known, which is stored as four digits.
+const novelKnownYear = "LENGTH(novels.year) = 4 AND novels.year BETWEEN '0000' AND '9999'"
+
+// novelPopularity counts the users who bookmarked a novel.
+const novelPopularity = "(SELECT COUNT(*) FROM bookmarked_novels WHERE bookmarked_novels.novel_id = novels.id AND bookmarked_novels.deleted_at IS NULL)"
+
+// GetNovelsByQuery retrieves a paginated list of the novels matching the filters of a query, in its order.
+//
+// Parameters:
+//   - query (models.NovelQuery): The filters and the order of the novels. The names of the tags, genres and authors
+//     are matched ignoring case.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of novels to return per page.
+//
+// Returns:
+//   - []models.Novel: A slice of novels matching the query.
+//   - int64: The total number of novels matching the query.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNoNovels: Returned if no novels are found.
+//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+func (n *NovelRepository) GetNovelsByQuery(query models.NovelQuery, page, limit int) ([]models.Novel, int64, error) {
 	if n.IsDown() {
 		return nil, 0, errors.ErrDatabaseOffline
 	}
@@ -154,8 +401,8 @@ func (n *NovelRepository) GetNovels(page, limit int) ([]models.Novel, int64, err
 	var novels []models.Novel
 	var total int64
 
-	// Count total novels for the author
-	if err := n.db.Model(&models.Novel{}).
+	// Count total novels matching the query
+	if err := applyNovelQuery(n.db.Model(&models.Novel{}), query).
 		Count(&total).Error; err != nil {
 
 		if err.Error() == "record not found" {
@@ -167,10 +414,11 @@ func (n *NovelRepository) GetNovels(page, limit int) ([]models.Novel, int64, err
 
 	// Apply pagination and ordering
 	offset := (page - 1) * limit
-	if err := n.db.Model(&models.Novel{}).
+	if err := applyNovelQuery(n.db.Model(&models.Novel{}), query).
 		Preload("Authors").
 		Preload("Genres").
 		Preload("Tags").
+		Order(novelOrder(query)).
 		Limit(limit).Offset(offset).
 		Find(&novels).Error; err != nil {
 
@@ -183,66 +431,232 @@ func (n *NovelRepository) GetNovels(page, limit int) ([]models.Novel, int64, err
 	return novels, total, nil
 }
 
-// GetNovelsByGenreName retrieves a paginated list of novels by a given genre.
+// GetNovelsPage retrieves a page of the novels matching the filters of a query, in its order, starting after the
+// novel of a cursor instead of skipping a number of novels, so that deep pages are as fast as the first ones and
+// novels added while paginating are neither skipped nor repeated.
 //
 // Parameters:
-//   - genreName (string): The name of the genre to search for.
-//   - page (int): The page number for pagination (1-based).
-//   - limit (int): The maximum number of novels to return per page.
+//   - query (models.NovelQuery): The filters and the order of the novels.
+//   - after (*models.NovelCursor): The position of the last novel of the previous page, or nil for the first page.
+//     It must have been returned for a query with the same order.
+//   - limit (int): The maximum number of novels to return.
 //
 // Returns:
-//   - []models.Novel: A slice of novels matching the genre.
-//   - int64: The total number of novels by the genre.
+//   - []models.Novel: A slice of novels matching the query.
+//   - *models.NovelCursor: The position of the last novel of the page, or nil if it is the last page.
 //   - error: An error object indicating any issues encountered during the retrieval process.
 //
 // Error types:
 //   - errors.ErrDatabaseOffline: Returned if the database is offline.
-//   - errors.ErrNoNovels: Returned if no novels are found for the given genre.
-//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
-//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
-func (n *NovelRepository) GetNovelsByGenreName(genreName string, page, limit int) ([]models.Novel, int64, error) {
+//   - errors.ErrInvalidCursor: Returned if the value of the cursor doesn't match the order of the query.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels.
+func (n *NovelRepository) GetNovelsPage(query models.NovelQuery, after *models.NovelCursor, limit int) ([]models.Novel, *models.NovelCursor, error) {
 	if n.IsDown() {
-		return nil, 0, errors.ErrDatabaseOffline
+		return nil, nil, errors.ErrDatabaseOffline
 	}
 
-	var novels []models.Novel
-	var total int64
-
-	// Count total novels for the genre
-	if err := n.db.Model(&models.Novel{}).
-		Joins("JOIN novel_genres ON novel_genres.novel_id = novels.id").
-		Joins("JOIN genres ON genres.id = novel_genres.genre_id").
-		Where("genres.name = ?", genreName).
-		Count(&total).Error; err != nil {
+	db := applyNovelQuery(n.db.Model(&models.Novel{}), query)
 
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoNovels
+	if after != nil {
+		operator := ">"
+		if query.Descending {
+			operator = "<"
 		}
 
-		return nil, 0, errors.ErrGettingTotalNovels
+		column, ok := novelSortColumns[query.Sort]
+		if !ok {
+			db = db.Where("novels.id "+operator+" ?", after.ID)
+		} else {
+			value, err := novelSortValue(query.Sort, after.Value)
+			if err != nil {
+				return nil, nil, errors.ErrInvalidCursor
+			}
+			// The novels with the same value are always sorted by ascending ID
+			db = db.Where(fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND novels.id > ?))", column, operator), value, value, after.ID)
+		}
 	}
 
-	// Apply pagination and ordering
-	offset := (page - 1) * limit
-	if err := n.db.Model(&models.Novel{}).
-		Joins("JOIN novel_genres ON novel_genres.novel_id = novels.id").
-		Joins("JOIN genres ON genres.id = novel_genres.genre_id").
-		Where("genres.name = ?", genreName).
+	// Fetch one more novel to know whether there is a next page
+	var novels []models.Novel
+	if err := db.
 		Preload("Authors").
 		Preload("Genres").
 		Preload("Tags").
-		Limit(limit).
-		Offset(offset).
+		Order(novelOrder(query)).
+		Limit(limit + 1).
 		Find(&novels).Error; err != nil {
+		return nil, nil, errors.ErrGettingNovels
+	}
+
+	if len(novels) <= limit {
+		return novels, nil, nil
+	}
+
+	novels = novels[:limit]
+	last := novels[limit-1]
+	next := &models.NovelCursor{Sort: query.Sort, Descending: query.Descending, ID: last.ID}
+
+	switch query.Sort {
+	case models.NovelSortTitle:
+		next.Value = last.Title
+	case models.NovelSortCreated:
+		next.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	case models.NovelSortUpdated:
+		next.Value = last.UpdatedAt.Format(time.RFC3339Nano)
+	case models.NovelSortLatestChapter:
+		next.Value = strconv.Itoa(last.LatestChapter)
+	case models.NovelSortYear:
+		next.Value = last.Year
+	case models.NovelSortPopularity:
+		var popularity int64
+		if err := n.db.Model(&models.BookmarkedNovel{}).Where("novel_id = ?", last.ID).Count(&popularity).Error; err != nil {
+			return nil, nil, errors.ErrGettingNovels
+		}
+		next.Value = strconv.FormatInt(popularity, 10)
+	}
 
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoNovels
+	return novels, next, nil
+}
+
+// novelSortValue converts the value of a cursor to the type of the column the novels are sorted by.
+//
+// Parameters:
+//   - sort (string): The models.NovelSort of the novels.
+//   - value (string): The value of the cursor.
+//
+// Returns:
+//   - any: The value to compare the column with.
+//   - error: An error if the value doesn't have the type of the column.
+func novelSortValue(sort, value string) (any, error) {
+	switch sort {
+	case models.NovelSortCreated, models.NovelSortUpdated:
+		return time.Parse(time.RFC3339Nano, value)
+	case models.NovelSortLatestChapter, models.NovelSortPopularity:
+		return strconv.ParseInt(value, 10, 64)
+	default:
+		return value, nil
+	}
+}
+
+// novelOrder returns the ORDER BY clause of a query of the novels, the novels with the same value being sorted by
+// ascending ID so that the order is stable between pages.
+//
+// Parameters:
+//   - query (models.NovelQuery): The order of the novels.
+//
+// Returns:
+//   - string: The ORDER BY clause.
+func novelOrder(query models.NovelQuery) string {
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+
+	column, ok := novelSortColumns[query.Sort]
+	if !ok {
+		return "novels.id " + direction
+	}
+	return fmt.Sprintf("%s %s, novels.id ASC", column, direction)
+}
+
+// applyNovelQuery adds the filters of a query to a query of the novels.
+//
+// Parameters:
+//   - db (*gorm.DB): The query of the novels.
+//   - query (models.NovelQuery): The filters to add.
+//
+// Returns:
+//   - *gorm.DB: The query with the filters.
+func applyNovelQuery(db *gorm.DB, query models.NovelQuery) *gorm.DB {
+	matchAny := query.Match == models.NovelMatchAny
+	db = filterByTaxonomy(db, tagTaxonomy, query.IncludeTags, query.ExcludeTags, matchAny)
+	db = filterByTaxonomy(db, genreTaxonomy, query.IncludeGenres, query.ExcludeGenres, matchAny)
+	db = filterByTaxonomy(db, authorTaxonomy, query.IncludeAuthors, query.ExcludeAuthors, matchAny)
+
+	if query.Status != "" {
+		db = db.Where("LOWER(novels.status) = ?", strings.ToLower(query.Status))
+	}
+	if query.Language != "" {
+		db = db.Where("LOWER(novels.language) = ?", strings.ToLower(query.Language))
+	}
+
+	// The years are stored as text, which sorts like the numbers as long as they have four digits. The unknown years
+	// ("N/A" for the sources that don't have them) sort after the digits, so they are left out explicitly
+	if query.YearFrom != 0 {
+		db = db.Where(novelKnownYear+" AND novels.year >= ?", fmt.Sprintf("%04d", query.YearFrom))
+	}
+	if query.YearTo != 0 {
+		db = db.Where(novelKnownYear+" AND novels.year <= ?", fmt.Sprintf("%04d", query.YearTo))
+	}
+
+	if query.MinChapters > 0 {
+		db = db.Where("novels.latest_chapter >= ?", query.MinChapters)
+	}
+
+	return db
+}
+
+// filterByTaxonomy adds to a query of the novels the tags, genres or authors the novels must and must not have.
+//
+// Parameters:
+//   - db (*gorm.DB): The query of the novels.
+//   - kind (taxonomy): The taxonomy of the entries.
+//   - include ([]string): The names of the entries the novels must have.
+//   - exclude ([]string): The names of the entries the novels must not have.
+//   - matchAny (bool): Whether having one of the included entries is enough.
+//
+// Returns:
+//   - *gorm.DB: The query with the filters.
+func filterByTaxonomy(db *gorm.DB, kind taxonomy, include, exclude []string, matchAny bool) *gorm.DB {
+	novelsWith := fmt.Sprintf(`SELECT %[1]s.novel_id FROM %[1]s
+		JOIN %[2]s ON %[2]s.id = %[1]s.%[3]s
+		WHERE %[1]s.novel_id IS NOT NULL AND LOWER(%[2]s.name) IN ?`, kind.joinTable, kind.table, kind.foreignKey)
+
+	if len(include) > 0 {
+		if matchAny {
+			db = db.Where("novels.id IN ("+novelsWith+")", lowerNames(include))
+		} else {
+			for _, name := range include {
+				db = db.Where("novels.id IN ("+novelsWith+")", lowerNames([]string{name}))
+			}
 		}
+	}
 
-		return nil, 0, errors.ErrGettingNovels
+	if len(exclude) > 0 {
+		db = db.Where("novels.id NOT IN ("+novelsWith+")", lowerNames(exclude))
 	}
 
-	return novels, total, nil
+	return db
+}
+
+// lowerNames returns the names in lowercase.
+func lowerNames(names []string) []string {
+	lowered := make([]string, len(names))
+	for i, name := range names {
+		lowered[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	return lowered
+}
+
+// GetNovelsByGenreName retrieves a paginated list of novels by a given genre.
+//
+// Parameters:
+//   - genreName (string): The name of the genre to search for.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of novels to return per page.
+//
+// Returns:
+//   - []models.Novel: A slice of novels matching the genre.
+//   - int64: The total number of novels by the genre.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNoNovels: Returned if no novels are found for the given genre.
+//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+func (n *NovelRepository) GetNovelsByGenreName(genreName string, page, limit int) ([]models.Novel, int64, error) {
+	return n.GetNovelsByQuery(models.NovelQuery{IncludeGenres: []string{genreName}}, page, limit)
 }
 
 // GetNovelsByTagName retrieves a paginated list of novels by a given tag.
@@ -263,48 +677,7 @@ func (n *NovelRepository) GetNovelsByGenreName(genreName string, page, limit int
 //   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
 //   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
 func (n *NovelRepository) GetNovelsByTagName(tagName string, page, limit int) ([]models.Novel, int64, error) {
-	if n.IsDown() {
-		return nil, 0, errors.ErrDatabaseOffline
-	}
-
-	var novels []models.Novel
-	var total int64
-
-	// Count total novels for the tag
-	if err := n.db.Model(&models.Novel{}).
-		Joins("JOIN novel_tags ON novel_tags.novel_id = novels.id").
-		Joins("JOIN tags ON tags.id = novel_tags.tag_id").
-		Where("tags.name = ?", tagName).
-		Count(&total).Error; err != nil {
-
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoNovels
-		}
-
-		return nil, 0, errors.ErrGettingTotalNovels
-	}
-
-	// Apply pagination and ordering
-	offset := (page - 1) * limit
-	if err := n.db.Model(&models.Novel{}).
-		Joins("JOIN novel_tags ON novel_tags.novel_id = novels.id").
-		Joins("JOIN tags ON tags.id = novel_tags.tag_id").
-		Where("tags.name = ?", tagName).
-		Preload("Authors").
-		Preload("Genres").
-		Preload("Tags").
-		Limit(limit).
-		Offset(offset).
-		Find(&novels).Error; err != nil {
-
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoNovels
-		}
-
-		return nil, 0, errors.ErrGettingNovels
-	}
-
-	return novels, total, nil
+	return n.GetNovelsByQuery(models.NovelQuery{IncludeTags: []string{tagName}}, page, limit)
 }
 
 // GetNovelsByAuthorName retrieves a paginated list of novels by a given author name.
@@ -325,6 +698,26 @@ func (n *NovelRepository) GetNovelsByTagName(tagName string, page, limit int) ([
 //   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
 //   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
 func (n *NovelRepository) GetNovelsByAuthorName(authorName string, page, limit int) ([]models.Novel, int64, error) {
+	return n.GetNovelsByQuery(models.NovelQuery{IncludeAuthors: []string{authorName}}, page, limit)
+}
+
+// SearchNovelsByTitle retrieves a paginated list of the novels whose title contains a query, ignoring case.
+//
+// Parameters:
+//   - query (string): The text to search for in the titles.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of novels to return per page.
+//
+// Returns:
+//   - []models.Novel: A slice of novels matching the query, ordered by title.
+//   - int64: The total number of novels matching the query.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+func (n *NovelRepository) SearchNovelsByTitle(query string, page, limit int) ([]models.Novel, int64, error) {
 	if n.IsDown() {
 		return nil, 0, errors.ErrDatabaseOffline
 	}
@@ -332,36 +725,23 @@ func (n *NovelRepository) GetNovelsByAuthorName(authorName string, page, limit i
 	var novels []models.Novel
 	var total int64
 
-	// Count total novels for the author
+	pattern := "%" + strings.ToLower(query) + "%"
+
 	if err := n.db.Model(&models.Novel{}).
-		Joins("JOIN novel_authors ON novel_authors.novel_id = novels.id").
-		Joins("JOIN authors ON authors.id = novel_authors.author_id").
-		Where("authors.name = ?", authorName).
+		Where("LOWER(title) LIKE ?", pattern).
 		Count(&total).Error; err != nil {
-
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoNovels
-		}
-
 		return nil, 0, errors.ErrGettingTotalNovels
 	}
 
-	// Apply pagination and ordering
 	offset := (page - 1) * limit
 	if err := n.db.Model(&models.Novel{}).
-		Joins("JOIN novel_authors ON novel_authors.novel_id = novels.id").
-		Joins("JOIN authors ON authors.id = novel_authors.author_id").
-		Where("authors.name = ?", authorName).
+		Where("LOWER(title) LIKE ?", pattern).
 		Preload("Authors").
 		Preload("Genres").
 		Preload("Tags").
+		Order("title ASC").
 		Limit(limit).Offset(offset).
 		Find(&novels).Error; err != nil {
-
-		if err.Error() == "record not found" {
-			return nil, 0, errors.ErrNoNovels
-		}
-
 		return nil, 0, errors.ErrGettingNovels
 	}
 	return novels, total, nil
@@ -436,6 +816,84 @@ func (n *NovelRepository) GetNovelByUpdatesID(title string) (*models.Novel, erro
 	return &novel, nil
 }
 
+// GetNovelByTitle retrieves a novel from the database based on its title, ignoring case, without its relationships.
+//
+// Parameters:
+//   - title (string): The title of the novel.
+//
+// Returns:
+//   - *models.Novel: A pointer to the retrieved novel, or nil if not found.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if no novel with the given title exists in the database.
+//   - errors.ErrGettingNovel: Returned if an error occurred while retrieving the novel from the database.
+func (n *NovelRepository) GetNovelByTitle(title string) (*models.Novel, error) {
+	if n.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var novels []models.Novel
+	if err := n.db.Where("LOWER(title) = ?", strings.ToLower(title)).Limit(1).Find(&novels).Error; err != nil {
+		return nil, errors.ErrGettingNovel
+	}
+	if len(novels) == 0 {
+		return nil, errors.ErrNovelNotFound
+	}
+	return &novels[0], nil
+}
+
+// GetOngoingNovels retrieves all the novels whose status is not completed, without their relationships. It is used to
+// find the novels that can still get new chapters.
+//
+// Returns:
+//   - []models.Novel: A slice of novels, ordered by ID.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingNovels: Returned if an error occurred while retrieving the novels.
+func (n *NovelRepository) GetOngoingNovels() ([]models.Novel, error) {
+	if n.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var novels []models.Novel
+	if err := n.db.Model(&models.Novel{}).
+		Where("LOWER(status) NOT LIKE ?", "%completed%").
+		Order("id ASC").
+		Find(&novels).Error; err != nil {
+		return nil, errors.ErrGettingNovels
+	}
+	return novels, nil
+}
+
+// UpdateNovelSourcePriority updates the source priority of a novel.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - sourcePriority (string): The comma separated names of the sources to try first, in order.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - UPDATING_SOURCE_PRIORITY: Returned if the source priority could not be updated.
+func (n *NovelRepository) UpdateNovelSourcePriority(novelID uint, sourcePriority string) error {
+	if n.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	if err := n.db.Model(&models.Novel{}).
+		Where("id = ?", novelID).
+		Update("source_priority", sourcePriority).Error; err != nil {
+		return types.WrapError(errors.UPDATING_SOURCE_PRIORITY, "Failed to update the source priority", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
 // processTags processes a list of tags, ensuring they exist in the database.
 // It iterates through the input tags and either retrieves existing tags or creates new ones if they don't exist.
 // Empty tag names are skipped.
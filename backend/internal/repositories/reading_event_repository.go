@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReadingEventRepository represents a repository for the reading history of the users.
+// It embeds the BaseRepository to inherit common database operations.
+type ReadingEventRepository struct {
+	*BaseRepository
+}
+
+// NewReadingEventRepository creates a new ReadingEventRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *ReadingEventRepository: A pointer to the newly created ReadingEventRepository.
+func NewReadingEventRepository(db *gorm.DB) *ReadingEventRepository {
+	return &ReadingEventRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// CreateReadingEvent adds an event to the reading history of a user.
+//
+// Parameters:
+//   - event (models.ReadingEvent): The event.
+//
+// Returns:
+//   - *models.ReadingEvent: A pointer to the created event.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_READING_EVENT: Returned if the event could not be created.
+func (r *ReadingEventRepository) CreateReadingEvent(event models.ReadingEvent) (*models.ReadingEvent, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	if err := r.db.Create(&event).Error; err != nil {
+		return nil, types.WrapError(errors.SAVING_READING_EVENT, "Failed to save the reading event", http.StatusInternalServerError, err)
+	}
+	return &event, nil
+}
+
+// GetLastOpenedReadingEvent retrieves the event of the chapter a user fetched last.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - *models.ReadingEvent: A pointer to the event, or nil if the user never fetched a chapter.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_EVENTS: Returned if the event could not be retrieved.
+func (r *ReadingEventRepository) GetLastOpenedReadingEvent(userID uint) (*models.ReadingEvent, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var events []models.ReadingEvent
+	if err := r.db.Where("user_id = ? AND event = ?", userID, models.ReadingEventOpened).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(1).
+		Find(&events).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_READING_EVENTS, "Failed to fetch the last reading event", http.StatusInternalServerError, err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return &events[0], nil
+}
+
+// GetReadingEvents retrieves the reading history of a user since a time, oldest first.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - since (time.Time): The time to start at, the zero time for the whole history.
+//
+// Returns:
+//   - []models.ReadingEvent: The events of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_EVENTS: Returned if the events could not be retrieved.
+func (r *ReadingEventRepository) GetReadingEvents(userID uint, since time.Time) ([]models.ReadingEvent, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	events := []models.ReadingEvent{}
+	if err := r.db.Where("user_id = ? AND created_at >= ?", userID, since).
+		Order("created_at ASC").
+		Find(&events).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_READING_EVENTS, "Failed to fetch the reading history", http.StatusInternalServerError, err)
+	}
+	return events, nil
+}
+
+// GetReadingShares counts the chapters a user finished since a time by genre or tag of their novels, most read first.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - kind (models.TaxonomyKind): The taxonomy to count by.
+//   - since (time.Time): The time to start at.
+//   - limit (int): The maximum number of genres or tags.
+//
+// Returns:
+//   - []models.ReadingShare: The chapters finished by genre or tag.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - GETTING_READING_EVENTS: Returned if the chapters could not be counted.
+func (r *ReadingEventRepository) GetReadingShares(userID uint, kind models.TaxonomyKind, since time.Time, limit int) ([]models.ReadingShare, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	tax, ok := taxonomies[kind]
+	if !ok {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	shares := []models.ReadingShare{}
+	if err := r.db.Table("reading_events").
+		Select(fmt.Sprintf("%s.name AS name, COUNT(*) AS chapters", tax.table)).
+		Joins(fmt.Sprintf("JOIN %s ON %s.novel_id = reading_events.novel_id", tax.joinTable, tax.joinTable)).
+		Joins(fmt.Sprintf("JOIN %s ON %s.id = %s.%s", tax.table, tax.table, tax.joinTable, tax.foreignKey)).
+		Where("reading_events.user_id = ? AND reading_events.event = ? AND reading_events.created_at >= ?", userID, models.ReadingEventFinished, since).
+		Group(tax.table + ".name").
+		Order("chapters DESC").
+		Order(tax.table + ".name ASC").
+		Limit(limit).
+		Scan(&shares).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_READING_EVENTS, "Failed to count the chapters read by "+string(kind), http.StatusInternalServerError, err)
+	}
+	return shares, nil
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"net/http"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReadingPositionRepository represents a repository for the reading positions the users sync across their devices.
+// It embeds the BaseRepository to inherit common database operations.
+type ReadingPositionRepository struct {
+	*BaseRepository
+}
+
+// NewReadingPositionRepository creates a new ReadingPositionRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *ReadingPositionRepository: A pointer to the newly created ReadingPositionRepository.
+func NewReadingPositionRepository(db *gorm.DB) *ReadingPositionRepository {
+	return &ReadingPositionRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// GetReadingPosition retrieves the reading position of a user in a novel.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *models.ReadingPosition: A pointer to the reading position, or nil if the user never synced the novel.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_POSITION: Returned if the reading position could not be retrieved.
+func (r *ReadingPositionRepository) GetReadingPosition(userID, novelID uint) (*models.ReadingPosition, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var positions []models.ReadingPosition
+	if err := r.db.Where("user_id = ? AND novel_id = ?", userID, novelID).Limit(1).Find(&positions).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_READING_POSITION, "Failed to fetch the reading position", http.StatusInternalServerError, err)
+	}
+	if len(positions) == 0 {
+		return nil, nil
+	}
+	return &positions[0], nil
+}
+
+// GetReadingPositions retrieves the reading positions of a user in all the novels they synced, most recently read
+// first.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - []models.ReadingPosition: The reading positions of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_POSITION: Returned if the reading positions could not be retrieved.
+func (r *ReadingPositionRepository) GetReadingPositions(userID uint) ([]models.ReadingPosition, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	positions := []models.ReadingPosition{}
+	if err := r.db.Where("user_id = ?", userID).Order("read_at DESC").Find(&positions).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_READING_POSITION, "Failed to fetch the reading positions", http.StatusInternalServerError, err)
+	}
+	return positions, nil
+}
+
+// SaveReadingPosition stores the reading position of a user in a novel unless the stored one was reached later, so the
+// last position read wins whatever order the devices sync in. The current chapter of the bookmark of the novel follows
+// the stored position, in the same transaction.
+//
+// Parameters:
+//   - position (models.ReadingPosition): The reading position.
+//
+// Returns:
+//   - bool: Whether the position was stored, false if the stored one was reached later.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_READING_POSITION: Returned if the reading position could not be stored.
+func (r *ReadingPositionRepository) SaveReadingPosition(position models.ReadingPosition) (bool, error) {
+	if r.IsDown() {
+		return false, errors.ErrDatabaseOffline
+	}
+
+	saved := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "novel_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"chapter_no", "paragraph", "percent", "device_id", "read_at", "updated_at"}),
+			Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "reading_positions.read_at <= excluded.read_at"}}},
+		}).Create(&position)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		saved = result.RowsAffected > 0
+		if !saved {
+			return nil
+		}
+
+		return tx.Model(&models.BookmarkedNovel{}).
+			Where("user_id = ? AND novel_id = ?", position.UserID, position.NovelID).
+			Update("current_chapter", position.ChapterNo).Error
+	})
+	if err != nil {
+		return false, types.WrapError(errors.SAVING_READING_POSITION, "Failed to save the reading position", http.StatusInternalServerError, err)
+	}
+
+	return saved, nil
+}
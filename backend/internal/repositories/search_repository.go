@@ -0,0 +1,249 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// searchHighlightStart and searchHighlightEnd surround the matching words in the snippets the databases return. They
+// are private-use characters, so that the text of the snippets can be escaped before the markers become <mark> tags.
+const (
+	searchHighlightStart = "\uE000"
+	searchHighlightEnd   = "\uE001"
+)
+
+// snippetHighlighter turns the markers of an escaped snippet into <mark> tags.
+var snippetHighlighter = strings.NewReplacer(searchHighlightStart, "<mark>", searchHighlightEnd, "</mark>")
+
+// postgresNovelText builds the text the snippets of a novel are taken from in PostgreSQL: its title, the names of its
+// authors and its synopsis. The document the novel is searched by is stored in novels.search_document by the triggers
+// created by config.ConnectDB.
+const postgresNovelText = `concat_ws(' ', novels.title, (
+		SELECT string_agg(authors.name, ', ') FROM authors
+		JOIN novel_authors ON novel_authors.author_id = authors.id
+		WHERE novel_authors.novel_id = novels.id
+	), novels.synopsis)`
+
+// SearchRepository represents a repository for the full-text search of the novels and their chapters. It searches
+// with tsvectors in PostgreSQL and with the FTS5 tables created by config.ConnectDB in SQLite.
+// It embeds the BaseRepository to inherit common database operations.
+type SearchRepository struct {
+	*BaseRepository
+}
+
+// NewSearchRepository creates a new SearchRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *SearchRepository: A pointer to the newly created SearchRepository.
+func NewSearchRepository(db *gorm.DB) *SearchRepository {
+	return &SearchRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// searchHit is a row matching a search, before the rest of its data is loaded.
+type searchHit struct {
+	ID        uint
+	ChapterNo uint
+	Title     string
+	Rank      float64
+	Snippet   string
+}
+
+// SearchNovels retrieves a paginated list of the novels whose title, synopsis or authors contain all the terms of a
+// search, as words or word prefixes, ordered by relevance.
+//
+// Parameters:
+//   - terms ([]string): The lowercase words to search for, as returned by utils.SearchTerms.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of novels to return per page.
+//
+// Returns:
+//   - []models.NovelSearchResult: A slice of the matching novels, most relevant first.
+//   - int64: The total number of matching novels.
+//   - error: An error object indicating any issues encountered during the search.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SEARCHING_NOVELS: Returned if an error occurs while searching the novels.
+func (s *SearchRepository) SearchNovels(terms []string, page, limit int) ([]models.NovelSearchResult, int64, error) {
+	if s.IsDown() {
+		return nil, 0, errors.ErrDatabaseOffline
+	}
+
+	var countQuery, searchQuery string
+	var match string
+	if s.isSQLite() {
+		match = sqliteMatch(terms)
+		countQuery = `SELECT COUNT(*) FROM novels_fts
+			JOIN novels ON novels.id = novels_fts.rowid
+			WHERE novels_fts MATCH ? AND novels.deleted_at IS NULL`
+		searchQuery = fmt.Sprintf(`SELECT novels_fts.rowid AS id, -bm25(novels_fts, 10.0, 1.0, 5.0) AS rank,
+				snippet(novels_fts, -1, '%s', '%s', '…', 24) AS snippet
+			FROM novels_fts
+			JOIN novels ON novels.id = novels_fts.rowid
+			WHERE novels_fts MATCH ? AND novels.deleted_at IS NULL
+			ORDER BY rank DESC, novels.id ASC
+			LIMIT ? OFFSET ?`, searchHighlightStart, searchHighlightEnd)
+	} else {
+		match = postgresMatch(terms)
+		countQuery = `SELECT COUNT(*) FROM novels, to_tsquery('simple', ?) AS query
+			WHERE novels.search_document @@ query AND novels.deleted_at IS NULL`
+		searchQuery = fmt.Sprintf(`SELECT novels.id, ts_rank(novels.search_document, query) AS rank,
+				ts_headline('simple', %s, query, 'StartSel=%s, StopSel=%s, MaxFragments=2') AS snippet
+			FROM novels, to_tsquery('simple', ?) AS query
+			WHERE novels.search_document @@ query AND novels.deleted_at IS NULL
+			ORDER BY rank DESC, novels.id ASC
+			LIMIT ? OFFSET ?`, postgresNovelText, searchHighlightStart, searchHighlightEnd)
+	}
+
+	var total int64
+	if err := s.db.Raw(countQuery, match).Scan(&total).Error; err != nil {
+		return nil, 0, types.WrapError(errors.SEARCHING_NOVELS, "Failed to count the novels matching the search", http.StatusInternalServerError, err)
+	}
+
+	var hits []searchHit
+	offset := (page - 1) * limit
+	if err := s.db.Raw(searchQuery, match, limit, offset).Scan(&hits).Error; err != nil {
+		return nil, 0, types.WrapError(errors.SEARCHING_NOVELS, "Failed to search the novels", http.StatusInternalServerError, err)
+	}
+
+	results := make([]models.NovelSearchResult, 0, len(hits))
+	if len(hits) == 0 {
+		return results, total, nil
+	}
+
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+
+	var novels []models.Novel
+	if err := s.db.Where("id IN ?", ids).
+		Preload("Authors").
+		Preload("Genres").
+		Preload("Tags").
+		Find(&novels).Error; err != nil {
+		return nil, 0, types.WrapError(errors.SEARCHING_NOVELS, "Failed to fetch the novels matching the search", http.StatusInternalServerError, err)
+	}
+
+	novelsByID := make(map[uint]models.Novel, len(novels))
+	for _, novel := range novels {
+		novelsByID[novel.ID] = novel
+	}
+
+	for _, hit := range hits {
+		if novel, ok := novelsByID[hit.ID]; ok {
+			results = append(results, models.NovelSearchResult{Novel: novel, Rank: hit.Rank, Snippet: highlightSnippet(hit.Snippet)})
+		}
+	}
+
+	return results, total, nil
+}
+
+// SearchChapters retrieves a paginated list of the chapters of a novel whose text contains all the terms of a search,
+// as words or word prefixes, ordered by relevance.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - terms ([]string): The lowercase words to search for, as returned by utils.SearchTerms.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of chapters to return per page.
+//
+// Returns:
+//   - []models.ChapterSearchResult: A slice of the matching chapters, most relevant first.
+//   - int64: The total number of matching chapters.
+//   - error: An error object indicating any issues encountered during the search.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SEARCHING_CHAPTERS: Returned if an error occurs while searching the chapters.
+func (s *SearchRepository) SearchChapters(novelID uint, terms []string, page, limit int) ([]models.ChapterSearchResult, int64, error) {
+	if s.IsDown() {
+		return nil, 0, errors.ErrDatabaseOffline
+	}
+
+	var countQuery, searchQuery string
+	var match string
+	if s.isSQLite() {
+		match = sqliteMatch(terms)
+		countQuery = `SELECT COUNT(*) FROM chapters_fts
+			JOIN chapters ON chapters.id = chapters_fts.rowid
+			WHERE chapters_fts MATCH ? AND chapters.novel_id = ? AND chapters.deleted_at IS NULL`
+		searchQuery = fmt.Sprintf(`SELECT chapters.id, chapters.chapter_no, chapters.title, -bm25(chapters_fts) AS rank,
+				snippet(chapters_fts, 0, '%s', '%s', '…', 24) AS snippet
+			FROM chapters_fts
+			JOIN chapters ON chapters.id = chapters_fts.rowid
+			WHERE chapters_fts MATCH ? AND chapters.novel_id = ? AND chapters.deleted_at IS NULL
+			ORDER BY rank DESC, chapters.chapter_no ASC
+			LIMIT ? OFFSET ?`, searchHighlightStart, searchHighlightEnd)
+	} else {
+		match = postgresMatch(terms)
+		countQuery = `SELECT COUNT(*) FROM chapters, to_tsquery('simple', ?) AS query
+			WHERE to_tsvector('simple', chapters.body) @@ query AND chapters.novel_id = ? AND chapters.deleted_at IS NULL`
+		searchQuery = fmt.Sprintf(`SELECT chapters.id, chapters.chapter_no, chapters.title,
+				ts_rank(to_tsvector('simple', chapters.body), query) AS rank,
+				ts_headline('simple', chapters.body, query, 'StartSel=%s, StopSel=%s, MaxFragments=2') AS snippet
+			FROM chapters, to_tsquery('simple', ?) AS query
+			WHERE to_tsvector('simple', chapters.body) @@ query AND chapters.novel_id = ? AND chapters.deleted_at IS NULL
+			ORDER BY rank DESC, chapters.chapter_no ASC
+			LIMIT ? OFFSET ?`, searchHighlightStart, searchHighlightEnd)
+	}
+
+	var total int64
+	if err := s.db.Raw(countQuery, match, novelID).Scan(&total).Error; err != nil {
+		return nil, 0, types.WrapError(errors.SEARCHING_CHAPTERS, "Failed to count the chapters matching the search", http.StatusInternalServerError, err)
+	}
+
+	var hits []searchHit
+	offset := (page - 1) * limit
+	if err := s.db.Raw(searchQuery, match, novelID, limit, offset).Scan(&hits).Error; err != nil {
+		return nil, 0, types.WrapError(errors.SEARCHING_CHAPTERS, "Failed to search the chapters", http.StatusInternalServerError, err)
+	}
+
+	results := make([]models.ChapterSearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = models.ChapterSearchResult{ID: hit.ID, ChapterNo: hit.ChapterNo, Title: hit.Title, Rank: hit.Rank, Snippet: highlightSnippet(hit.Snippet)}
+	}
+
+	return results, total, nil
+}
+
+// isSQLite reports whether the repository searches an SQLite database rather than a PostgreSQL one.
+func (s *SearchRepository) isSQLite() bool {
+	return s.db.Dialector.Name() == "sqlite"
+}
+
+// highlightSnippet escapes the text of a snippet, so that the HTML of the novels and chapters is shown as text, and
+// surrounds its matching words with <mark> tags.
+func highlightSnippet(snippet string) string {
+	return snippetHighlighter.Replace(html.EscapeString(snippet))
+}
+
+// sqliteMatch builds the FTS5 query matching all the terms as word prefixes.
+func sqliteMatch(terms []string) string {
+	prefixes := make([]string, len(terms))
+	for i, term := range terms {
+		prefixes[i] = `"` + term + `"*`
+	}
+	return strings.Join(prefixes, " ")
+}
+
+// postgresMatch builds the tsquery matching all the terms as word prefixes.
+func postgresMatch(terms []string) string {
+	prefixes := make([]string, len(terms))
+	for i, term := range terms {
+		prefixes[i] = term + ":*"
+	}
+	return strings.Join(prefixes, " & ")
+}
@@ -0,0 +1,240 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+// ShelfRepository represents a repository for the shelves the users organize their libraries with.
+// It embeds the BaseRepository to inherit common database operations.
+type ShelfRepository struct {
+	*BaseRepository
+}
+
+// NewShelfRepository creates a new ShelfRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *ShelfRepository: A pointer to the newly created ShelfRepository.
+func NewShelfRepository(db *gorm.DB) *ShelfRepository {
+	return &ShelfRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// GetShelves retrieves the shelves of a user with their novels, ordered by name.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - []models.Shelf: The shelves of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES: Returned if the shelves could not be retrieved.
+func (r *ShelfRepository) GetShelves(userID uint) ([]models.Shelf, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	shelves := []models.Shelf{}
+	if err := r.db.Preload("Novels", orderNovelsByTitle).Where("user_id = ?", userID).Order("name ASC").Find(&shelves).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_SHELVES, "Failed to fetch the shelves", http.StatusInternalServerError, err)
+	}
+	return shelves, nil
+}
+
+// GetShelf retrieves a shelf of a user with its novels.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the shelf, or nil if the user has no shelf with this ID.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES: Returned if the shelf could not be retrieved.
+func (r *ShelfRepository) GetShelf(userID, shelfID uint) (*models.Shelf, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var shelves []models.Shelf
+	if err := r.db.Preload("Novels", orderNovelsByTitle).Where("id = ? AND user_id = ?", shelfID, userID).Limit(1).Find(&shelves).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_SHELVES, "Failed to fetch the shelf", http.StatusInternalServerError, err)
+	}
+	if len(shelves) == 0 {
+		return nil, nil
+	}
+	return &shelves[0], nil
+}
+
+// GetShelfByName retrieves a shelf of a user by its name, without its novels.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - name (string): The name of the shelf.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the shelf, or nil if the user has no shelf with this name.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES: Returned if the shelf could not be retrieved.
+func (r *ShelfRepository) GetShelfByName(userID uint, name string) (*models.Shelf, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var shelves []models.Shelf
+	if err := r.db.Where("user_id = ? AND name = ?", userID, name).Limit(1).Find(&shelves).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_SHELVES, "Failed to fetch the shelf", http.StatusInternalServerError, err)
+	}
+	if len(shelves) == 0 {
+		return nil, nil
+	}
+	return &shelves[0], nil
+}
+
+// CreateShelf stores a new shelf.
+//
+// Parameters:
+//   - shelf (models.Shelf): The shelf to store.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the stored shelf.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_SHELF: Returned if the shelf could not be stored.
+func (r *ShelfRepository) CreateShelf(shelf models.Shelf) (*models.Shelf, error) {
+	if r.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	if err := r.db.Omit("Novels").Create(&shelf).Error; err != nil {
+		return nil, types.WrapError(errors.SAVING_SHELF, "Failed to save the shelf", http.StatusInternalServerError, err)
+	}
+	return &shelf, nil
+}
+
+// RenameShelf changes the name of a shelf.
+//
+// Parameters:
+//   - shelfID (uint): The ID of the shelf.
+//   - name (string): The new name of the shelf.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_SHELF: Returned if the shelf could not be renamed.
+func (r *ShelfRepository) RenameShelf(shelfID uint, name string) error {
+	if r.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	if err := r.db.Model(&models.Shelf{}).Where("id = ?", shelfID).Update("name", name).Error; err != nil {
+		return types.WrapError(errors.SAVING_SHELF, "Failed to rename the shelf", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// DeleteShelf deletes a shelf. The novels on it are only taken off the shelf.
+//
+// Parameters:
+//   - shelf (*models.Shelf): The shelf to delete.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - DELETING_SHELF: Returned if the shelf could not be deleted.
+func (r *ShelfRepository) DeleteShelf(shelf *models.Shelf) error {
+	if r.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(shelf).Association("Novels").Clear(); err != nil {
+			return err
+		}
+		return tx.Delete(shelf).Error
+	})
+	if err != nil {
+		return types.WrapError(errors.DELETING_SHELF, "Failed to delete the shelf", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// AddNovelToShelf puts a novel on a shelf. A novel already on the shelf stays there once.
+//
+// Parameters:
+//   - shelf (*models.Shelf): The shelf.
+//   - novel (*models.Novel): The novel to put on the shelf.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_SHELF: Returned if the novel could not be put on the shelf.
+func (r *ShelfRepository) AddNovelToShelf(shelf *models.Shelf, novel *models.Novel) error {
+	if r.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	if err := r.db.Model(shelf).Omit("Novels.*").Association("Novels").Append(novel); err != nil {
+		return types.WrapError(errors.SAVING_SHELF, "Failed to put the novel on the shelf", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// RemoveNovelFromShelf takes a novel off a shelf.
+//
+// Parameters:
+//   - shelf (*models.Shelf): The shelf.
+//   - novel (*models.Novel): The novel to take off the shelf.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SAVING_SHELF: Returned if the novel could not be taken off the shelf.
+func (r *ShelfRepository) RemoveNovelFromShelf(shelf *models.Shelf, novel *models.Novel) error {
+	if r.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	if err := r.db.Model(shelf).Association("Novels").Delete(novel); err != nil {
+		return types.WrapError(errors.SAVING_SHELF, "Failed to take the novel off the shelf", http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// orderNovelsByTitle orders the novels preloaded on the shelves by title.
+//
+// Parameters:
+//   - db (*gorm.DB): The query of the novels.
+//
+// Returns:
+//   - *gorm.DB: The ordered query.
+func orderNovelsByTitle(db *gorm.DB) *gorm.DB {
+	return db.Order("novels.title ASC")
+}
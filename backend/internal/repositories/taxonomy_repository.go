@@ -0,0 +1,394 @@
+package repositories
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// taxonomy describes the tables of a kind of taxonomy entry (genres, tags or authors) and of its association with
+// the novels.
+//
+// Fields:
+//   - table (string): The table of the entries.
+//   - joinTable (string): The table associating the entries with the novels.
+//   - foreignKey (string): The column of the join table referencing the entries.
+//   - description (bool): Whether the entries have a description.
+//   - errorCode (string): The code of the error returned if the entries could not be retrieved.
+type taxonomy struct {
+	table       string
+	joinTable   string
+	foreignKey  string
+	description bool
+	errorCode   string
+}
+
+var (
+	genreTaxonomy  = taxonomy{table: "genres", joinTable: "novel_genres", foreignKey: "genre_id", description: true, errorCode: errors.GETTING_GENRES}
+	tagTaxonomy    = taxonomy{table: "tags", joinTable: "novel_tags", foreignKey: "tag_id", description: true, errorCode: errors.GETTING_TAGS}
+	authorTaxonomy = taxonomy{table: "authors", joinTable: "novel_authors", foreignKey: "author_id", errorCode: errors.GETTING_AUTHORS}
+
+	taxonomies = map[models.TaxonomyKind]taxonomy{
+		models.TaxonomyGenres:  genreTaxonomy,
+		models.TaxonomyTags:    tagTaxonomy,
+		models.TaxonomyAuthors: authorTaxonomy,
+	}
+)
+
+// TaxonomyRepository represents a repository for the genres, tags and authors the novels are classified by.
+// It embeds the BaseRepository to inherit common database operations.
+type TaxonomyRepository struct {
+	*BaseRepository
+}
+
+// NewTaxonomyRepository creates a new TaxonomyRepository.
+//
+// Parameters:
+//   - db (*gorm.DB): The database connection.
+//
+// Returns:
+//   - *TaxonomyRepository: A pointer to the newly created TaxonomyRepository.
+func NewTaxonomyRepository(db *gorm.DB) *TaxonomyRepository {
+	return &TaxonomyRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// GetGenres retrieves a paginated list of the genres, ordered by name, with the number of novels of each.
+//
+// Parameters:
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of genres to return per page.
+//
+// Returns:
+//   - []models.TaxonomyEntry: A slice of genres.
+//   - int64: The total number of genres.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_GENRES: Returned if an error occurs while retrieving the genres.
+func (t *TaxonomyRepository) GetGenres(page, limit int) ([]models.TaxonomyEntry, int64, error) {
+	return t.getEntries(genreTaxonomy, "", page, limit)
+}
+
+// GetTags retrieves a paginated list of the tags, ordered by name, with the number of novels of each.
+//
+// Parameters:
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of tags to return per page.
+//
+// Returns:
+//   - []models.TaxonomyEntry: A slice of tags.
+//   - int64: The total number of tags.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_TAGS: Returned if an error occurs while retrieving the tags.
+func (t *TaxonomyRepository) GetTags(page, limit int) ([]models.TaxonomyEntry, int64, error) {
+	return t.getEntries(tagTaxonomy, "", page, limit)
+}
+
+// GetAuthors retrieves a paginated list of the authors, ordered by name, with the number of novels of each.
+//
+// Parameters:
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of authors to return per page.
+//
+// Returns:
+//   - []models.TaxonomyEntry: A slice of authors.
+//   - int64: The total number of authors.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_AUTHORS: Returned if an error occurs while retrieving the authors.
+func (t *TaxonomyRepository) GetAuthors(page, limit int) ([]models.TaxonomyEntry, int64, error) {
+	return t.getEntries(authorTaxonomy, "", page, limit)
+}
+
+// GetEntries retrieves a paginated list of the entries of a taxonomy whose name contains a search, ordered by name,
+// with the number of novels of each.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entries.
+//   - search (string): The text the names must contain, ignoring case. Empty to retrieve all the entries.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of entries to return per page.
+//
+// Returns:
+//   - []models.TaxonomyEntry: A slice of entries.
+//   - int64: The total number of matching entries.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_GENRES, GETTING_TAGS, GETTING_AUTHORS: Returned if an error occurs while retrieving the entries.
+func (t *TaxonomyRepository) GetEntries(kind models.TaxonomyKind, search string, page, limit int) ([]models.TaxonomyEntry, int64, error) {
+	tax, ok := taxonomies[kind]
+	if !ok {
+		return nil, 0, errors.ErrInvalidTaxonomyKind
+	}
+	return t.getEntries(tax, search, page, limit)
+}
+
+// GetEntry retrieves an entry of a taxonomy with the number of novels it is associated with.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entry.
+//   - id (uint): The ID of the entry.
+//
+// Returns:
+//   - *models.TaxonomyEntry: The entry.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if the entry doesn't exist.
+//   - GETTING_TAXONOMY_ENTRY: Returned if an error occurs while retrieving the entry.
+func (t *TaxonomyRepository) GetEntry(kind models.TaxonomyKind, id uint) (*models.TaxonomyEntry, error) {
+	tax, ok := taxonomies[kind]
+	if !ok {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	if t.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	var entries []models.TaxonomyEntry
+	if err := t.entriesQuery(t.db, tax).Where(tax.table+".id = ?", id).Scan(&entries).Error; err != nil {
+		return nil, types.WrapError(errors.GETTING_TAXONOMY_ENTRY, fmt.Sprintf("Failed to fetch the entry of the %s", tax.table), http.StatusInternalServerError, err)
+	}
+
+	if len(entries) == 0 {
+		return nil, errors.ErrTaxonomyEntryNotFound
+	}
+
+	return &entries[0], nil
+}
+
+// UpdateEntryDescription changes the description of a genre or tag.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entry, genres or tags.
+//   - id (uint): The ID of the entry.
+//   - description (string): The new description.
+//
+// Returns:
+//   - *models.TaxonomyEntry: The updated entry.
+//   - error: An error object indicating any issues encountered during the update.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrInvalidTaxonomyDescription: Returned if the entries of the taxonomy have no description.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if the entry doesn't exist.
+//   - UPDATING_TAXONOMY_ENTRY: Returned if an error occurs while updating the entry.
+func (t *TaxonomyRepository) UpdateEntryDescription(kind models.TaxonomyKind, id uint, description string) (*models.TaxonomyEntry, error) {
+	tax, ok := taxonomies[kind]
+	if !ok {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	if !tax.description {
+		return nil, errors.ErrInvalidTaxonomyDescription
+	}
+
+	if t.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	result := t.db.Table(tax.table).Where("id = ?", id).Update("description", description)
+	if result.Error != nil {
+		return nil, types.WrapError(errors.UPDATING_TAXONOMY_ENTRY, fmt.Sprintf("Failed to update the entry of the %s", tax.table), http.StatusInternalServerError, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, errors.ErrTaxonomyEntryNotFound
+	}
+
+	return t.GetEntry(kind, id)
+}
+
+// MergeEntries merges an entry of a taxonomy into another, such as a duplicate spelled differently. The novels of the
+// merged entry are associated with the entry it is merged into instead, and the merged entry is deleted.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entries.
+//   - sourceID (uint): The ID of the entry merged and deleted.
+//   - targetID (uint): The ID of the entry kept.
+//
+// Returns:
+//   - *models.TaxonomyEntry: The entry kept, with the novels of both entries.
+//   - error: An error object indicating any issues encountered during the merge.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if either entry doesn't exist.
+//   - MERGING_TAXONOMY_ENTRIES: Returned if an error occurs while merging the entries.
+func (t *TaxonomyRepository) MergeEntries(kind models.TaxonomyKind, sourceID, targetID uint) (*models.TaxonomyEntry, error) {
+	tax, ok := taxonomies[kind]
+	if !ok {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	if t.IsDown() {
+		return nil, errors.ErrDatabaseOffline
+	}
+
+	err := t.db.Transaction(func(tx *gorm.DB) error {
+		var found int64
+		if err := tx.Table(tax.table).Where("id IN ?", []uint{sourceID, targetID}).Count(&found).Error; err != nil {
+			return types.WrapError(errors.MERGING_TAXONOMY_ENTRIES, fmt.Sprintf("Failed to fetch the entries of the %s", tax.table), http.StatusInternalServerError, err)
+		}
+		if found != 2 {
+			return errors.ErrTaxonomyEntryNotFound
+		}
+
+		// The novels associated with both entries keep a single association
+		if err := tx.Exec(fmt.Sprintf(`DELETE FROM %[1]s WHERE %[2]s = ? AND novel_id IN (
+			SELECT novel_id FROM %[1]s WHERE %[2]s = ? AND novel_id IS NOT NULL)`, tax.joinTable, tax.foreignKey), sourceID, targetID).Error; err != nil {
+			return types.WrapError(errors.MERGING_TAXONOMY_ENTRIES, fmt.Sprintf("Failed to remove the duplicate associations of the %s", tax.table), http.StatusInternalServerError, err)
+		}
+
+		if err := tx.Table(tax.joinTable).Where(tax.foreignKey+" = ?", sourceID).Update(tax.foreignKey, targetID).Error; err != nil {
+			return types.WrapError(errors.MERGING_TAXONOMY_ENTRIES, fmt.Sprintf("Failed to move the novels of the merged entry of the %s", tax.table), http.StatusInternalServerError, err)
+		}
+
+		if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", tax.table), sourceID).Error; err != nil {
+			return types.WrapError(errors.MERGING_TAXONOMY_ENTRIES, fmt.Sprintf("Failed to delete the merged entry of the %s", tax.table), http.StatusInternalServerError, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.GetEntry(kind, targetID)
+}
+
+// DeleteEntry deletes an entry of a taxonomy no novel is associated with.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entry.
+//   - id (uint): The ID of the entry.
+//
+// Returns:
+//   - error: An error object indicating any issues encountered during the deletion.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if the entry doesn't exist.
+//   - errors.ErrTaxonomyEntryInUse: Returned if novels are still associated with the entry.
+//   - DELETING_TAXONOMY_ENTRY: Returned if an error occurs while deleting the entry.
+func (t *TaxonomyRepository) DeleteEntry(kind models.TaxonomyKind, id uint) error {
+	tax, ok := taxonomies[kind]
+	if !ok {
+		return errors.ErrInvalidTaxonomyKind
+	}
+
+	if t.IsDown() {
+		return errors.ErrDatabaseOffline
+	}
+
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		var novels int64
+		if err := tx.Table(tax.joinTable).Where(tax.foreignKey+" = ? AND novel_id IS NOT NULL", id).Count(&novels).Error; err != nil {
+			return types.WrapError(errors.DELETING_TAXONOMY_ENTRY, fmt.Sprintf("Failed to count the novels of the entry of the %s", tax.table), http.StatusInternalServerError, err)
+		}
+		if novels > 0 {
+			return errors.ErrTaxonomyEntryInUse
+		}
+
+		// Remove the associations left by deleted novels
+		if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", tax.joinTable, tax.foreignKey), id).Error; err != nil {
+			return types.WrapError(errors.DELETING_TAXONOMY_ENTRY, fmt.Sprintf("Failed to delete the associations of the entry of the %s", tax.table), http.StatusInternalServerError, err)
+		}
+
+		result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", tax.table), id)
+		if result.Error != nil {
+			return types.WrapError(errors.DELETING_TAXONOMY_ENTRY, fmt.Sprintf("Failed to delete the entry of the %s", tax.table), http.StatusInternalServerError, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.ErrTaxonomyEntryNotFound
+		}
+
+		return nil
+	})
+}
+
+// getEntries retrieves a paginated list of the entries of a taxonomy whose name contains a search, ordered by name,
+// with the number of novels of each, counted in the same query.
+//
+// Parameters:
+//   - kind (taxonomy): The taxonomy of the entries.
+//   - search (string): The text the names must contain, ignoring case. Empty to retrieve all the entries.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of entries to return per page.
+//
+// Returns:
+//   - []models.TaxonomyEntry: A slice of entries.
+//   - int64: The total number of entries.
+//   - error: An error object indicating any issues encountered during the retrieval process.
+func (t *TaxonomyRepository) getEntries(kind taxonomy, search string, page, limit int) ([]models.TaxonomyEntry, int64, error) {
+	if t.IsDown() {
+		return nil, 0, errors.ErrDatabaseOffline
+	}
+
+	matchSearch := func(db *gorm.DB) *gorm.DB {
+		if search == "" {
+			return db
+		}
+		return db.Where("LOWER("+kind.table+".name) LIKE ?", "%"+strings.ToLower(search)+"%")
+	}
+
+	var total int64
+	if err := t.db.Table(kind.table).Scopes(matchSearch).Count(&total).Error; err != nil {
+		return nil, 0, types.WrapError(kind.errorCode, fmt.Sprintf("Failed to count the %s", kind.table), http.StatusInternalServerError, err)
+	}
+
+	entries := make([]models.TaxonomyEntry, 0, limit)
+	offset := (page - 1) * limit
+	if err := t.entriesQuery(t.db, kind).
+		Scopes(matchSearch).
+		Order(kind.table + ".name ASC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&entries).Error; err != nil {
+		return nil, 0, types.WrapError(kind.errorCode, fmt.Sprintf("Failed to fetch the %s", kind.table), http.StatusInternalServerError, err)
+	}
+
+	return entries, total, nil
+}
+
+// entriesQuery builds the query of the entries of a taxonomy with the number of novels of each.
+//
+// Parameters:
+//   - db (*gorm.DB): The query to add the selection of the entries to.
+//   - kind (taxonomy): The taxonomy of the entries.
+//
+// Returns:
+//   - *gorm.DB: The query of the entries.
+func (t *TaxonomyRepository) entriesQuery(db *gorm.DB, kind taxonomy) *gorm.DB {
+	description := "''"
+	if kind.description {
+		description = kind.table + ".description"
+	}
+
+	return db.Table(kind.table).
+		Select(fmt.Sprintf("%[1]s.id, %[1]s.name, %[2]s AS description, COUNT(%[3]s.novel_id) AS novel_count", kind.table, description, kind.joinTable)).
+		Joins(fmt.Sprintf("LEFT JOIN %[1]s ON %[1]s.%[2]s = %[3]s.id", kind.joinTable, kind.foreignKey, kind.table)).
+		Group(fmt.Sprintf("%[1]s.id, %[1]s.name", kind.table))
+}
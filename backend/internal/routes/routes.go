@@ -17,8 +17,21 @@ import (
 //   - authController (*controllers.AuthController): The authentication controller.
 //   - userController (*controllers.UserController): The user controller.
 //   - novelController (*controllers.NovelController): The novel controller.
+//   - epubImportController (*controllers.EPUBImportController): The EPUB import controller.
+//   - epubExportController (*controllers.EPUBExportController): The EPUB export controller.
+//   - opdsController (*controllers.OPDSController): The OPDS catalog controller.
+//   - feedController (*controllers.FeedController): The Atom feed controller.
+//   - searchController (*controllers.SearchController): The full-text search controller.
+//   - taxonomyController (*controllers.TaxonomyController): The genre, tag and author controller.
 //   - bookmarkController (*controllers.BookmarkController): The bookmark controller.
+//   - readingPositionController (*controllers.ReadingPositionController): The reading position controller.
+//   - readingHistoryController (*controllers.ReadingHistoryController): The reading history controller.
+//   - shelfController (*controllers.ShelfController): The shelf controller.
+//   - libraryController (*controllers.LibraryController): The library import and export controller.
 //   - chapterController (*controllers.ChapterController): The chapter controller.
+//   - chapterRevisionController (*controllers.ChapterRevisionController): The chapter revision controller.
+//   - importJobController (*controllers.ImportJobController): The chapter import job controller.
+//   - refreshSchedulerController (*controllers.RefreshSchedulerController): The novel refresh scheduler controller.
 //   - ttsController (*controllers.TTSController): The TTS controller.
 //   - logController (*controllers.LogController): The log controller.
 //   - middleware (*middleware.Middleware): The middleware to use for authentication and authorization.
@@ -26,8 +39,21 @@ func SetupRoutes(r *gin.Engine,
 	authController *controllers.AuthController,
 	userController *controllers.UserController,
 	novelController *controllers.NovelController,
+	epubImportController *controllers.EPUBImportController,
+	epubExportController *controllers.EPUBExportController,
+	opdsController *controllers.OPDSController,
+	feedController *controllers.FeedController,
+	searchController *controllers.SearchController,
+	taxonomyController *controllers.TaxonomyController,
 	bookmarkController *controllers.BookmarkController,
+	readingPositionController *controllers.ReadingPositionController,
+	readingHistoryController *controllers.ReadingHistoryController,
+	shelfController *controllers.ShelfController,
+	libraryController *controllers.LibraryController,
 	chapterController *controllers.ChapterController,
+	chapterRevisionController *controllers.ChapterRevisionController,
+	importJobController *controllers.ImportJobController,
+	refreshSchedulerController *controllers.RefreshSchedulerController,
 	ttsController *controllers.TTSController,
 	logController *controllers.LogController,
 	middleware *middleware.Middleware) {
@@ -35,7 +61,7 @@ func SetupRoutes(r *gin.Engine,
 	r.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", os.Getenv("FRONTEND_URL"))
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		if c.Request.Method == "OPTIONS" {
 			c.Writer.WriteHeader(http.StatusOK)
@@ -47,6 +73,9 @@ func SetupRoutes(r *gin.Engine,
 	// Serve TTS files
 	r.Static("/tts-files", "./tts-files")
 
+	// Serve the covers of the novels imported from EPUB files
+	r.Static("/covers", "./covers")
+
 	r.StaticFile("/", "./static/index.html")
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -78,30 +107,80 @@ func SetupRoutes(r *gin.Engine,
 		user.PUT("/:id/email", middleware.AuthMiddleware(), userController.UpdateEmail)
 		user.PUT("/:id/fields", middleware.AuthMiddleware(), userController.UpdateUserFields)
 		user.DELETE("/:id", middleware.AuthMiddleware(), userController.HandleDeleteUser)
+		user.GET("/feed-token", middleware.AuthMiddleware(), feedController.GetFeedToken)
+		user.POST("/feed-token", middleware.AuthMiddleware(), feedController.RegenerateFeedToken)
+		user.GET("/reading-positions", middleware.AuthMiddleware(), readingPositionController.GetReadingPositions)
+		user.GET("/continue-reading", middleware.AuthMiddleware(), bookmarkController.GetContinueReading)
+		user.GET("/reading-stats", middleware.AuthMiddleware(), readingHistoryController.GetReadingStats)
+		user.GET("/shelves", middleware.AuthMiddleware(), shelfController.GetShelves)
+		user.POST("/shelves", middleware.AuthMiddleware(), shelfController.CreateShelf)
+		user.GET("/shelves/:shelf_id", middleware.AuthMiddleware(), shelfController.GetShelf)
+		user.PUT("/shelves/:shelf_id", middleware.AuthMiddleware(), shelfController.RenameShelf)
+		user.DELETE("/shelves/:shelf_id", middleware.AuthMiddleware(), shelfController.DeleteShelf)
+		user.PUT("/shelves/:shelf_id/novels/:novel_id", middleware.AuthMiddleware(), shelfController.AddNovelToShelf)
+		user.DELETE("/shelves/:shelf_id/novels/:novel_id", middleware.AuthMiddleware(), shelfController.RemoveNovelFromShelf)
+		user.GET("/library/export", middleware.AuthMiddleware(), libraryController.ExportLibrary)
+		user.POST("/library/import", middleware.AuthMiddleware(), libraryController.ImportLibrary)
 	}
 
 	novel := r.Group("/novels")
 	{
 		novel.POST("/:novel_updates_id", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "create"), novelController.HandleImportNovelByNovelUpdatesID)
+		novel.POST("/epub", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "create"), epubImportController.ImportNovelFromEPUB)
 		novel.GET("/", novelController.GetNovels)
+		novel.GET("/search", searchController.SearchNovels)
 		novel.GET("/authors/:author_name", novelController.GetNovelsByAuthorName)
 		novel.GET("/genres/:genre_name", novelController.GetNovelsByGenreName)
 		novel.GET("/tags/:tag_name", novelController.GetNovelsByTagName)
 		novel.GET("/:novel_id", novelController.GetNovelByID)
+		novel.PUT("/:novel_id", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "update"), novelController.UpdateNovel)
+		novel.PATCH("/:novel_id", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "update"), novelController.PatchNovel)
+		novel.DELETE("/:novel_id", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "delete"), novelController.DeleteNovel)
+		novel.GET("/:novel_id/sources", novelController.GetNovelSourcePriority)
+		novel.GET("/:novel_id/export.epub", epubExportController.ExportNovelToEPUB)
+		novel.GET("/:novel_id/feed.atom", feedController.GetNovelFeed)
+		novel.GET("/:novel_id/reading-position", middleware.AuthMiddleware(), readingPositionController.GetReadingPosition)
+		novel.PUT("/:novel_id/reading-position", middleware.AuthMiddleware(), readingPositionController.SyncReadingPosition)
+		novel.GET("/:novel_id/search", searchController.SearchChapters)
+		novel.PUT("/:novel_id/sources", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "update"), novelController.UpdateNovelSourcePriority)
 		novel.GET("/title/:title", novelController.GetNovelByUpdatesID)
 		novel.GET("/update", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "update"), novelController.HandleBatchUpdateNovels)
+		novel.GET("/refresh/status", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "update"), refreshSchedulerController.GetRefreshStatus)
+		novel.POST("/refresh/:schedule", middleware.AuthMiddleware(), middleware.PermissionMiddleware("novels", "update"), refreshSchedulerController.RunRefreshSchedule)
 
 		chapters := novel.Group("/chapters")
 		{
-			chapters.GET("/:novel_id/scrape", chapterController.HandleImportChapters)
-			chapters.GET("/novel/:novel_title/chapter/:chapter_no", chapterController.GetChapterByNovelUpdatesIDAndChapterNo)
+			chapters.GET("/:novel_id/scrape", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.HandleImportChapters)
+			chapters.GET("/:novel_id/missing", importJobController.GetMissingChapters)
+			chapters.POST("/:novel_id/import-jobs", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.StartImportJob)
+			chapters.GET("/novel/:novel_title/chapter/:chapter_no", middleware.OptionalAuthMiddleware(), chapterController.GetChapterByNovelUpdatesIDAndChapterNo)
 			chapters.GET("/novel/:novel_title/chapters", chapterController.GetChaptersByNovelUpdatesID)
+			chapters.GET("/novel/:novel_title/toc", chapterController.GetTableOfContents)
+			chapters.PUT("/novel/:novel_title/chapter/:chapter_no", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "update"), chapterRevisionController.EditChapter)
+			chapters.DELETE("/novel/:novel_title/chapter/:chapter_no", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "delete"), chapterController.DeleteChapter)
+			chapters.POST("/novel/:novel_title/chapters", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), chapterController.InsertChapter)
+			chapters.DELETE("/novel/:novel_title/chapters", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "delete"), chapterController.DeleteChapters)
+			chapters.POST("/novel/:novel_title/chapters/renumber", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "update"), chapterController.RenumberChapters)
+			chapters.POST("/novel/:novel_title/chapter/:chapter_no/rescrape", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "update"), chapterRevisionController.RescrapeChapter)
+			chapters.GET("/novel/:novel_title/chapter/:chapter_no/revisions", chapterRevisionController.GetChapterRevisions)
+			chapters.GET("/novel/:novel_title/chapter/:chapter_no/revisions/diff", chapterRevisionController.DiffChapterRevisions)
+			chapters.PUT("/novel/:novel_title/chapter/:chapter_no/revisions/:revision/current", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "update"), chapterRevisionController.SetCurrentChapterRevision)
+		}
+
+		importJobs := novel.Group("/import-jobs")
+		{
+			importJobs.GET("/", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.GetImportJobs)
+			importJobs.GET("/:job_id", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.GetImportJob)
+			importJobs.GET("/:job_id/events", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.StreamImportJob)
+			importJobs.POST("/:job_id/cancel", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.CancelImportJob)
+			importJobs.POST("/:job_id/resume", middleware.AuthMiddleware(), middleware.PermissionMiddleware("chapters", "create"), importJobController.ResumeImportJob)
 		}
 
 		bookmarked := novel.Group("/bookmarked")
 		{
 			bookmarked.POST("/", middleware.AuthMiddleware(), bookmarkController.CreateBookmark)
 			bookmarked.PUT("/", middleware.AuthMiddleware(), bookmarkController.UpdateBookmark)
+			bookmarked.GET("/feed.atom", feedController.GetLibraryFeed)
 			bookmarked.GET("/:user_id", middleware.AuthMiddleware(), bookmarkController.GetBookmarkedNovelsByUserID)
 			bookmarked.GET("/user/:user_id/novel/:novel_id", middleware.AuthMiddleware(), bookmarkController.GetBookmarkByUserIDAndNovelID)
 			bookmarked.DELETE("/user/:user_id/novel/:novel_id", middleware.AuthMiddleware(), bookmarkController.UnbookmarkNovel)
@@ -114,6 +193,30 @@ func SetupRoutes(r *gin.Engine,
 		}
 	}
 
+	taxonomy := r.Group("/taxonomy/:kind")
+	{
+		taxonomy.GET("", taxonomyController.GetEntries)
+		taxonomy.GET("/:id", taxonomyController.GetEntry)
+		taxonomy.PUT("/:id/description", middleware.AuthMiddleware(), middleware.PermissionMiddleware("taxonomy", "update"), taxonomyController.UpdateEntryDescription)
+		taxonomy.POST("/:id/merge", middleware.AuthMiddleware(), middleware.PermissionMiddleware("taxonomy", "update"), taxonomyController.MergeEntries)
+		taxonomy.DELETE("/:id", middleware.AuthMiddleware(), middleware.PermissionMiddleware("taxonomy", "delete"), taxonomyController.DeleteEntry)
+	}
+
+	// OPDS catalog for e-reader apps
+	opds := r.Group("/opds")
+	{
+		opds.GET("", opdsController.GetRootFeed)
+		opds.GET("/opensearch.xml", opdsController.GetOpenSearchDescription)
+		opds.GET("/search", opdsController.SearchFeed)
+		opds.GET("/novels", opdsController.GetNovelsFeed)
+		opds.GET("/genres", opdsController.GetGenresFeed)
+		opds.GET("/genres/:genre_name", opdsController.GetGenreFeed)
+		opds.GET("/tags", opdsController.GetTagsFeed)
+		opds.GET("/tags/:tag_name", opdsController.GetTagFeed)
+		opds.GET("/authors", opdsController.GetAuthorsFeed)
+		opds.GET("/authors/:author_name", opdsController.GetAuthorFeed)
+	}
+
 	// Health check route
 	r.GET("/health", func(c *gin.Context) {
 		c.String(http.StatusOK, "OK")
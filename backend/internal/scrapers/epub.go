@@ -0,0 +1,467 @@
+package scrapers
+
+import (
+	"archive/zip"
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// maxEPUBEntrySize is the largest size a file inside an EPUB may have once decompressed.
+const maxEPUBEntrySize = 32 << 20
+
+// epubYearRegex matches the year of dates such as "2012-05-01".
+var epubYearRegex = regexp.MustCompile(`\d{4}`)
+
+// epubCoverExtensions maps the image types accepted as covers to the extension their file is saved with. Other types,
+// like SVG, which can carry scripts, are left out.
+var epubCoverExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// epubBlockTags are the elements whose text is a paragraph of a chapter when they contain no other block.
+var epubBlockTags = map[string]bool{
+	"p": true, "div": true, "li": true, "blockquote": true, "pre": true, "td": true, "dd": true, "dt": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// epubContainer is the META-INF/container.xml file, which points to the package document.
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// epubPackage is the package document (OPF) describing the metadata, the files and the reading order of an EPUB.
+type epubPackage struct {
+	Metadata struct {
+		Titles       []string      `xml:"title"`
+		Creators     []epubCreator `xml:"creator"`
+		Languages    []string      `xml:"language"`
+		Descriptions []string      `xml:"description"`
+		Subjects     []string      `xml:"subject"`
+		Dates        []string      `xml:"date"`
+		Metas        []struct {
+			Name    string `xml:"name,attr"`
+			Content string `xml:"content,attr"`
+		} `xml:"meta"`
+	} `xml:"metadata"`
+	Items []epubItem `xml:"manifest>item"`
+	Spine struct {
+		Toc      string `xml:"toc,attr"`
+		ItemRefs []struct {
+			IDRef  string `xml:"idref,attr"`
+			Linear string `xml:"linear,attr"`
+		} `xml:"itemref"`
+	} `xml:"spine"`
+}
+
+// epubCreator is a creator of an EPUB, an author unless its role says otherwise.
+type epubCreator struct {
+	Role string `xml:"role,attr"`
+	Name string `xml:",chardata"`
+}
+
+// epubItem is a file of the manifest of an EPUB.
+type epubItem struct {
+	ID         string `xml:"id,attr"`
+	Href       string `xml:"href,attr"`
+	MediaType  string `xml:"media-type,attr"`
+	Properties string `xml:"properties,attr"`
+}
+
+// epubNavPoint is an entry of the table of contents of an EPUB 2 (NCX).
+type epubNavPoint struct {
+	Label   string `xml:"navLabel>text"`
+	Content struct {
+		Src string `xml:"src,attr"`
+	} `xml:"content"`
+	Children []epubNavPoint `xml:"navPoint"`
+}
+
+// epubArchive gives access to the files of an EPUB by their path.
+type epubArchive map[string]*zip.File
+
+// ParseEPUB reads the metadata, the cover and the chapters of an EPUB (version 2 or 3). Each document of the reading
+// order becomes a chapter with its text split in paragraphs and stripped of any markup, titled after the table of
+// contents. Documents that can't be read keep the reason in their Err field instead of failing the whole book.
+//
+// Parameters:
+//   - data []byte (The content of the EPUB file)
+//
+// Returns:
+//   - *models.ImportedBook (The book)
+//   - error (errors.ErrInvalidEPUB if the file is not a zip archive or its package document is missing or invalid)
+func ParseEPUB(data []byte) (*models.ImportedBook, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, types.WrapError(errors.INVALID_EPUB, "File is not a zip archive", http.StatusBadRequest, err)
+	}
+
+	archive := epubArchive{}
+	for _, file := range reader.File {
+		archive[file.Name] = file
+	}
+
+	var container epubContainer
+	if err := archive.decode("META-INF/container.xml", &container); err != nil || len(container.Rootfiles) == 0 {
+		return nil, types.WrapError(errors.INVALID_EPUB, "EPUB has no container", http.StatusBadRequest, err)
+	}
+
+	packagePath := container.Rootfiles[0].FullPath
+	var pkg epubPackage
+	if err := archive.decode(packagePath, &pkg); err != nil {
+		return nil, types.WrapError(errors.INVALID_EPUB, "EPUB has no valid package document", http.StatusBadRequest, err)
+	}
+
+	items := make(map[string]epubItem, len(pkg.Items))
+	for _, item := range pkg.Items {
+		item.Href = resolveEPUBHref(packagePath, item.Href)
+		items[item.ID] = item
+	}
+
+	book := &models.ImportedBook{
+		Title:    strings.TrimSpace(firstOf(pkg.Metadata.Titles)),
+		Synopsis: strings.TrimSpace(utils.StripHTML(firstOf(pkg.Metadata.Descriptions))),
+		Language: strings.TrimSpace(firstOf(pkg.Metadata.Languages)),
+		Year:     epubYearRegex.FindString(firstOf(pkg.Metadata.Dates)),
+	}
+
+	for _, creator := range pkg.Metadata.Creators {
+		name := strings.TrimSpace(creator.Name)
+		if name != "" && (creator.Role == "" || creator.Role == "aut") {
+			book.Authors = append(book.Authors, models.Author{Name: name})
+		}
+	}
+	for _, subject := range pkg.Metadata.Subjects {
+		if subject = strings.TrimSpace(subject); subject != "" {
+			book.Tags = append(book.Tags, models.Tag{Name: subject})
+		}
+	}
+
+	book.Cover, book.CoverExtension = archive.cover(pkg, items)
+
+	titles := archive.tableOfContents(pkg, items)
+	for _, itemRef := range pkg.Spine.ItemRefs {
+		item, ok := items[itemRef.IDRef]
+		if !ok || itemRef.Linear == "no" {
+			continue
+		}
+		book.Chapters = append(book.Chapters, archive.chapter(item, titles[item.Href]))
+	}
+
+	return book, nil
+}
+
+// read returns the content of a file of the archive.
+//
+// Parameters:
+//   - name string (The path of the file)
+//
+// Returns:
+//   - []byte (The content of the file)
+//   - error (An error if the file doesn't exist, is too large or can't be decompressed)
+func (a epubArchive) read(name string) ([]byte, error) {
+	file, ok := a[name]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found", name)
+	}
+
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxEPUBEntrySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxEPUBEntrySize {
+		return nil, fmt.Errorf("file %s is too large", name)
+	}
+	return content, nil
+}
+
+// decode parses an XML file of the archive into v.
+func (a epubArchive) decode(name string, v any) error {
+	content, err := a.read(name)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(content, v)
+}
+
+// cover returns the cover image of the book, declared either by a cover-image manifest property (EPUB 3) or by a
+// cover meta tag (EPUB 2). Only the image types in epubCoverExtensions are kept, the covers are served as they are
+// saved.
+//
+// Returns:
+//   - []byte (The image, nil if the book has no cover)
+//   - string (The file extension of the image)
+func (a epubArchive) cover(pkg epubPackage, items map[string]epubItem) ([]byte, string) {
+	var coverItem *epubItem
+	for _, item := range pkg.Items {
+		if hasProperty(item.Properties, "cover-image") {
+			item := items[item.ID]
+			coverItem = &item
+			break
+		}
+	}
+	if coverItem == nil {
+		for _, meta := range pkg.Metadata.Metas {
+			if item, ok := items[meta.Content]; ok && meta.Name == "cover" {
+				coverItem = &item
+				break
+			}
+		}
+	}
+	if coverItem == nil {
+		return nil, ""
+	}
+	extension, ok := epubCoverExtensions[coverItem.MediaType]
+	if !ok {
+		return nil, ""
+	}
+
+	content, err := a.read(coverItem.Href)
+	if err != nil {
+		return nil, ""
+	}
+
+	return content, extension
+}
+
+// tableOfContents returns the titles of the documents of the book, from the navigation document (EPUB 3) or the NCX
+// (EPUB 2). Only the first entry pointing to each document is kept.
+//
+// Returns:
+//   - map[string]string (The titles by path of the document)
+func (a epubArchive) tableOfContents(pkg epubPackage, items map[string]epubItem) map[string]string {
+	titles := map[string]string{}
+	addTitle := func(base, href, title string) {
+		href = resolveEPUBHref(base, href)
+		if _, exists := titles[href]; !exists && title != "" {
+			titles[href] = title
+		}
+	}
+
+	for _, item := range pkg.Items {
+		if !hasProperty(item.Properties, "nav") {
+			continue
+		}
+
+		navPath := items[item.ID].Href
+		content, err := a.read(navPath)
+		if err != nil {
+			break
+		}
+		doc, err := parseHTML(content)
+		if err != nil {
+			break
+		}
+		for _, link := range findAll(findFirst(doc, byTag("nav")), byTag("a")) {
+			addTitle(navPath, attr(link, "href"), epubText(link))
+		}
+		return titles
+	}
+
+	if ncx, ok := items[pkg.Spine.Toc]; ok {
+		var toc struct {
+			NavPoints []epubNavPoint `xml:"navMap>navPoint"`
+		}
+		if err := a.decode(ncx.Href, &toc); err == nil {
+			var walk func([]epubNavPoint)
+			walk = func(navPoints []epubNavPoint) {
+				for _, navPoint := range navPoints {
+					addTitle(ncx.Href, navPoint.Content.Src, strings.Join(strings.Fields(navPoint.Label), " "))
+					walk(navPoint.Children)
+				}
+			}
+			walk(toc.NavPoints)
+		}
+	}
+
+	return titles
+}
+
+// chapter reads a document of the reading order as a chapter.
+//
+// Parameters:
+//   - item epubItem (The document)
+//   - title string (The title of the document in the table of contents, if any)
+//
+// Returns:
+//   - models.ImportedBookChapter (The chapter, with EPUB_NOT_CHAPTER if the document is the table of contents or is
+//     not text, errors.ErrChapterEmpty if it has no text, like a cover page, or PARSING_EPUB_CHAPTER if it can't be
+//     read)
+func (a epubArchive) chapter(item epubItem, title string) models.ImportedBookChapter {
+	chapter := models.ImportedBookChapter{Href: item.Href, Title: title}
+
+	if hasProperty(item.Properties, "nav") {
+		chapter.Err = types.WrapError(errors.EPUB_NOT_CHAPTER, "Document is the table of contents", http.StatusUnprocessableEntity, nil)
+		return chapter
+	}
+	if item.MediaType != "application/xhtml+xml" && item.MediaType != "text/html" {
+		chapter.Err = types.WrapError(errors.EPUB_NOT_CHAPTER, fmt.Sprintf("Document of type %s is not text", item.MediaType), http.StatusUnprocessableEntity, nil)
+		return chapter
+	}
+
+	content, err := a.read(item.Href)
+	if err != nil {
+		chapter.Err = types.WrapError(errors.PARSING_EPUB_CHAPTER, "Failed to read the document", http.StatusUnprocessableEntity, err)
+		return chapter
+	}
+
+	doc, err := parseHTML(content)
+	if err != nil {
+		chapter.Err = types.WrapError(errors.PARSING_EPUB_CHAPTER, "Failed to parse the document", http.StatusUnprocessableEntity, err)
+		return chapter
+	}
+
+	paragraphs := epubParagraphs(findFirst(doc, byTag("body")))
+	if chapter.Title == "" {
+		for _, heading := range []string{"h1", "h2", "h3"} {
+			if chapter.Title = epubText(findFirst(doc, byTag(heading))); chapter.Title != "" {
+				break
+			}
+		}
+	}
+
+	// The heading of the document usually repeats the title
+	if len(paragraphs) > 0 && strings.EqualFold(paragraphs[0], chapter.Title) {
+		paragraphs = paragraphs[1:]
+	}
+	if len(paragraphs) == 0 {
+		chapter.Err = errors.ErrChapterEmpty
+		return chapter
+	}
+
+	chapter.Body = strings.Join(paragraphs, "\n\n")
+	return chapter
+}
+
+// epubParagraphs returns the text of the innermost blocks of an element, dropping scripts, styles and markup. Text
+// outside any block is kept as a paragraph of its own.
+func epubParagraphs(n *html.Node) []string {
+	if n == nil {
+		return nil
+	}
+
+	var paragraphs []string
+	var inline []*html.Node
+
+	flush := func() {
+		var sb strings.Builder
+		for _, node := range inline {
+			writeEPUBText(&sb, node)
+		}
+		if paragraph := strings.Join(strings.Fields(sb.String()), " "); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+		inline = nil
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.ElementNode && (c.Data == "script" || c.Data == "style"):
+		case c.Type == html.ElementNode && c.Data == "br":
+			flush()
+		case c.Type == html.ElementNode && (epubBlockTags[c.Data] || findFirst(c, isEPUBBlock) != nil):
+			flush()
+			if findFirst(c, isEPUBBlock) == nil {
+				inline = append(inline, c)
+				flush()
+			} else {
+				paragraphs = append(paragraphs, epubParagraphs(c)...)
+			}
+		default:
+			inline = append(inline, c)
+		}
+	}
+	flush()
+
+	return paragraphs
+}
+
+// epubText returns the text of a node and its descendants with whitespace collapsed, see writeEPUBText.
+func epubText(n *html.Node) string {
+	if n == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	writeEPUBText(&sb, n)
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// writeEPUBText writes the text of a node and its descendants as it is, unlike text, so inline markup such as
+// "<em>word</em>." doesn't add spaces. Scripts and styles are dropped and line breaks become spaces.
+func writeEPUBText(sb *strings.Builder, n *html.Node) {
+	switch {
+	case n.Type == html.TextNode:
+		sb.WriteString(n.Data)
+	case n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style"):
+	case n.Type == html.ElementNode && n.Data == "br":
+		sb.WriteString(" ")
+	default:
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			writeEPUBText(sb, c)
+		}
+	}
+}
+
+// isEPUBBlock matches the elements whose text is a paragraph.
+func isEPUBBlock(n *html.Node) bool {
+	return n.Type == html.ElementNode && epubBlockTags[n.Data]
+}
+
+// resolveEPUBHref resolves a link found in a file of an EPUB into the path of its target inside the archive, without
+// its fragment.
+//
+// Parameters:
+//   - base string (The path of the file containing the link)
+//   - href string (The link)
+//
+// Returns:
+//   - string (The path of the target)
+func resolveEPUBHref(base, href string) string {
+	href, _, _ = strings.Cut(href, "#")
+	if unescaped, err := url.PathUnescape(href); err == nil {
+		href = unescaped
+	}
+	return strings.TrimPrefix(path.Join(path.Dir(base), href), "./")
+}
+
+// hasProperty reports whether a space separated list of properties contains the given one.
+func hasProperty(properties, property string) bool {
+	for _, p := range strings.Fields(properties) {
+		if p == property {
+			return true
+		}
+	}
+	return false
+}
+
+// firstOf returns the first of the values, or an empty string if there are none.
+func firstOf(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,203 @@
+package scrapers
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// chapterNumberRegex matches the number in strings such as "Chapter 12" or "Chapter 12: The Return".
+var chapterNumberRegex = regexp.MustCompile(`(?i)chapter\s+(\d+)`)
+
+// matcher reports whether an HTML node matches a condition.
+type matcher func(n *html.Node) bool
+
+// parseHTML parses the body of a page into an HTML node tree.
+//
+// Parameters:
+//   - body []byte (The body of the page)
+//
+// Returns:
+//   - *html.Node (The root node of the document)
+//   - error (An error if the body is not valid HTML)
+func parseHTML(body []byte) (*html.Node, error) {
+	return html.Parse(bytes.NewReader(body))
+}
+
+// byTag matches element nodes with the given tag name.
+func byTag(tag string) matcher {
+	return func(n *html.Node) bool {
+		return n.Type == html.ElementNode && n.Data == tag
+	}
+}
+
+// byID matches element nodes with the given id attribute.
+func byID(id string) matcher {
+	return func(n *html.Node) bool {
+		return n.Type == html.ElementNode && attr(n, "id") == id
+	}
+}
+
+// byClass matches element nodes that have all the given classes.
+func byClass(classes ...string) matcher {
+	return func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return false
+		}
+
+		nodeClasses := strings.Fields(attr(n, "class"))
+		for _, class := range classes {
+			found := false
+			for _, nodeClass := range nodeClasses {
+				if nodeClass == class {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// and matches nodes that satisfy every given matcher.
+func and(matchers ...matcher) matcher {
+	return func(n *html.Node) bool {
+		for _, m := range matchers {
+			if !m(n) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// findFirst returns the first descendant of n (depth-first) that matches, or nil if there is none.
+func findFirst(n *html.Node, m matcher) *html.Node {
+	if n == nil {
+		return nil
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if m(c) {
+			return c
+		}
+		if found := findFirst(c, m); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findAll returns every descendant of n (depth-first) that matches.
+func findAll(n *html.Node, m matcher) []*html.Node {
+	var nodes []*html.Node
+	if n == nil {
+		return nodes
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if m(c) {
+			nodes = append(nodes, c)
+		}
+		nodes = append(nodes, findAll(c, m)...)
+	}
+	return nodes
+}
+
+// children returns the direct children of n that match.
+func children(n *html.Node, m matcher) []*html.Node {
+	var nodes []*html.Node
+	if n == nil {
+		return nodes
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if m(c) {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// attr returns the value of the attribute key of n, or an empty string if it is not set.
+func attr(n *html.Node, key string) string {
+	if n == nil {
+		return ""
+	}
+
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// text returns the text content of n and its descendants with whitespace collapsed.
+func text(n *html.Node) string {
+	if n == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.TextNode {
+			sb.WriteString(node.Data)
+			sb.WriteString(" ")
+		}
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// joinParagraphs joins the text of the given nodes as paragraphs separated by blank lines, skipping empty ones.
+func joinParagraphs(nodes []*html.Node) string {
+	paragraphs := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if t := text(n); t != "" {
+			paragraphs = append(paragraphs, t)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// metaContent returns the content of the first <meta> tag whose property or name attribute is one of the given keys.
+func metaContent(doc *html.Node, keys ...string) string {
+	metas := findAll(doc, byTag("meta"))
+	for _, key := range keys {
+		for _, meta := range metas {
+			if attr(meta, "property") == key || attr(meta, "name") == key {
+				return strings.TrimSpace(attr(meta, "content"))
+			}
+		}
+	}
+	return ""
+}
+
+// parseChapterNumber extracts the chapter number from strings such as "Chapter 12: The Return".
+//
+// Returns:
+//   - int (The chapter number, or 0 if the string doesn't contain one)
+func parseChapterNumber(s string) int {
+	match := chapterNumberRegex.FindStringSubmatch(s)
+	if match == nil {
+		return 0
+	}
+
+	number, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	return number
+}
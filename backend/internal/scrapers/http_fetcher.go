@@ -0,0 +1,168 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"bytes"
+	stdErrors "errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// userAgents lists the user agents used when fetching pages, one is picked at random for each request.
+var userAgents = []string{
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:109.0) Gecko/20100101 Firefox/119.0",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:109.0) Gecko/20100101 Firefox/119.0",
+	"Mozilla/5.0 (X11; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/119.0",
+}
+
+// blockedPageMarkers lists the snippets of the challenge and access denied pages served by Cloudflare, the anti-bot
+// protection of the sources, with a successful status. They are specific to those pages, a chapter mentioning a captcha
+// or a page with a captcha in its comment form isn't blocked.
+var blockedPageMarkers = [][]byte{
+	[]byte("<title>Just a moment...</title>"),
+	[]byte("<title>Attention Required! | Cloudflare</title>"),
+	[]byte("cf-challenge-"),
+	[]byte("cf-chl-"),
+	[]byte("_cf_chl_opt"),
+	[]byte("/cdn-cgi/challenge-platform/"),
+}
+
+// HTTPFetcher is a Fetcher that downloads pages over HTTP.
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+// NewHTTPFetcher creates a new HTTPFetcher instance.
+//
+// Parameters:
+//   - timeout time.Duration (The maximum duration of a request)
+//
+// Returns:
+//   - *HTTPFetcher (pointer to the HTTPFetcher instance)
+func NewHTTPFetcher(timeout time.Duration) *HTTPFetcher {
+	return &HTTPFetcher{client: &http.Client{Timeout: timeout}}
+}
+
+// Fetch downloads the page at the given URL.
+//
+// Parameters:
+//   - url string (The URL of the page)
+//
+// Returns:
+//   - []byte (The body of the page)
+//   - int (The HTTP status code of the response)
+//   - error (An error if the request could not be made or the body could not be read)
+func (f *HTTPFetcher) Fetch(url string) ([]byte, int, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("User-Agent", userAgents[rand.Intn(len(userAgents))])
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+
+	return body, resp.StatusCode, nil
+}
+
+// fetchDocument fetches a page and parses it into an HTML document.
+//
+// Parameters:
+//   - fetcher interfaces.Fetcher (The fetcher used to download the page)
+//   - url string (The URL of the page)
+//   - notFound error (The error returned if the source responds with 404)
+//
+// Returns:
+//   - *html.Node (The root node of the document)
+//   - error (notFound if the page doesn't exist, SOURCE_BLOCKED if the source denied the request or answered with a
+//     captcha, SOURCE_TIMEOUT if the request timed out, SOURCE_DOWN if the page could not be downloaded,
+//     PARSING_SOURCE if the page is not valid HTML)
+func fetchDocument(fetcher interfaces.Fetcher, url string, notFound error) (*html.Node, error) {
+	body, status, err := fetcher.Fetch(url)
+	if err != nil {
+		var netErr net.Error
+		if stdErrors.As(err, &netErr) && netErr.Timeout() {
+			return nil, types.WrapError(errors.SOURCE_TIMEOUT, "Timed out fetching "+url, http.StatusGatewayTimeout, err)
+		}
+		return nil, types.WrapError(errors.SOURCE_DOWN, "Failed to fetch "+url, http.StatusServiceUnavailable, err)
+	}
+
+	if status == http.StatusNotFound {
+		return nil, notFound
+	}
+
+	if isBlockedResponse(status, body) {
+		return nil, types.WrapError(errors.SOURCE_BLOCKED, "Source blocked the request for "+url, http.StatusBadGateway, nil)
+	}
+
+	if status == http.StatusGatewayTimeout || status == http.StatusRequestTimeout {
+		return nil, types.WrapError(errors.SOURCE_TIMEOUT, fmt.Sprintf("Source timed out with status %d for %s", status, url), http.StatusGatewayTimeout, nil)
+	}
+
+	if status != http.StatusOK {
+		return nil, types.WrapError(errors.SOURCE_DOWN, fmt.Sprintf("Source responded with status %d for %s", status, url), http.StatusServiceUnavailable, nil)
+	}
+
+	doc, err := parseHTML(body)
+	if err != nil {
+		return nil, types.WrapError(errors.PARSING_SOURCE, "Failed to parse "+url, http.StatusBadGateway, err)
+	}
+
+	return doc, nil
+}
+
+// isBlockedResponse checks if the source denied a request, or answered it with a challenge page.
+//
+// Parameters:
+//   - status int (The HTTP status code of the response)
+//   - body []byte (The body of the response)
+//
+// Returns:
+//   - bool (True if the response is a 403 or a blocked page)
+func isBlockedResponse(status int, body []byte) bool {
+	return status == http.StatusForbidden || (status == http.StatusOK && isBlockedPage(body))
+}
+
+// isBlockedPage checks if a page is an access denied or captcha page instead of the requested page.
+//
+// Parameters:
+//   - body []byte (The body of the page)
+//
+// Returns:
+//   - bool (True if the page contains one of the blockedPageMarkers)
+func isBlockedPage(body []byte) bool {
+	for _, marker := range blockedPageMarkers {
+		if bytes.Contains(body, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseError builds the error returned when a page doesn't have the expected structure.
+//
+// Parameters:
+//   - url string (The URL of the page)
+//   - what string (What could not be found on the page)
+//
+// Returns:
+//   - *types.MyCustomError (PARSING_SOURCE error)
+func parseError(url string, what string) *types.MyCustomError {
+	return types.WrapError(errors.PARSING_SOURCE, fmt.Sprintf("Failed to find the %s in %s", what, url), http.StatusBadGateway, nil)
+}
@@ -0,0 +1,142 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LightNovelWorldSource is a Source that scrapes novels and chapters from LightNovelWorld.
+type LightNovelWorldSource struct {
+	fetcher interfaces.Fetcher
+	baseUrl string
+}
+
+// NewLightNovelWorldSource creates a new LightNovelWorldSource instance.
+//
+// Parameters:
+//   - fetcher interfaces.Fetcher (The fetcher used to download pages)
+//
+// Returns:
+//   - *LightNovelWorldSource (pointer to the LightNovelWorldSource instance)
+func NewLightNovelWorldSource(fetcher interfaces.Fetcher) *LightNovelWorldSource {
+	return &LightNovelWorldSource{fetcher: fetcher, baseUrl: "https://www.lightnovelworld.co"}
+}
+
+// Name returns the name of the source.
+func (s *LightNovelWorldSource) Name() string {
+	return "lightnovelworld"
+}
+
+// FetchNovelMetadata fetches the metadata of a novel from its LightNovelWorld page.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//
+// Returns:
+//   - *models.ImportedNovel (The metadata of the novel)
+//   - error (errors.ErrNovelNotFound if the novel doesn't exist, SOURCE_DOWN or PARSING_SOURCE otherwise)
+func (s *LightNovelWorldSource) FetchNovelMetadata(novelID string) (*models.ImportedNovel, error) {
+	url := fmt.Sprintf("%s/novel/%s", s.baseUrl, novelID)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrNovelNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	article := findFirst(doc, and(byTag("article"), byID("novel")))
+	if article == nil {
+		return nil, parseError(url, "novel article")
+	}
+
+	novel := &models.ImportedNovel{
+		Title:            strings.TrimSuffix(metaContent(doc, "og:title", "twitter:title"), " | Light Novel World"),
+		CoverUrl:         metaContent(doc, "og:image", "twitter:image"),
+		Language:         models.ImportedLanguage{Name: "English"},
+		Year:             "N/A",
+		ReleaseFrequency: "N/A",
+		LatestChapter:    0,
+	}
+
+	if novel.Title == "" {
+		return nil, parseError(url, "novel title")
+	}
+
+	for _, a := range findAll(findFirst(article, byClass("author")), byTag("a")) {
+		novel.Authors = append(novel.Authors, models.Author{Name: text(a)})
+	}
+
+	for _, a := range findAll(findFirst(article, byClass("categories")), byTag("a")) {
+		novel.Genres = append(novel.Genres, models.Genre{Name: text(a)})
+	}
+
+	for _, span := range findAll(findFirst(article, byClass("header-stats")), byTag("span")) {
+		value := text(findFirst(span, byTag("strong")))
+
+		switch text(findFirst(span, byTag("small"))) {
+		case "Chapters":
+			if latestChapter, err := strconv.Atoi(strings.ReplaceAll(value, ",", "")); err == nil {
+				novel.LatestChapter = latestChapter
+			}
+		case "Status":
+			novel.Status = value
+		}
+	}
+
+	info := findFirst(article, and(byTag("section"), byID("info")))
+	summary := findFirst(findFirst(info, byClass("summary")), byClass("content"))
+	novel.Synopsis = joinParagraphs(children(summary, byTag("p")))
+
+	for _, a := range findAll(findFirst(info, byClass("tags")), byTag("a")) {
+		novel.Tags = append(novel.Tags, models.Tag{Name: text(a)})
+	}
+
+	return novel, nil
+}
+
+// FetchChapter fetches a chapter from LightNovelWorld.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (errors.ErrChapterNotFound if the chapter doesn't exist, errors.ErrChapterEmpty if it has no text,
+//     PARSING_SOURCE if the page has no chapter container, one of the errors of fetchDocument otherwise)
+func (s *LightNovelWorldSource) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	url := fmt.Sprintf("%s/novel/%s/chapter-%d", s.baseUrl, novelID, chapterNo)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrChapterNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	container := findFirst(doc, byID("chapter-container"))
+	if container == nil {
+		return nil, parseError(url, "chapter container")
+	}
+
+	body := joinParagraphs(findAll(container, byTag("p")))
+	if body == "" {
+		return nil, errors.ErrChapterEmpty
+	}
+
+	return &models.ImportedChapterMetadata{
+		ID:         uint(chapterNo),
+		Title:      text(findFirst(doc, byClass("chapter-title"))),
+		Body:       body,
+		ChapterUrl: url,
+	}, nil
+}
+
+// ListChapters is not supported by LightNovelWorld, whose chapter list is split across many pages.
+//
+// Returns:
+//   - error (errors.ErrSourceUnsupported)
+func (s *LightNovelWorldSource) ListChapters(_ string) ([]models.ImportedChapterListing, error) {
+	return nil, errors.ErrSourceUnsupported
+}
@@ -0,0 +1,190 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// NovelBinSource is a Source that scrapes novels and chapters from NovelBin.
+type NovelBinSource struct {
+	fetcher interfaces.Fetcher
+	baseUrl string
+}
+
+// NewNovelBinSource creates a new NovelBinSource instance.
+//
+// Parameters:
+//   - fetcher interfaces.Fetcher (The fetcher used to download pages)
+//
+// Returns:
+//   - *NovelBinSource (pointer to the NovelBinSource instance)
+func NewNovelBinSource(fetcher interfaces.Fetcher) *NovelBinSource {
+	return &NovelBinSource{fetcher: fetcher, baseUrl: "https://novelbin.com"}
+}
+
+// Name returns the name of the source.
+func (s *NovelBinSource) Name() string {
+	return "novelbin"
+}
+
+// FetchNovelMetadata fetches the metadata of a novel from its NovelBin page.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//
+// Returns:
+//   - *models.ImportedNovel (The metadata of the novel)
+//   - error (errors.ErrNovelNotFound if the novel doesn't exist, SOURCE_DOWN or PARSING_SOURCE otherwise)
+func (s *NovelBinSource) FetchNovelMetadata(novelID string) (*models.ImportedNovel, error) {
+	url := fmt.Sprintf("%s/b/%s", s.baseUrl, novelID)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrNovelNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	article := findFirst(doc, byID("novel"))
+	if article == nil {
+		return nil, parseError(url, "novel article")
+	}
+
+	novel := &models.ImportedNovel{
+		Title:            text(findFirst(findFirst(article, byClass("books")), byClass("title"))),
+		CoverUrl:         metaContent(doc, "og:image"),
+		Language:         models.ImportedLanguage{Name: "English"},
+		Year:             "N/A",
+		ReleaseFrequency: "N/A",
+		Synopsis:         text(findFirst(findFirst(article, byClass("tab-content")), byClass("desc-text"))),
+		LatestChapter:    parseChapterNumber(text(findFirst(findFirst(article, byClass("l-chapter")), byTag("a")))),
+	}
+
+	if novel.Title == "" {
+		return nil, parseError(url, "novel title")
+	}
+
+	for _, li := range findAll(findFirst(article, byClass("info", "info-meta")), byTag("li")) {
+		links := findAll(li, byTag("a"))
+
+		switch text(findFirst(li, byTag("h3"))) {
+		case "Author:":
+			for _, a := range links {
+				novel.Authors = append(novel.Authors, models.Author{Name: text(a)})
+			}
+		case "Genre:":
+			for _, a := range links {
+				novel.Genres = append(novel.Genres, models.Genre{Name: text(a)})
+			}
+		case "Tag:":
+			for _, a := range findAll(findFirst(li, byClass("tag-container")), byTag("a")) {
+				novel.Tags = append(novel.Tags, models.Tag{Name: text(a)})
+			}
+		case "Status:":
+			if len(links) > 0 {
+				novel.Status = text(links[0])
+			}
+		case "Year of publishing:":
+			if len(links) > 0 {
+				novel.Year = text(links[0])
+			}
+		}
+	}
+
+	return novel, nil
+}
+
+// FetchChapter fetches a chapter from NovelBin.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (errors.ErrChapterNotFound if the chapter doesn't exist, errors.ErrChapterEmpty if it has no text,
+//     PARSING_SOURCE if the page has no chapter content, one of the errors of fetchDocument otherwise)
+func (s *NovelBinSource) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	url := fmt.Sprintf("%s/b/%s/chapter-%d", s.baseUrl, novelID, chapterNo)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrChapterNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	content := findFirst(doc, byID("chr-content"))
+	if content == nil {
+		return nil, parseError(url, "chapter content")
+	}
+
+	titleNode := findFirst(doc, byClass("chr-title"))
+	title := attr(titleNode, "title")
+	if title == "" {
+		title = text(titleNode)
+	}
+
+	body := joinParagraphs(findAll(content, byTag("p")))
+	if strings.TrimSpace(body) == "" {
+		return nil, errors.ErrChapterEmpty
+	}
+
+	return &models.ImportedChapterMetadata{
+		ID:         uint(chapterNo),
+		Title:      title,
+		Body:       body,
+		ChapterUrl: url,
+	}, nil
+}
+
+// ListChapters lists the chapters of a novel using the NovelBin chapter archive.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//
+// Returns:
+//   - []models.ImportedChapterListing (The chapters, ordered by chapter number)
+//   - error (errors.ErrNovelNotFound if the novel doesn't exist, SOURCE_DOWN otherwise)
+func (s *NovelBinSource) ListChapters(novelID string) ([]models.ImportedChapterListing, error) {
+	url := fmt.Sprintf("%s/ajax/chapter-archive?novelId=%s", s.baseUrl, novelID)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrNovelNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	var chapters []models.ImportedChapterListing
+	seen := make(map[int]bool)
+	for _, li := range findAll(doc, and(byTag("ul"), byClass("list-chapter"))) {
+		for _, a := range findAll(li, byTag("a")) {
+			chapters = appendListing(chapters, seen, a)
+		}
+	}
+
+	sort.Slice(chapters, func(i, j int) bool { return chapters[i].ChapterNo < chapters[j].ChapterNo })
+	return chapters, nil
+}
+
+// appendListing appends the chapter linked by the anchor a to chapters, skipping anchors without a chapter number and
+// chapters that were already listed.
+func appendListing(chapters []models.ImportedChapterListing, seen map[int]bool, a *html.Node) []models.ImportedChapterListing {
+	title := attr(a, "title")
+	if title == "" {
+		title = text(a)
+	}
+
+	chapterNo := parseChapterNumber(title)
+	if chapterNo == 0 || seen[chapterNo] {
+		return chapters
+	}
+	seen[chapterNo] = true
+
+	return append(chapters, models.ImportedChapterListing{
+		ChapterNo:  uint(chapterNo),
+		Title:      title,
+		ChapterUrl: attr(a, "href"),
+	})
+}
@@ -0,0 +1,83 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"fmt"
+)
+
+// NovTalesSource is a Source that scrapes chapters from NovTales.
+type NovTalesSource struct {
+	fetcher interfaces.Fetcher
+	baseUrl string
+}
+
+// NewNovTalesSource creates a new NovTalesSource instance.
+//
+// Parameters:
+//   - fetcher interfaces.Fetcher (The fetcher used to download pages)
+//
+// Returns:
+//   - *NovTalesSource (pointer to the NovTalesSource instance)
+func NewNovTalesSource(fetcher interfaces.Fetcher) *NovTalesSource {
+	return &NovTalesSource{fetcher: fetcher, baseUrl: "https://novtales.com"}
+}
+
+// Name returns the name of the source.
+func (s *NovTalesSource) Name() string {
+	return "novtales"
+}
+
+// FetchNovelMetadata is not supported by NovTales, whose novel pages don't carry the full metadata.
+//
+// Returns:
+//   - error (errors.ErrSourceUnsupported)
+func (s *NovTalesSource) FetchNovelMetadata(_ string) (*models.ImportedNovel, error) {
+	return nil, errors.ErrSourceUnsupported
+}
+
+// FetchChapter fetches a chapter from NovTales. NovTales renders chapters client side, so the title and body are read
+// from the Open Graph (or Twitter card) meta tags of the page.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (errors.ErrChapterNotFound if the chapter doesn't exist, errors.ErrChapterEmpty if it has no text, one of
+//     the errors of fetchDocument otherwise)
+func (s *NovTalesSource) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	url := fmt.Sprintf("%s/chapter/%s-%d", s.baseUrl, novelID, chapterNo)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrChapterNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	title := metaContent(doc, "og:title", "twitter:title")
+	if title == "" {
+		return nil, errors.ErrChapterNotFound
+	}
+
+	body := metaContent(doc, "og:description", "twitter:description")
+	if body == "" {
+		return nil, errors.ErrChapterEmpty
+	}
+
+	return &models.ImportedChapterMetadata{
+		ID:         uint(chapterNo),
+		Title:      title,
+		Body:       body,
+		ChapterUrl: url,
+	}, nil
+}
+
+// ListChapters is not supported by NovTales.
+//
+// Returns:
+//   - error (errors.ErrSourceUnsupported)
+func (s *NovTalesSource) ListChapters(_ string) ([]models.ImportedChapterListing, error) {
+	return nil, errors.ErrSourceUnsupported
+}
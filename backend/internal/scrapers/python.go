@@ -0,0 +1,171 @@
+package scrapers
+
+import (
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// pythonScraperModule is the Python module that implements the scraper client.
+const pythonScraperModule = "novel_updates_scraper.client"
+
+// PythonSource is a Source that delegates to the novel_updates_scraper Python client through a ScriptExecutor.
+type PythonSource struct {
+	scriptExecutor utils.ScriptExecutor
+}
+
+// NewPythonSource creates a new PythonSource instance.
+//
+// Parameters:
+//   - scriptExecutor utils.ScriptExecutor (The script executor used to run the Python client)
+//
+// Returns:
+//   - *PythonSource (pointer to the PythonSource instance)
+func NewPythonSource(scriptExecutor utils.ScriptExecutor) *PythonSource {
+	return &PythonSource{scriptExecutor: scriptExecutor}
+}
+
+// Name returns the name of the source.
+func (s *PythonSource) Name() string {
+	return "python"
+}
+
+// FetchNovelMetadata runs the import-novel action of the Python client.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//
+// Returns:
+//   - *models.ImportedNovel (The metadata of the novel)
+//   - error (errors.ErrNovelNotFound if the novel doesn't exist, SCRIPT_ERROR if the script failed or reported an
+//     error, IMPORTING_NOVEL if the output of the script is not a valid novel)
+func (s *PythonSource) FetchNovelMetadata(novelID string) (*models.ImportedNovel, error) {
+	output, err := s.run("import-novel", novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkScriptError(output, errors.ErrNovelNotFound); err != nil {
+		return nil, err
+	}
+
+	var result models.ImportedNovel
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, types.WrapError(errors.IMPORTING_NOVEL, "An error occurred while importing the novel: "+err.Error(), http.StatusInternalServerError, err)
+	}
+
+	return &result, nil
+}
+
+// FetchChapter runs the import-chapter action of the Python client. The chapter keeps the name of the website the
+// client fetched it from, the client tries several of them.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (errors.ErrChapterNotFound if the chapter doesn't exist, errors.ErrChapterEmpty if it has no text,
+//     SOURCE_BLOCKED, PARSING_SOURCE, SOURCE_TIMEOUT or SOURCE_DOWN if the script reported why the chapter could not
+//     be fetched, SCRIPT_ERROR if the script failed or reported any other error, IMPORTING_CHAPTER if the output of
+//     the script is not a valid chapter)
+func (s *PythonSource) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	output, err := s.run("import-chapter", novelID, strconv.Itoa(chapterNo))
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Title  string `json:"title"`
+		Body   string `json:"body"`
+		Url    string `json:"url"`
+		Source string `json:"source"`
+		Status int    `json:"status"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, types.WrapError(errors.IMPORTING_CHAPTER, "Failed to parse Python script output as JSON", http.StatusInternalServerError, err)
+	}
+
+	switch result.Status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errors.ErrChapterNotFound
+	case http.StatusNoContent:
+		return nil, errors.ErrChapterEmpty
+	case http.StatusForbidden:
+		return nil, types.WrapError(errors.SOURCE_BLOCKED, result.Error, http.StatusBadGateway, nil)
+	case http.StatusBadGateway:
+		return nil, types.WrapError(errors.PARSING_SOURCE, result.Error, http.StatusBadGateway, nil)
+	case http.StatusGatewayTimeout:
+		return nil, types.WrapError(errors.SOURCE_TIMEOUT, result.Error, http.StatusGatewayTimeout, nil)
+	case http.StatusServiceUnavailable:
+		return nil, types.WrapError(errors.SOURCE_DOWN, result.Error, http.StatusServiceUnavailable, nil)
+	default:
+		return nil, types.WrapError(errors.SCRIPT_ERROR, result.Error, http.StatusServiceUnavailable, nil)
+	}
+
+	return &models.ImportedChapterMetadata{
+		ID:         uint(chapterNo),
+		Title:      result.Title,
+		Body:       result.Body,
+		ChapterUrl: result.Url,
+		Source:     result.Source,
+	}, nil
+}
+
+// ListChapters is not supported by the Python client.
+//
+// Returns:
+//   - error (errors.ErrSourceUnsupported)
+func (s *PythonSource) ListChapters(_ string) ([]models.ImportedChapterListing, error) {
+	return nil, errors.ErrSourceUnsupported
+}
+
+// run executes an action of the Python client.
+//
+// Parameters:
+//   - action string (The action to run, e.g. "import-novel")
+//   - args ...string (The arguments of the action)
+//
+// Returns:
+//   - []byte (The output of the script)
+//   - error (SCRIPT_ERROR if the script could not be executed)
+func (s *PythonSource) run(action string, args ...string) ([]byte, error) {
+	scriptArgs := append([]string{"-m", pythonScraperModule, action}, args...)
+
+	output, err := s.scriptExecutor.ExecuteScript(os.Getenv("PYTHON"), scriptArgs...)
+	if err != nil {
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to execute Python script: "+err.Error(), http.StatusServiceUnavailable, err)
+	}
+
+	return output, nil
+}
+
+// checkScriptError checks if the output of the script is an error object ({"status": ..., "error": ...}).
+//
+// Parameters:
+//   - output []byte (The output of the script)
+//   - notFound error (The error returned if the script reported a 404)
+//
+// Returns:
+//   - error (notFound if the script reported a 404, SCRIPT_ERROR for any other reported error, nil otherwise)
+func checkScriptError(output []byte, notFound error) error {
+	var scriptError utils.ScriptError
+	if json.Unmarshal(output, &scriptError) != nil || (scriptError.Status == 0 && scriptError.Error == "") {
+		return nil
+	}
+
+	if scriptError.Status == http.StatusNotFound {
+		return notFound
+	}
+
+	return types.WrapError(errors.SCRIPT_ERROR, scriptError.Error, http.StatusServiceUnavailable, nil)
+}
@@ -0,0 +1,269 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	stdErrors "errors"
+	"log"
+	"sync"
+)
+
+// Registry keeps the available sources by name. It also implements Source itself by trying every registered source in
+// registration order until one of them succeeds. The metadata of the novels is fetched from the sources preferred for
+// it first, since the websites that are best for chapters don't have the full metadata of the novels.
+type Registry struct {
+	mu            sync.RWMutex
+	sources       map[string]interfaces.Source
+	order         []string
+	metadataOrder []string
+}
+
+// NewRegistry creates a new Registry instance.
+//
+// Parameters:
+//   - sources ...interfaces.Source (The sources to register, in fallback order)
+//
+// Returns:
+//   - *Registry (pointer to the Registry instance)
+func NewRegistry(sources ...interfaces.Source) *Registry {
+	registry := &Registry{sources: make(map[string]interfaces.Source)}
+	for _, source := range sources {
+		registry.Register(source)
+	}
+	return registry
+}
+
+// Register adds a source to the registry. Registering a source with the name of an existing one replaces it while
+// keeping its position in the fallback order.
+//
+// Parameters:
+//   - source interfaces.Source (The source to register)
+func (r *Registry) Register(source interfaces.Source) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.sources[source.Name()]; !exists {
+		r.order = append(r.order, source.Name())
+	}
+	r.sources[source.Name()] = source
+}
+
+// PreferForMetadata makes FetchNovelMetadata try the given sources, in order, before the others. The names of sources
+// that aren't registered are skipped.
+//
+// Parameters:
+//   - names ...string (The names of the sources preferred for the metadata of the novels)
+func (r *Registry) PreferForMetadata(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.metadataOrder = append([]string(nil), names...)
+}
+
+// Get returns the source registered with the given name.
+//
+// Parameters:
+//   - name string (The name of the source)
+//
+// Returns:
+//   - interfaces.Source (The source)
+//   - error (errors.ErrSourceNotRegistered if there is no source with that name)
+func (r *Registry) Get(name string) (interfaces.Source, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	source, ok := r.sources[name]
+	if !ok {
+		return nil, errors.ErrSourceNotRegistered
+	}
+	return source, nil
+}
+
+// Names returns the names of the registered sources in fallback order.
+//
+// Returns:
+//   - []string (The names of the sources)
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]string(nil), r.order...)
+}
+
+// Name returns the name of the registry when used as a Source.
+func (r *Registry) Name() string {
+	return "registry"
+}
+
+// FetchNovelMetadata fetches the metadata of a novel from the first source that provides it, trying the sources
+// preferred for metadata first.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//
+// Returns:
+//   - *models.ImportedNovel (The metadata of the novel)
+//   - error (The error of the last source that supports the operation if all of them failed, or
+//     errors.ErrSourceUnsupported if none supports it)
+func (r *Registry) FetchNovelMetadata(novelID string) (*models.ImportedNovel, error) {
+	return fallback(r.metadataSnapshot(), func(source interfaces.Source) (*models.ImportedNovel, error) {
+		return source.FetchNovelMetadata(novelID)
+	})
+}
+
+// FetchChapter fetches a chapter from the first source that provides it.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (The error of the last source that supports the operation if all of them failed, or
+//     errors.ErrSourceUnsupported if none supports it)
+func (r *Registry) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	return fetchChapter(r.snapshot(), novelID, chapterNo)
+}
+
+// FetchChapterFrom fetches a chapter from the first of the given sources that provides it, ignoring the other
+// registered sources.
+//
+// Parameters:
+//   - names []string (The names of the sources to try, in order)
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (errors.ErrSourceNotRegistered if one of the names is unknown, the error of the last source that supports
+//     the operation if all of them failed, or errors.ErrSourceUnsupported if none supports it)
+func (r *Registry) FetchChapterFrom(names []string, novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	sources := make([]interfaces.Source, 0, len(names))
+	for _, name := range names {
+		source, err := r.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+
+	return fetchChapter(sources, novelID, chapterNo)
+}
+
+// ListChapters lists the chapters of a novel using the first source that provides them.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//
+// Returns:
+//   - []models.ImportedChapterListing (The chapters, ordered by chapter number)
+//   - error (The error of the last source that supports the operation if all of them failed, or
+//     errors.ErrSourceUnsupported if none supports it)
+func (r *Registry) ListChapters(novelID string) ([]models.ImportedChapterListing, error) {
+	return fallback(r.snapshot(), func(source interfaces.Source) ([]models.ImportedChapterListing, error) {
+		return source.ListChapters(novelID)
+	})
+}
+
+// fetchChapter fetches a chapter from the first of the sources that provides it, recording the name of that source in
+// the chapter.
+//
+// Parameters:
+//   - sources []interfaces.Source (The sources to try, in order)
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (The error of the sources if all of them failed)
+func fetchChapter(sources []interfaces.Source, novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	return fallback(sources, func(source interfaces.Source) (*models.ImportedChapterMetadata, error) {
+		chapter, err := source.FetchChapter(novelID, chapterNo)
+		if err == nil && chapter.Source == "" {
+			chapter.Source = source.Name()
+		}
+		return chapter, err
+	})
+}
+
+// snapshot returns the registered sources in fallback order.
+func (r *Registry) snapshot() []interfaces.Source {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sources := make([]interfaces.Source, 0, len(r.order))
+	for _, name := range r.order {
+		sources = append(sources, r.sources[name])
+	}
+	return sources
+}
+
+// metadataSnapshot returns the registered sources in the fallback order of the metadata of the novels: the preferred
+// sources first, then the others in registration order.
+func (r *Registry) metadataSnapshot() []interfaces.Source {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sources := make([]interfaces.Source, 0, len(r.order))
+	preferred := make(map[string]bool, len(r.metadataOrder))
+	for _, name := range r.metadataOrder {
+		if source, ok := r.sources[name]; ok && !preferred[name] {
+			sources = append(sources, source)
+			preferred[name] = true
+		}
+	}
+	for _, name := range r.order {
+		if !preferred[name] {
+			sources = append(sources, r.sources[name])
+		}
+	}
+	return sources
+}
+
+// fallback calls fetch on each source in order and returns the first successful result.
+//
+// Parameters:
+//   - sources []interfaces.Source (The sources to try, in order)
+//   - fetch func(interfaces.Source) (T, error) (The operation to run on a source)
+//
+// Returns:
+//   - T (The result of the first source that succeeded)
+//   - error (The error of the last source that supports the operation if all of them failed, the last not found one
+//     if any source reported the novel or chapter missing, otherwise the last transient one if any source failed with
+//     a transient error, or errors.ErrSourceUnsupported if none supports it)
+func fallback[T any](sources []interfaces.Source, fetch func(interfaces.Source) (T, error)) (T, error) {
+	var zero T
+	var lastErr error = errors.ErrSourceUnsupported
+
+	for _, source := range sources {
+		result, err := fetch(source)
+		if err == nil {
+			return result, nil
+		}
+
+		if stdErrors.Is(err, errors.ErrSourceUnsupported) {
+			continue
+		}
+
+		log.Printf("Source %s failed: %v", source.Name(), err)
+
+		// A source reporting the novel or chapter missing is a real answer, it's kept over the other errors. Otherwise a
+		// transient error is kept over a permanent one, a source being down makes the whole operation worth retrying
+		switch {
+		case isNotFoundError(err):
+			lastErr = err
+		case isNotFoundError(lastErr):
+		case !utils.IsRetryableImportError(lastErr) || utils.IsRetryableImportError(err):
+			lastErr = err
+		}
+	}
+
+	return zero, lastErr
+}
+
+// isNotFoundError reports whether a source failed because the novel or chapter doesn't exist.
+func isNotFoundError(err error) bool {
+	return stdErrors.Is(err, errors.ErrNovelNotFound) || stdErrors.Is(err, errors.ErrChapterNotFound)
+}
@@ -0,0 +1,410 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSourceLimits are the limits of the sources that are not configured.
+var DefaultSourceLimits = models.SourceLimits{
+	RequestsPerSecond: 2,
+	Burst:             4,
+	MaxRetries:        4,
+	BaseBackoff:       500 * time.Millisecond,
+	MaxBackoff:        30 * time.Second,
+	FailureThreshold:  5,
+	Cooldown:          time.Minute,
+}
+
+// Throttle creates the throttled fetchers of the sources and reports their status.
+type Throttle struct {
+	fetcher interfaces.Fetcher
+	limits  map[string]models.SourceLimits
+
+	mu       sync.Mutex
+	fetchers []*ThrottledFetcher
+}
+
+// NewThrottle creates a new Throttle instance.
+//
+// Parameters:
+//   - fetcher interfaces.Fetcher (The fetcher wrapped by the throttled fetchers)
+//   - limits map[string]models.SourceLimits (The limits of the configured sources, the others use DefaultSourceLimits)
+//
+// Returns:
+//   - *Throttle (pointer to the Throttle instance)
+func NewThrottle(fetcher interfaces.Fetcher, limits map[string]models.SourceLimits) *Throttle {
+	return &Throttle{fetcher: fetcher, limits: limits}
+}
+
+// Fetcher creates the throttled fetcher of a source, with the limits configured for it.
+//
+// Parameters:
+//   - source string (The name of the source)
+//
+// Returns:
+//   - *ThrottledFetcher (The fetcher to give to the source)
+func (t *Throttle) Fetcher(source string) *ThrottledFetcher {
+	limits, ok := t.limits[source]
+	if !ok {
+		limits = DefaultSourceLimits
+	}
+	fetcher := NewThrottledFetcher(source, t.fetcher, limits)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.fetchers = append(t.fetchers, fetcher)
+
+	return fetcher
+}
+
+// SourceStatuses returns the status of each source created by the throttle.
+//
+// Returns:
+//   - []models.SourceStatus (The status of the sources, in creation order)
+func (t *Throttle) SourceStatuses() []models.SourceStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	statuses := make([]models.SourceStatus, 0, len(t.fetchers))
+	for _, fetcher := range t.fetchers {
+		statuses = append(statuses, fetcher.Status())
+	}
+	return statuses
+}
+
+// ThrottledFetcher is a Fetcher that limits the requests sent to each host with a token bucket, retries requests that
+// fail with a 429 or 5xx response or a network error with an exponential backoff with jitter, and pauses the source
+// after too many consecutive failed requests.
+type ThrottledFetcher struct {
+	source  string
+	fetcher interfaces.Fetcher
+	limits  models.SourceLimits
+
+	mu                  sync.Mutex
+	buckets             map[string]*tokenBucket
+	consecutiveFailures int
+	pausedUntil         time.Time
+	probing             bool
+	requests            int64
+	retries             int64
+	failures            int64
+	rejected            int64
+}
+
+// NewThrottledFetcher creates a new ThrottledFetcher instance.
+//
+// Parameters:
+//   - source string (The name of the source)
+//   - fetcher interfaces.Fetcher (The fetcher sending the requests)
+//   - limits models.SourceLimits (The limits of the source, zero values are replaced by the default ones)
+//
+// Returns:
+//   - *ThrottledFetcher (pointer to the ThrottledFetcher instance)
+func NewThrottledFetcher(source string, fetcher interfaces.Fetcher, limits models.SourceLimits) *ThrottledFetcher {
+	if limits.RequestsPerSecond <= 0 {
+		limits.RequestsPerSecond = DefaultSourceLimits.RequestsPerSecond
+	}
+	if limits.Burst <= 0 {
+		limits.Burst = DefaultSourceLimits.Burst
+	}
+	if limits.MaxRetries < 0 {
+		limits.MaxRetries = 0
+	}
+	if limits.BaseBackoff <= 0 {
+		limits.BaseBackoff = DefaultSourceLimits.BaseBackoff
+	}
+	if limits.MaxBackoff < limits.BaseBackoff {
+		limits.MaxBackoff = max(DefaultSourceLimits.MaxBackoff, limits.BaseBackoff)
+	}
+	if limits.FailureThreshold <= 0 {
+		limits.FailureThreshold = DefaultSourceLimits.FailureThreshold
+	}
+	if limits.Cooldown <= 0 {
+		limits.Cooldown = DefaultSourceLimits.Cooldown
+	}
+
+	return &ThrottledFetcher{
+		source:  source,
+		fetcher: fetcher,
+		limits:  limits,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Fetch downloads the page at the given URL once the host allows it, retrying if the request fails with a 429 or 5xx
+// response or a network error.
+//
+// Parameters:
+//   - url string (The URL of the page)
+//
+// Returns:
+//   - []byte (The body of the page)
+//   - int (The HTTP status code of the last response)
+//   - error (errors.ErrSourceCircuitOpen if the source is paused, or the error of the last attempt)
+func (f *ThrottledFetcher) Fetch(url string) ([]byte, int, error) {
+	if !f.allow() {
+		return nil, 0, errors.ErrSourceCircuitOpen
+	}
+
+	bucket := f.bucket(url)
+
+	var body []byte
+	var status int
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		time.Sleep(bucket.reserve())
+
+		f.mu.Lock()
+		f.requests++
+		f.mu.Unlock()
+
+		body, status, err = f.fetcher.Fetch(url)
+		if !isRetryable(status, err) || attempt >= f.limits.MaxRetries {
+			break
+		}
+
+		f.mu.Lock()
+		f.retries++
+		f.mu.Unlock()
+
+		time.Sleep(f.backoff(attempt))
+	}
+
+	// Blocked requests aren't retried, but the source is paused if it keeps blocking them
+	f.record(!isRetryable(status, err) && !isBlockedResponse(status, body))
+
+	return body, status, err
+}
+
+// Status returns the limits and the health of the source.
+//
+// Returns:
+//   - models.SourceStatus (The status of the source)
+func (f *ThrottledFetcher) Status() models.SourceStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := models.SourceStatus{
+		Name:                f.source,
+		Limits:              f.limits,
+		State:               models.CircuitClosed,
+		ConsecutiveFailures: f.consecutiveFailures,
+		Requests:            f.requests,
+		Retries:             f.retries,
+		Failures:            f.failures,
+		Rejected:            f.rejected,
+	}
+
+	if !f.pausedUntil.IsZero() {
+		if time.Now().Before(f.pausedUntil) {
+			pausedUntil := f.pausedUntil
+			status.State = models.CircuitOpen
+			status.PausedUntil = &pausedUntil
+		} else {
+			status.State = models.CircuitHalfOpen
+		}
+	}
+
+	return status
+}
+
+// allow reports whether a request can be sent to the source. Once the pause of the source is over, a single request
+// is let through to decide whether it is resumed.
+func (f *ThrottledFetcher) allow() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.pausedUntil.IsZero() {
+		return true
+	}
+
+	if time.Now().Before(f.pausedUntil) || f.probing {
+		f.rejected++
+		return false
+	}
+
+	f.probing = true
+	return true
+}
+
+// record updates the health of the source with the outcome of a request.
+func (f *ThrottledFetcher) record(succeeded bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	wasProbing := f.probing
+	f.probing = false
+
+	if succeeded {
+		f.consecutiveFailures = 0
+		f.pausedUntil = time.Time{}
+		return
+	}
+
+	f.failures++
+	f.consecutiveFailures++
+	if wasProbing || f.consecutiveFailures >= f.limits.FailureThreshold {
+		f.pausedUntil = time.Now().Add(f.limits.Cooldown)
+	}
+}
+
+// bucket returns the token bucket of the host of a URL.
+func (f *ThrottledFetcher) bucket(url string) *tokenBucket {
+	host := url
+	if parsed, err := neturl.Parse(url); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, ok := f.buckets[host]
+	if !ok {
+		bucket = newTokenBucket(f.limits.RequestsPerSecond, f.limits.Burst)
+		f.buckets[host] = bucket
+	}
+	return bucket
+}
+
+// backoff returns the delay before a retry, doubling the base delay on each attempt up to the maximum delay, with a
+// random jitter so the retries of concurrent requests are spread out.
+func (f *ThrottledFetcher) backoff(attempt int) time.Duration {
+	delay := f.limits.MaxBackoff
+	if attempt < 32 {
+		delay = min(f.limits.BaseBackoff<<attempt, f.limits.MaxBackoff)
+	}
+	if delay <= 0 {
+		delay = f.limits.MaxBackoff
+	}
+
+	// Equal jitter: at least half of the delay, so retries still slow down
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// isRetryable reports whether a request failed in a way that may succeed if it is sent again.
+func isRetryable(status int, err error) bool {
+	return err != nil || status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// tokenBucket limits the rate of the requests sent to a host.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// newTokenBucket creates a full token bucket.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, capacity: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve takes a token and returns how long to wait before it can be used.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// ParseSourceLimits parses the limits of the sources from a specification such as
+// "novelbin:rps=1,burst=2;wuxiabox:retries=2,cooldown=5m". The keys are rps, burst, retries, backoff, max_backoff,
+// failures and cooldown, durations are written as Go durations (e.g., "500ms", "1m"). Keys that are not given keep
+// the value of the defaults.
+//
+// Parameters:
+//   - spec string (The specification, empty for no configured source)
+//   - defaults models.SourceLimits (The limits the configured values are applied on)
+//
+// Returns:
+//   - map[string]models.SourceLimits (The limits of each configured source)
+//   - error (INVALID_SOURCE_LIMITS if the specification is invalid)
+func ParseSourceLimits(spec string, defaults models.SourceLimits) (map[string]models.SourceLimits, error) {
+	limits := make(map[string]models.SourceLimits)
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		source, values, ok := strings.Cut(entry, ":")
+		source = strings.TrimSpace(source)
+		if !ok || source == "" {
+			return nil, invalidSourceLimits(entry, fmt.Errorf("expected source:key=value"))
+		}
+
+		sourceLimits := defaults
+		if existing, ok := limits[source]; ok {
+			sourceLimits = existing
+		}
+
+		for _, pair := range strings.Split(values, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok {
+				return nil, invalidSourceLimits(entry, fmt.Errorf("expected key=value, got %q", pair))
+			}
+
+			if err := setSourceLimit(&sourceLimits, strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+				return nil, invalidSourceLimits(entry, err)
+			}
+		}
+
+		limits[source] = sourceLimits
+	}
+
+	return limits, nil
+}
+
+// setSourceLimit sets a single limit from its key and value.
+func setSourceLimit(limits *models.SourceLimits, key, value string) error {
+	var err error
+
+	switch key {
+	case "rps":
+		limits.RequestsPerSecond, err = strconv.ParseFloat(value, 64)
+	case "burst":
+		limits.Burst, err = strconv.Atoi(value)
+	case "retries":
+		limits.MaxRetries, err = strconv.Atoi(value)
+	case "failures":
+		limits.FailureThreshold, err = strconv.Atoi(value)
+	case "backoff":
+		limits.BaseBackoff, err = time.ParseDuration(value)
+	case "max_backoff":
+		limits.MaxBackoff, err = time.ParseDuration(value)
+	case "cooldown":
+		limits.Cooldown, err = time.ParseDuration(value)
+	default:
+		return fmt.Errorf("unknown key %q", key)
+	}
+
+	return err
+}
+
+// invalidSourceLimits wraps the reason the limits of a source are invalid.
+func invalidSourceLimits(entry string, err error) error {
+	return types.WrapError(errors.INVALID_SOURCE_LIMITS, fmt.Sprintf("Invalid source limits %q", entry), http.StatusBadRequest, err)
+}
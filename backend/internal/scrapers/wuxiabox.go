@@ -0,0 +1,82 @@
+package scrapers
+
+import (
+	"backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"fmt"
+)
+
+// WuxiaBoxSource is a Source that scrapes chapters from WuxiaBox.
+type WuxiaBoxSource struct {
+	fetcher interfaces.Fetcher
+	baseUrl string
+}
+
+// NewWuxiaBoxSource creates a new WuxiaBoxSource instance.
+//
+// Parameters:
+//   - fetcher interfaces.Fetcher (The fetcher used to download pages)
+//
+// Returns:
+//   - *WuxiaBoxSource (pointer to the WuxiaBoxSource instance)
+func NewWuxiaBoxSource(fetcher interfaces.Fetcher) *WuxiaBoxSource {
+	return &WuxiaBoxSource{fetcher: fetcher, baseUrl: "https://www.wuxiabox.com"}
+}
+
+// Name returns the name of the source.
+func (s *WuxiaBoxSource) Name() string {
+	return "wuxiabox"
+}
+
+// FetchNovelMetadata is not supported by WuxiaBox, which is only used for chapters.
+//
+// Returns:
+//   - error (errors.ErrSourceUnsupported)
+func (s *WuxiaBoxSource) FetchNovelMetadata(_ string) (*models.ImportedNovel, error) {
+	return nil, errors.ErrSourceUnsupported
+}
+
+// FetchChapter fetches a chapter from WuxiaBox.
+//
+// Parameters:
+//   - novelID string (The NovelUpdates ID of the novel)
+//   - chapterNo int (The number of the chapter)
+//
+// Returns:
+//   - *models.ImportedChapterMetadata (The chapter)
+//   - error (errors.ErrChapterNotFound if the chapter doesn't exist, errors.ErrChapterEmpty if it has no text, one of
+//     the errors of fetchDocument otherwise)
+func (s *WuxiaBoxSource) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	url := fmt.Sprintf("%s/novel/%s_%d.html", s.baseUrl, novelID, chapterNo)
+
+	doc, err := fetchDocument(s.fetcher, url, errors.ErrChapterNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	article := findFirst(doc, and(byTag("article"), byID("chapter-article")))
+	if article == nil {
+		return nil, errors.ErrChapterNotFound
+	}
+
+	body := joinParagraphs(children(findFirst(article, byClass("chapter-content")), byTag("p")))
+	if body == "" {
+		return nil, errors.ErrChapterEmpty
+	}
+
+	return &models.ImportedChapterMetadata{
+		ID:         uint(chapterNo),
+		Title:      text(findFirst(article, byTag("h2"))),
+		Body:       body,
+		ChapterUrl: url,
+	}, nil
+}
+
+// ListChapters is not supported by WuxiaBox.
+//
+// Returns:
+//   - error (errors.ErrSourceUnsupported)
+func (s *WuxiaBoxSource) ListChapters(_ string) ([]models.ImportedChapterListing, error) {
+	return nil, errors.ErrSourceUnsupported
+}
@@ -3,18 +3,62 @@ package services
 import (
 	"backend/internal/models"
 	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/validators"
+	"slices"
 )
 
 type BookmarkService struct {
-	repo interfaces.BookmarkRepositoryInterface
+	repo      interfaces.BookmarkRepositoryInterface
+	novelRepo interfaces.NovelRepositoryInterface
 }
 
-func NewBookmarkService(repo interfaces.BookmarkRepositoryInterface) *BookmarkService {
-	return &BookmarkService{repo: repo}
+func NewBookmarkService(repo interfaces.BookmarkRepositoryInterface, novelRepo interfaces.NovelRepositoryInterface) *BookmarkService {
+	return &BookmarkService{repo: repo, novelRepo: novelRepo}
 }
 
-func (s *BookmarkService) GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.Novel, int64, error) {
-	return s.repo.GetBookmarkedNovelsByUserID(userID, page, limit)
+func (s *BookmarkService) GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.LibraryEntry, int64, error) {
+	entries, total, err := s.repo.GetBookmarkedNovelsByUserID(userID, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	completeLibraryEntries(entries)
+	return entries, total, nil
+}
+
+func (s *BookmarkService) GetContinueReading(userID uint, statuses []string, limit int) ([]models.LibraryEntry, error) {
+	if len(statuses) == 0 {
+		statuses = []string{models.BookmarkStatusReading}
+	}
+	for i, status := range statuses {
+		statuses[i] = models.NormalizeBookmarkStatus(status)
+		if err := validators.ValidateBookmarkStatus(statuses[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := s.repo.GetContinueReading(userID, statuses, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	completeLibraryEntries(entries)
+	return entries, nil
+}
+
+// completeLibraryEntries computes the unread chapters, up to the latest chapter stored, and the last activity of library
+// entries.
+func completeLibraryEntries(entries []models.LibraryEntry) {
+	for i := range entries {
+		entry := &entries[i]
+		entry.UnreadChapters = max(entry.Novel.LatestChapter-entry.CurrentChapter, 0)
+
+		entry.LastActivityAt = entry.UpdatedAt
+		if entry.LastReadAt != nil && entry.LastReadAt.After(entry.LastActivityAt) {
+			entry.LastActivityAt = *entry.LastReadAt
+		}
+	}
 }
 
 func (s *BookmarkService) GetBookmarkByUserIDAndNovelID(userID uint, novelID string) (models.BookmarkedNovel, error) {
@@ -22,13 +66,60 @@ func (s *BookmarkService) GetBookmarkByUserIDAndNovelID(userID uint, novelID str
 }
 
 func (s *BookmarkService) UpdateBookmark(novel models.BookmarkedNovel) (models.BookmarkedNovel, error) {
+	novel.Status = models.NormalizeBookmarkStatus(novel.Status)
+	if err := validators.ValidateBookmark(novel); err != nil {
+		return novel, err
+	}
+
+	existing, err := s.repo.GetBookmark(uint(novel.UserID), uint(novel.NovelID))
+	if err != nil {
+		return novel, err
+	}
+	if existing == nil {
+		return novel, errors.ErrBookmarkNotFound
+	}
+
+	bookmarked, err := s.novelRepo.GetNovelByID(uint(novel.NovelID))
+	if err != nil {
+		return novel, err
+	}
+
+	applyBookmarkProgress(&novel, existing.CurrentChapter, bookmarked)
 	return s.repo.UpdateBookmark(novel)
 }
 
 func (s *BookmarkService) CreateBookmark(bookmarkedNovel models.BookmarkedNovel) (*models.BookmarkedNovel, error) {
+	bookmarkedNovel.Status = models.NormalizeBookmarkStatus(bookmarkedNovel.Status)
+	if err := validators.ValidateBookmark(bookmarkedNovel); err != nil {
+		return nil, err
+	}
+
+	novel, err := s.novelRepo.GetNovelByID(uint(bookmarkedNovel.NovelID))
+	if err != nil {
+		return nil, err
+	}
+
+	applyBookmarkProgress(&bookmarkedNovel, 0, novel)
 	return s.repo.CreateBookmark(bookmarkedNovel)
 }
 
+// applyBookmarkProgress moves a bookmark on when the user reads further than the previous current chapter: a novel
+// read to the last chapter of a completed novel is completed, and a novel planned or put on hold is being read again.
+// Dropped novels stay dropped, since reading on doesn't mean the user picked them up.
+func applyBookmarkProgress(bookmark *models.BookmarkedNovel, previousChapter int, novel *models.Novel) {
+	if bookmark.CurrentChapter <= previousChapter {
+		return
+	}
+
+	switch {
+	case novel.IsCompleted() && novel.LatestChapter > 0 && bookmark.CurrentChapter >= novel.LatestChapter &&
+		bookmark.Status != models.BookmarkStatusDropped:
+		bookmark.Status = models.BookmarkStatusCompleted
+	case slices.Contains([]string{models.BookmarkStatusPlanToRead, models.BookmarkStatusOnHold}, bookmark.Status):
+		bookmark.Status = models.BookmarkStatusReading
+	}
+}
+
 func (s *BookmarkService) UnbookmarkNovel(userID uint, novelID uint) error {
 	return s.repo.DeleteBookmark(userID, novelID)
 }
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"strings"
+)
+
+// chapterScraper is the part of the chapter service used to scrape chapters again.
+type chapterScraper interface {
+	ImportChapter(novelUpdatesID string, chapterNo int) (models.ImportedChapterMetadata, error)
+	ImportChapterFromSource(novelUpdatesID string, chapterNo int, source string) (models.ImportedChapterMetadata, error)
+}
+
+// ChapterRevisionService manages the revisions of the content of the chapters. Scraping a chapter again stores a new
+// revision, and moderators pick which revision is served to readers.
+type ChapterRevisionService struct {
+	repo           interfaces.ChapterRevisionRepositoryInterface
+	chapterRepo    interfaces.ChapterRepositoryInterface
+	chapterService chapterScraper
+}
+
+// NewChapterRevisionService creates a new ChapterRevisionService instance.
+//
+// Parameters:
+//   - repo (interfaces.ChapterRevisionRepositoryInterface): The repository used to store the revisions.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to look up the chapters.
+//   - chapterService (chapterScraper): The chapter service used to scrape the chapters.
+//
+// Returns:
+//   - *ChapterRevisionService: A pointer to the newly created ChapterRevisionService.
+func NewChapterRevisionService(repo interfaces.ChapterRevisionRepositoryInterface, chapterRepo interfaces.ChapterRepositoryInterface, chapterService chapterScraper) *ChapterRevisionService {
+	return &ChapterRevisionService{
+		repo:           repo,
+		chapterRepo:    chapterRepo,
+		chapterService: chapterService,
+	}
+}
+
+// RescrapeChapter scrapes a chapter again and stores the result as a new revision. The sources are tried in the
+// priority of the novel unless a source is given, and the content served to readers only changes if the new revision
+// is made current.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//   - source (string): The name of the only source to scrape the chapter from, empty to try all of them.
+//   - makeCurrent (bool): Whether the new revision replaces the content served to readers.
+//
+// Returns:
+//   - *models.ChapterRevision: A pointer to the created revision.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if the chapter is not stored or is not found on the sources.
+//   - errors.ErrSourceNotRegistered: Returned if there is no source with the given name.
+//   - errors.ErrChapterRevisionUnchanged: Returned if the scraped content is identical to an existing revision.
+//   - CHAPTER_EMPTY, SOURCE_BLOCKED, PARSING_SOURCE, SOURCE_TIMEOUT, SOURCE_DOWN: Returned if the chapter could not be
+//     scraped.
+//   - CREATING_CHAPTER_REVISION: Returned if the revision could not be created.
+//   - UPDATING_CHAPTER_REVISION: Returned if the revision could not be made current.
+func (s *ChapterRevisionService) RescrapeChapter(novelUpdatesID string, chapterNo uint, source string, makeCurrent bool) (*models.ChapterRevision, error) {
+	chapter, err := s.chapterRepo.GetChapterByNovelUpdatesIDAndChapterNo(novelUpdatesID, chapterNo)
+	if err != nil {
+		return nil, err
+	}
+
+	var result models.ImportedChapterMetadata
+	if source != "" {
+		result, err = s.chapterService.ImportChapterFromSource(novelUpdatesID, int(chapterNo), source)
+	} else {
+		result, err = s.chapterService.ImportChapter(novelUpdatesID, int(chapterNo))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.getChapterRevisions(chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, revision := range revisions {
+		if revision.Title == result.Title && revision.Body == result.Body {
+			return nil, errors.ErrChapterRevisionUnchanged
+		}
+	}
+
+	revision, err := s.repo.CreateChapterRevision(chapter, models.ChapterRevision{
+		Title:      result.Title,
+		ChapterUrl: result.ChapterUrl,
+		Body:       result.Body,
+		Source:     result.Source,
+		Origin:     models.RevisionOriginRescrape,
+	})
+	if err != nil || !makeCurrent {
+		return revision, err
+	}
+
+	return s.repo.SetCurrentChapterRevision(chapter, revision.Revision)
+}
+
+// EditChapter stores the title or the body written by a moderator as a new manual revision of a chapter and makes it
+// the content served to readers. The previous content stays available as an older revision.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//   - title (*string): The new title of the chapter, nil to keep the current one.
+//   - body (*string): The new body of the chapter, nil to keep the current one.
+//
+// Returns:
+//   - *models.ChapterRevision: A pointer to the created revision.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+//   - errors.ErrInvalidChapter: Returned if the edited title or body is invalid.
+//   - errors.ErrChapterRevisionUnchanged: Returned if the edit leaves the content of the chapter as it is.
+//   - CREATING_CHAPTER_REVISION: Returned if the revision could not be created.
+//   - UPDATING_CHAPTER_REVISION: Returned if the revision could not be made current.
+func (s *ChapterRevisionService) EditChapter(novelUpdatesID string, chapterNo uint, title, body *string) (*models.ChapterRevision, error) {
+	chapter, err := s.chapterRepo.GetChapterByNovelUpdatesIDAndChapterNo(novelUpdatesID, chapterNo)
+	if err != nil {
+		return nil, err
+	}
+
+	edited := models.ChapterRevision{
+		Title:      chapter.Title,
+		ChapterUrl: chapter.ChapterUrl,
+		Body:       chapter.Body,
+		Source:     models.ManualChapterSource,
+		Origin:     models.RevisionOriginManual,
+	}
+	if title != nil {
+		edited.Title = strings.TrimSpace(*title)
+	}
+	if body != nil {
+		edited.Body = strings.TrimSpace(*body)
+	}
+
+	if !isValidChapterContent(edited.Title, edited.Body) {
+		return nil, errors.ErrInvalidChapter
+	}
+
+	if edited.Title == chapter.Title && edited.Body == chapter.Body {
+		return nil, errors.ErrChapterRevisionUnchanged
+	}
+
+	revision, err := s.repo.CreateChapterRevision(chapter, edited)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.SetCurrentChapterRevision(chapter, revision.Revision)
+}
+
+// GetChapterRevisions retrieves the revisions of a chapter, ordered by revision number. A chapter that was never
+// scraped again has a single current revision holding its content.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//
+// Returns:
+//   - []models.ChapterRevision: The revisions of the chapter.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+//   - GETTING_CHAPTER_REVISIONS: Returned if the revisions could not be retrieved.
+func (s *ChapterRevisionService) GetChapterRevisions(novelUpdatesID string, chapterNo uint) ([]models.ChapterRevision, error) {
+	chapter, err := s.chapterRepo.GetChapterByNovelUpdatesIDAndChapterNo(novelUpdatesID, chapterNo)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getChapterRevisions(chapter)
+}
+
+// SetCurrentChapterRevision makes a revision the content of a chapter served to readers.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//   - revision (int): The number of the revision.
+//
+// Returns:
+//   - *models.ChapterRevision: A pointer to the current revision.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+//   - errors.ErrChapterRevisionNotFound: Returned if the chapter has no such revision.
+//   - UPDATING_CHAPTER_REVISION: Returned if the revision could not be made current.
+func (s *ChapterRevisionService) SetCurrentChapterRevision(novelUpdatesID string, chapterNo uint, revision int) (*models.ChapterRevision, error) {
+	chapter, err := s.chapterRepo.GetChapterByNovelUpdatesIDAndChapterNo(novelUpdatesID, chapterNo)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.getChapterRevisions(chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	// A chapter that was never scraped again only has its content, which is already current
+	if revisions[0].ID == 0 {
+		if revision != 1 {
+			return nil, errors.ErrChapterRevisionNotFound
+		}
+		return &revisions[0], nil
+	}
+
+	return s.repo.SetCurrentChapterRevision(chapter, revision)
+}
+
+// DiffChapterRevisions computes the paragraph level differences between two revisions of a chapter.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//   - from (int): The older revision, the current revision if zero.
+//   - to (int): The newer revision, the latest revision if zero.
+//
+// Returns:
+//   - *dtos.ChapterRevisionDiff: A pointer to the differences between the revisions.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+//   - errors.ErrChapterRevisionNotFound: Returned if the chapter has no such revisions.
+//   - GETTING_CHAPTER_REVISIONS: Returned if the revisions could not be retrieved.
+func (s *ChapterRevisionService) DiffChapterRevisions(novelUpdatesID string, chapterNo uint, from, to int) (*dtos.ChapterRevisionDiff, error) {
+	chapter, err := s.chapterRepo.GetChapterByNovelUpdatesIDAndChapterNo(novelUpdatesID, chapterNo)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.getChapterRevisions(chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromRevision, toRevision *models.ChapterRevision
+	for i := range revisions {
+		if revisions[i].Revision == from || (from == 0 && revisions[i].Current) {
+			fromRevision = &revisions[i]
+		}
+		if revisions[i].Revision == to {
+			toRevision = &revisions[i]
+		}
+	}
+	if to == 0 {
+		toRevision = &revisions[len(revisions)-1]
+	}
+	if fromRevision == nil || toRevision == nil {
+		return nil, errors.ErrChapterRevisionNotFound
+	}
+
+	diff := &dtos.ChapterRevisionDiff{
+		ChapterNo:  chapter.ChapterNo,
+		From:       fromRevision.Revision,
+		To:         toRevision.Revision,
+		Paragraphs: utils.DiffParagraphs(utils.SplitParagraphs(fromRevision.Body), utils.SplitParagraphs(toRevision.Body)),
+	}
+	for _, paragraph := range diff.Paragraphs {
+		switch paragraph.Change {
+		case dtos.ParagraphAdded:
+			diff.Added++
+		case dtos.ParagraphRemoved:
+			diff.Removed++
+		default:
+			diff.Unchanged++
+		}
+	}
+
+	return diff, nil
+}
+
+// getChapterRevisions retrieves the revisions of a chapter, standing in the content of the chapter as its current
+// revision 1 if it was never scraped again.
+//
+// Parameters:
+//   - chapter (*models.Chapter): The chapter.
+//
+// Returns:
+//   - []models.ChapterRevision: The revisions of the chapter, never empty.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+func (s *ChapterRevisionService) getChapterRevisions(chapter *models.Chapter) ([]models.ChapterRevision, error) {
+	revisions, err := s.repo.GetChapterRevisions(chapter.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revisions) == 0 {
+		revisions = append(revisions, models.ChapterRevision{
+			ChapterID:  chapter.ID,
+			Revision:   1,
+			Title:      chapter.Title,
+			ChapterUrl: chapter.ChapterUrl,
+			Body:       chapter.Body,
+			Source:     chapter.Source,
+			Origin:     models.RevisionOriginImport,
+			Current:    true,
+		})
+	}
+	return revisions, nil
+}
@@ -1,31 +1,37 @@
 package services
 
 import (
+	"backend/internal/dtos"
+	internalInterfaces "backend/internal/interfaces"
 	"backend/internal/models"
 	"backend/internal/repositories/interfaces"
-	"backend/internal/types"
 	"backend/internal/types/errors"
 	"backend/internal/utils"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"os"
-	"os/exec"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type ChapterService struct {
-	repo interfaces.ChapterRepositoryInterface
+	repo      interfaces.ChapterRepositoryInterface
+	novelRepo interfaces.NovelRepositoryInterface
+	source    internalInterfaces.Source
 }
 
-func NewChapterService(repo interfaces.ChapterRepositoryInterface) *ChapterService {
-	return &ChapterService{repo: repo}
+func NewChapterService(repo interfaces.ChapterRepositoryInterface, novelRepo interfaces.NovelRepositoryInterface, source internalInterfaces.Source) *ChapterService {
+	return &ChapterService{repo: repo, novelRepo: novelRepo, source: source}
 }
 
 func (s *ChapterService) IsChapterCreated(chapterNo uint, novelID uint) bool {
 	return s.repo.IsChapterCreated(chapterNo, novelID)
 }
 
+func (s *ChapterService) GetChapterNumbers(novelID uint) ([]uint, error) {
+	return s.repo.GetChapterNumbers(novelID)
+}
+
 func (s *ChapterService) CreateChapter(novelID uint, result models.ImportedChapterMetadata) error {
 	importedChapter := models.ImportedChapter{
 		NovelID:    &novelID,
@@ -33,6 +39,7 @@ func (s *ChapterService) CreateChapter(novelID uint, result models.ImportedChapt
 		Title:      result.Title,
 		ChapterUrl: result.ChapterUrl,
 		Body:       result.Body,
+		Source:     result.Source,
 	}
 
 	chapter := importedChapter.ToChapter()
@@ -44,48 +51,384 @@ func (s *ChapterService) CreateChapter(novelID uint, result models.ImportedChapt
 	return nil
 }
 
+// ImportChapter fetches a chapter from the sources, trying first the ones in the source priority of the novel.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (int): The number of the chapter.
+//
+// Returns:
+//   - models.ImportedChapterMetadata: The chapter, with the name of the source and the URL it was fetched from.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if none of the sources has the chapter.
+//   - errors.ErrGettingNovel: Returned if the source priority of the novel could not be retrieved.
+//   - CHAPTER_EMPTY, SOURCE_BLOCKED, PARSING_SOURCE, SOURCE_TIMEOUT, SOURCE_DOWN: Returned if the chapter could not be
+//     fetched.
 func (s *ChapterService) ImportChapter(novelUpdatesID string, chapterNo int) (models.ImportedChapterMetadata, error) {
-	chapterNoStr := strconv.Itoa(chapterNo)
-	cmd := exec.Command(os.Getenv("PYTHON"), "-m", "novel_updates_scraper.client", "import-chapter", novelUpdatesID, chapterNoStr)
-
-	output, err := cmd.Output()
-	if err != nil {
-		return models.ImportedChapterMetadata{}, types.WrapError(errors.SCRIPT_ERROR, "Failed to execute Python script: "+err.Error(), http.StatusServiceUnavailable, err)
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil && err != errors.ErrNovelNotFound {
+		return models.ImportedChapterMetadata{}, err
 	}
 
-	var result struct {
-		Title     string `json:"title"`
-		Body      string `json:"body"`
-		ChapterNo string `json:"chapter_no"`
-		Status    int    `json:"status"`
-		Error     string `json:"error,omitempty"`
+	var names []string
+	if novel != nil && novel.SourcePriority != "" {
+		names = prioritizeSources(s.source, novel.GetSourcePriority())
 	}
 
-	err = json.Unmarshal(output, &result)
+	return s.fetchChapter(novelUpdatesID, chapterNo, names)
+}
+
+// ImportChapterFromSource fetches a chapter from a single source, without falling back to the other ones.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (int): The number of the chapter.
+//   - source (string): The name of the source.
+//
+// Returns:
+//   - models.ImportedChapterMetadata: The chapter, with the name of the source and the URL it was fetched from.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrSourceNotRegistered: Returned if there is no source with that name.
+//   - errors.ErrChapterNotFound: Returned if the source doesn't have the chapter.
+//   - CHAPTER_EMPTY, SOURCE_BLOCKED, PARSING_SOURCE, SOURCE_TIMEOUT, SOURCE_DOWN: Returned if the chapter could not be
+//     fetched.
+func (s *ChapterService) ImportChapterFromSource(novelUpdatesID string, chapterNo int, source string) (models.ImportedChapterMetadata, error) {
+	return s.fetchChapter(novelUpdatesID, chapterNo, []string{source})
+}
+
+// fetchChapter fetches a chapter from the given sources, or from all of them in the default order if there are none,
+// and strips the HTML of its body.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (int): The number of the chapter.
+//   - names ([]string): The names of the sources to try, in order.
+//
+// Returns:
+//   - models.ImportedChapterMetadata: The chapter.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+func (s *ChapterService) fetchChapter(novelUpdatesID string, chapterNo int, names []string) (models.ImportedChapterMetadata, error) {
+	var result *models.ImportedChapterMetadata
+	var err error
+
+	if selector, ok := s.source.(internalInterfaces.SourceSelector); ok && len(names) > 0 {
+		result, err = selector.FetchChapterFrom(names, novelUpdatesID, chapterNo)
+	} else if len(names) > 0 && !slices.Contains(names, s.source.Name()) {
+		err = errors.ErrSourceNotRegistered
+	} else {
+		result, err = s.source.FetchChapter(novelUpdatesID, chapterNo)
+	}
 	if err != nil {
-		return models.ImportedChapterMetadata{}, types.WrapError(errors.IMPORTING_CHAPTER, "Failed to parse Python script output as JSON", http.StatusInternalServerError, err)
+		return models.ImportedChapterMetadata{}, err
 	}
 
-	if result.Status == 404 {
-		return models.ImportedChapterMetadata{}, errors.ErrChapterNotFound
+	body := utils.StripHTML(result.Body)
+	if strings.TrimSpace(body) == "" {
+		return models.ImportedChapterMetadata{}, errors.ErrChapterEmpty
 	}
 
-	if result.Status == 204 {
-		return models.ImportedChapterMetadata{}, errors.ErrChapterNotFound
+	source := result.Source
+	if source == "" {
+		source = s.source.Name()
 	}
 
 	return models.ImportedChapterMetadata{
 		ID:         uint(chapterNo),
 		Title:      result.Title,
-		Body:       utils.StripHTML(result.Body),
-		ChapterUrl: fmt.Sprintf("https://www.lightnovelworld.co/novel/%s/chapter-%d", novelUpdatesID, chapterNo),
+		Body:       body,
+		ChapterUrl: result.ChapterUrl,
+		Source:     source,
 	}, nil
 }
 
-func (s *ChapterService) GetChapterByNovelUpdatesIDAndChapterNo(novelTitle string, chapterNo uint) (*models.Chapter, error) {
-	return s.repo.GetChapterByNovelUpdatesIDAndChapterNo(novelTitle, chapterNo)
+// sourceNames returns the names of the sources a source can fetch chapters from, in default order.
+//
+// Parameters:
+//   - source (internalInterfaces.Source): The source, which may group other sources.
+//
+// Returns:
+//   - []string: The names of the sources.
+func sourceNames(source internalInterfaces.Source) []string {
+	if selector, ok := source.(internalInterfaces.SourceSelector); ok {
+		return selector.Names()
+	}
+	return []string{source.Name()}
+}
+
+// prioritizeSources orders the sources a source can fetch chapters from so the ones in a source priority are tried
+// first, in its order. Sources that are no longer available are skipped.
+//
+// Parameters:
+//   - source (internalInterfaces.Source): The source, which may group other sources.
+//   - priority ([]string): The names of the sources to try first.
+//
+// Returns:
+//   - []string: The names of the sources to try, in order.
+func prioritizeSources(source internalInterfaces.Source, priority []string) []string {
+	available := sourceNames(source)
+	names := make([]string, 0, len(available))
+	for _, name := range priority {
+		if slices.Contains(available, name) && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	for _, name := range available {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// GetChapterByNovelUpdatesIDAndChapterNo retrieves a chapter of a novel with the numbers of the stored chapters before
+// and after it, which skip the missing chapters.
+//
+// Parameters:
+//   - novelTitle (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//
+// Returns:
+//   - *dtos.ChapterResponse: A pointer to the chapter and the numbers of its adjacent chapters.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+//   - GETTING_CHAPTER, GETTING_CHAPTERS: Returned if the chapter or its adjacent chapters could not be retrieved.
+func (s *ChapterService) GetChapterByNovelUpdatesIDAndChapterNo(novelTitle string, chapterNo uint) (*dtos.ChapterResponse, error) {
+	chapter, err := s.repo.GetChapterByNovelUpdatesIDAndChapterNo(novelTitle, chapterNo)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dtos.ChapterResponse{Chapter: *chapter}
+	if chapter.NovelID != nil {
+		response.PreviousChapterNo, response.NextChapterNo, err = s.repo.GetAdjacentChapterNumbers(*chapter.NovelID, chapter.ChapterNo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// GetTableOfContents retrieves a page of the table of contents of a novel, where each page continues after the last
+// chapter of the previous one.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - cursor (string): The cursor returned with the previous page, empty for the first page.
+//   - limit (int): The maximum number of chapters in the page.
+//
+// Returns:
+//   - []dtos.ChapterTOCEntry: The chapters of the page, in ascending order of chapter number.
+//   - string: The cursor of the next page, empty on the last page.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidCursor: Returned if the cursor is not a chapter number.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+func (s *ChapterService) GetTableOfContents(novelUpdatesID string, cursor string, limit int) ([]dtos.ChapterTOCEntry, string, error) {
+	var after uint64
+	if cursor != "" {
+		var err error
+		after, err = strconv.ParseUint(cursor, 10, 32)
+		if err != nil {
+			return nil, "", errors.ErrInvalidCursor
+		}
+	}
+
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One chapter more than the page tells whether there is a next page
+	chapters, err := s.repo.GetChapterListing(novel.ID, uint(after), limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(chapters) > limit {
+		chapters = chapters[:limit]
+		next = strconv.FormatUint(uint64(chapters[limit-1].ChapterNo), 10)
+	}
+
+	entries := make([]dtos.ChapterTOCEntry, len(chapters))
+	for i, chapter := range chapters {
+		entries[i] = dtos.ChapterTOCEntry{
+			ChapterNo: chapter.ChapterNo,
+			Title:     chapter.Title,
+			WordCount: chapter.WordCount,
+			CreatedAt: chapter.CreatedAt,
+			UpdatedAt: chapter.UpdatedAt,
+		}
+	}
+	return entries, next, nil
 }
 
 func (s *ChapterService) GetChaptersByNovelUpdatesID(novelTitle string, page, limit int) ([]models.Chapter, int64, error) {
 	return s.repo.GetChaptersByNovelUpdatesID(novelTitle, page, limit)
 }
+
+// DeleteChapter deletes a chapter of a novel.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrChapterNotFound: Returned if the novel has no such chapter.
+//   - DELETING_CHAPTERS: Returned if the chapter could not be deleted.
+func (s *ChapterService) DeleteChapter(novelUpdatesID string, chapterNo uint) error {
+	deleted, err := s.DeleteChapters(novelUpdatesID, chapterNo, chapterNo)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return errors.ErrChapterNotFound
+	}
+	return nil
+}
+
+// DeleteChapters deletes the chapters of a novel whose numbers are in a range.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - from (uint): The first chapter of the range.
+//   - to (uint): The last chapter of the range.
+//
+// Returns:
+//   - int64: The number of deleted chapters.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidChapterRange: Returned if the range is empty.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - DELETING_CHAPTERS: Returned if the chapters could not be deleted.
+func (s *ChapterService) DeleteChapters(novelUpdatesID string, from, to uint) (int64, error) {
+	if from == 0 || to < from {
+		return 0, errors.ErrInvalidChapterRange
+	}
+
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.repo.DeleteChapters(novel.ID, from, to)
+}
+
+// RenumberChapters moves the chapters of a novel whose numbers are in a range by an offset, such as to make room for a
+// side story the source inserted.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - from (uint): The first chapter of the range.
+//   - to (uint): The last chapter of the range, 0 for the last chapter of the novel.
+//   - offset (int): The number added to the chapter numbers, negative to move the chapters down.
+//
+// Returns:
+//   - int64: The number of renumbered chapters.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidChapterRange: Returned if the range is empty.
+//   - errors.ErrInvalidChapterRenumbering: Returned if the offset is zero or moves a chapter below 1.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrNoChapters: Returned if the range has no chapters.
+//   - errors.ErrChapterRenumberingConflict: Returned if chapters outside the range already have the new numbers.
+//   - GETTING_CHAPTERS, RENUMBERING_CHAPTERS: Returned if the chapters could not be renumbered.
+func (s *ChapterService) RenumberChapters(novelUpdatesID string, from, to uint, offset int) (int64, error) {
+	if from == 0 || (to != 0 && to < from) {
+		return 0, errors.ErrInvalidChapterRange
+	}
+
+	if offset == 0 || int(from)+offset < 1 {
+		return 0, errors.ErrInvalidChapterRenumbering
+	}
+
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return 0, err
+	}
+
+	if to == 0 {
+		numbers, err := s.repo.GetChapterNumbers(novel.ID)
+		if err != nil {
+			return 0, err
+		}
+		if len(numbers) > 0 {
+			to = numbers[len(numbers)-1]
+		}
+	}
+
+	if to < from {
+		return 0, errors.ErrNoChapters
+	}
+
+	renumbered, err := s.repo.RenumberChapters(novel.ID, from, to, offset)
+	if err != nil {
+		return 0, err
+	}
+
+	if renumbered == 0 {
+		return 0, errors.ErrNoChapters
+	}
+	return renumbered, nil
+}
+
+// InsertChapter stores a chapter written by hand, such as one missing from every source, under a manual URL. The
+// latest chapter of the novel is raised if the chapters go past it.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - chapterNo (uint): The number of the chapter.
+//   - title (string): The title of the chapter.
+//   - body (string): The body of the chapter.
+//   - shift (bool): Whether the chapters from the number onwards are moved one number up to make room for the chapter.
+//
+// Returns:
+//   - *models.Chapter: The stored chapter.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidChapter: Returned if the number, the title or the body is invalid.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrChapterConflict: Returned if a chapter has the number and the following chapters are not moved.
+//   - INSERTING_CHAPTER: Returned if the chapter could not be stored.
+func (s *ChapterService) InsertChapter(novelUpdatesID string, chapterNo uint, title, body string, shift bool) (*models.Chapter, error) {
+	title = strings.TrimSpace(title)
+	body = strings.TrimSpace(body)
+	if chapterNo == 0 || !isValidChapterContent(title, body) {
+		return nil, errors.ErrInvalidChapter
+	}
+
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.InsertChapter(models.Chapter{
+		ChapterNo:  chapterNo,
+		NovelID:    &novel.ID,
+		Title:      title,
+		ChapterUrl: models.ManualChapterUrl(novel.NovelUpdatesID, time.Now()),
+		Body:       body,
+		Source:     models.ManualChapterSource,
+	}, shift)
+}
+
+// isValidChapterContent reports whether a chapter written by hand has a title that fits its column and a body.
+func isValidChapterContent(title, body string) bool {
+	return title != "" && utf8.RuneCountInString(title) <= 255 && body != ""
+}
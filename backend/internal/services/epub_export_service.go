@@ -0,0 +1,222 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// epubExportBatchSize is the number of chapters read from the database at a time while writing an EPUB.
+	epubExportBatchSize = 50
+	// maxEPUBCoverSize is the largest cover image added to an exported EPUB.
+	maxEPUBCoverSize = 10 << 20
+)
+
+// EPUBExportService exports the stored chapters of novels as EPUB files.
+//
+// Fields:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to get the novels.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to get the chapters.
+//   - CoverDir (string): The directory the covers of the imported novels are saved in.
+//   - CoverBaseURL (string): The URL the cover directory is served at.
+//   - client (*http.Client): The client used to download the covers hosted elsewhere.
+type EPUBExportService struct {
+	novelRepo    interfaces.NovelRepositoryInterface
+	chapterRepo  interfaces.ChapterRepositoryInterface
+	CoverDir     string
+	CoverBaseURL string
+	client       *http.Client
+}
+
+// NewEPUBExportService creates a new EPUBExportService instance.
+//
+// Parameters:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to get the novels.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to get the chapters.
+//   - coverDir (string): The directory the covers of the imported novels are saved in.
+//   - coverBaseURL (string): The URL the cover directory is served at.
+//
+// Returns:
+//   - *EPUBExportService: A pointer to the newly created EPUBExportService.
+func NewEPUBExportService(novelRepo interfaces.NovelRepositoryInterface, chapterRepo interfaces.ChapterRepositoryInterface, coverDir, coverBaseURL string) *EPUBExportService {
+	return &EPUBExportService{
+		novelRepo:    novelRepo,
+		chapterRepo:  chapterRepo,
+		CoverDir:     coverDir,
+		CoverBaseURL: coverBaseURL,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// PrepareEPUBExport checks that a novel exists and has stored chapters in a range, so the errors can be reported
+// before the EPUB starts being written.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - from (int): The first chapter of the range, 1 if zero or negative.
+//   - to (int): The last chapter of the range, the last stored chapter if zero or negative.
+//
+// Returns:
+//   - *dtos.EPUBExport: The export to be written with WriteEPUB.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidChapterRange: Returned if the range is empty.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrNoChapters: Returned if the novel has no stored chapters in the range.
+//   - GETTING_TOTAL_CHAPTERS: Returned if the chapters could not be counted.
+func (s *EPUBExportService) PrepareEPUBExport(novelID uint, from, to int) (*dtos.EPUBExport, error) {
+	if from <= 0 {
+		from = 1
+	}
+	if to > 0 && from > to {
+		return nil, errors.ErrInvalidChapterRange
+	}
+
+	novel, err := s.novelRepo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &dtos.EPUBExport{Novel: novel, From: uint(from), To: math.MaxInt32, Filename: novel.NovelUpdatesID + ".epub"}
+	if to > 0 {
+		export.To = uint(to)
+		export.Filename = fmt.Sprintf("%s-%d-%d.epub", novel.NovelUpdatesID, from, to)
+	}
+
+	export.Chapters, err = s.chapterRepo.CountChaptersInRange(novel.ID, export.From, export.To)
+	if err != nil {
+		return nil, err
+	}
+	if export.Chapters == 0 {
+		return nil, errors.ErrNoChapters
+	}
+
+	return export, nil
+}
+
+// WriteEPUB writes an EPUB 3 of a prepared export to a stream. The chapters are read from the database a batch at a
+// time and written as they are read, so the novel is never held in memory as a whole. A cover that can't be
+// retrieved is logged and left out.
+//
+// Parameters:
+//   - w (io.Writer): The stream the EPUB is written to.
+//   - export (*dtos.EPUBExport): The export returned by PrepareEPUBExport.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - WRITING_EPUB: Returned if the stream could not be written to.
+//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+func (s *EPUBExportService) WriteEPUB(w io.Writer, export *dtos.EPUBExport) error {
+	novel := export.Novel
+
+	authors := make([]string, 0, len(novel.Authors))
+	for _, author := range novel.Authors {
+		authors = append(authors, author.Name)
+	}
+
+	writer, err := utils.NewEPUBWriter(w, utils.EPUBMetadata{
+		Identifier:  "urn:novel:" + novel.NovelUpdatesID,
+		Title:       novel.Title,
+		Language:    novel.Language,
+		Description: novel.Synopsis,
+		Authors:     authors,
+		Modified:    novel.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	if cover, err := s.getCover(novel); err != nil {
+		log.Println(err)
+	} else if cover != nil {
+		if _, err := writer.AddCover(cover); err != nil {
+			return err
+		}
+	}
+
+	from := export.From
+	for from <= export.To {
+		chapters, err := s.chapterRepo.GetChaptersInRange(novel.ID, from, export.To, epubExportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, chapter := range chapters {
+			title := chapter.Title
+			if title == "" {
+				title = fmt.Sprintf("Chapter %d", chapter.ChapterNo)
+			}
+			if err := writer.AddChapter(title, utils.SplitParagraphs(chapter.Body)); err != nil {
+				return err
+			}
+		}
+
+		if len(chapters) < epubExportBatchSize {
+			break
+		}
+		from = chapters[len(chapters)-1].ChapterNo + 1
+	}
+
+	return writer.Close()
+}
+
+// getCover gets the cover image of a novel, reading the covers saved by the EPUB import from the cover directory and
+// downloading the others.
+//
+// Parameters:
+//   - novel (*models.Novel): The novel.
+//
+// Returns:
+//   - []byte: The content of the cover image, nil if the novel has none.
+//   - error: FETCHING_EPUB_COVER if the cover could not be retrieved.
+func (s *EPUBExportService) getCover(novel *models.Novel) ([]byte, error) {
+	if novel.CoverUrl == "" {
+		return nil, nil
+	}
+
+	if filename, ok := strings.CutPrefix(novel.CoverUrl, s.CoverBaseURL+"/"); ok && s.CoverBaseURL != "" {
+		cover, err := os.ReadFile(filepath.Join(s.CoverDir, path.Base(filename)))
+		if err != nil {
+			return nil, types.WrapError(errors.FETCHING_EPUB_COVER, "Failed to read the cover", http.StatusInternalServerError, err)
+		}
+		return cover, nil
+	}
+
+	if !strings.HasPrefix(novel.CoverUrl, "http://") && !strings.HasPrefix(novel.CoverUrl, "https://") {
+		return nil, nil
+	}
+
+	resp, err := s.client.Get(novel.CoverUrl)
+	if err != nil {
+		return nil, types.WrapError(errors.FETCHING_EPUB_COVER, "Failed to download the cover", http.StatusBadGateway, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, types.WrapError(errors.FETCHING_EPUB_COVER, fmt.Sprintf("Failed to download the cover: %s", resp.Status), http.StatusBadGateway, nil)
+	}
+
+	cover, err := io.ReadAll(io.LimitReader(resp.Body, maxEPUBCoverSize))
+	if err != nil {
+		return nil, types.WrapError(errors.FETCHING_EPUB_COVER, "Failed to download the cover", http.StatusBadGateway, err)
+	}
+	return cover, nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/scrapers"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// EPUBSource is the source recorded in the chapters imported from an EPUB.
+	EPUBSource = "epub"
+	// epubNovelStatus is the status of the novels imported from an EPUB. They hold a whole book and have no source to
+	// be refreshed from, so they are kept out of the scheduled refreshes.
+	epubNovelStatus = "Completed"
+)
+
+// EPUBImportService imports novels that no source covers from uploaded EPUB files.
+//
+// Fields:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to store the novels.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to store the chapters.
+//   - CoverDir (string): The directory the covers of the novels are saved in.
+//   - CoverBaseURL (string): The URL the cover directory is served at.
+type EPUBImportService struct {
+	novelRepo    interfaces.NovelRepositoryInterface
+	chapterRepo  interfaces.ChapterRepositoryInterface
+	CoverDir     string
+	CoverBaseURL string
+}
+
+// NewEPUBImportService creates a new EPUBImportService instance.
+//
+// Parameters:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to store the novels.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to store the chapters.
+//   - coverDir (string): The directory the covers of the novels are saved in.
+//   - coverBaseURL (string): The URL the cover directory is served at.
+//
+// Returns:
+//   - *EPUBImportService: A pointer to the newly created EPUBImportService.
+func NewEPUBImportService(novelRepo interfaces.NovelRepositoryInterface, chapterRepo interfaces.ChapterRepositoryInterface, coverDir, coverBaseURL string) *EPUBImportService {
+	return &EPUBImportService{
+		novelRepo:    novelRepo,
+		chapterRepo:  chapterRepo,
+		CoverDir:     coverDir,
+		CoverBaseURL: coverBaseURL,
+	}
+}
+
+// ImportNovelFromEPUB creates a novel from the metadata of an EPUB and stores each document of its reading order as a
+// chapter, numbered in reading order. Documents that are not chapters, like cover pages, are skipped, and chapters
+// that are already stored are kept, so the same file can be uploaded again to fill in the chapters that failed. A
+// novel imported from its source is never replaced.
+//
+// Parameters:
+//   - data ([]byte): The content of the EPUB file.
+//   - novelUpdatesID (string): The ID of the novel, derived from its title if empty.
+//
+// Returns:
+//   - *dtos.EPUBImportReport: The novel and the result of each document of the reading order.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - INVALID_EPUB: Returned if the file is not a valid EPUB or has no title.
+//   - errors.ErrInvalidNovelUpdatesID: Returned if the ID is invalid or can't be derived from the title.
+//   - errors.ErrEPUBNovelConflict: Returned if a novel imported from its source already has the ID.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrImportingNovel: Returned if the novel could not be stored.
+//   - TAG_ASSOCIATION_ERROR, AUTHOR_ASSOCIATION_ERROR: Returned if the tags or the authors could not be stored.
+func (s *EPUBImportService) ImportNovelFromEPUB(data []byte, novelUpdatesID string) (*dtos.EPUBImportReport, error) {
+	book, err := scrapers.ParseEPUB(data)
+	if err != nil {
+		return nil, err
+	}
+	if book.Title == "" {
+		return nil, types.WrapError(errors.INVALID_EPUB, "EPUB has no title", http.StatusBadRequest, nil)
+	}
+
+	if novelUpdatesID == "" {
+		novelUpdatesID = utils.SlugifyNovelUpdatesID(book.Title)
+	}
+	novelUpdatesID, err = utils.NewNovelUpdatesIDParser().Parse(novelUpdatesID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Storing the novel replaces the one with the same ID, which is only expected from an earlier upload. The novels
+	// imported from their source are the only ones with a NovelUpdates URL
+	existing, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err == nil && existing.NovelUpdatesUrl != "" {
+		return nil, errors.ErrEPUBNovelConflict
+	}
+	if err != nil && err != errors.ErrNovelNotFound {
+		return nil, err
+	}
+
+	latestChapter := 0
+	for _, chapter := range book.Chapters {
+		if chapter.Err == nil {
+			latestChapter++
+		}
+	}
+
+	novel, err := s.novelRepo.CreateNovel(models.Novel{
+		Title:          book.Title,
+		Synopsis:       book.Synopsis,
+		CoverUrl:       s.saveCover(novelUpdatesID, book),
+		Language:       book.Language,
+		Status:         epubNovelStatus,
+		NovelUpdatesID: novelUpdatesID,
+		Tags:           book.Tags,
+		Authors:        book.Authors,
+		Year:           book.Year,
+		LatestChapter:  latestChapter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dtos.EPUBImportReport{Novel: novel, Chapters: make([]dtos.EPUBChapterResult, 0, len(book.Chapters))}
+	chapterNo := 0
+	for _, chapter := range book.Chapters {
+		result := dtos.EPUBChapterResult{Href: chapter.Href, Title: chapter.Title, Status: dtos.EPUBChapterImported}
+
+		if chapter.Err != nil {
+			result.Status, result.Message, result.ErrorCode = dtos.EPUBChapterFailed, chapter.Err.Error(), getErrorCode(chapter.Err)
+			if result.ErrorCode == errors.CHAPTER_EMPTY || result.ErrorCode == errors.EPUB_NOT_CHAPTER {
+				result.Status = dtos.EPUBChapterSkipped
+			}
+		} else {
+			chapterNo++
+			result.ChapterNo = chapterNo
+			if result.Title == "" {
+				result.Title = fmt.Sprintf("Chapter %d", chapterNo)
+			}
+
+			_, err := s.chapterRepo.CreateChapter(models.Chapter{
+				ChapterNo: uint(chapterNo),
+				NovelID:   &novel.ID,
+				Title:     result.Title,
+				Body:      chapter.Body,
+				Source:    EPUBSource,
+			})
+			if err == errors.ErrChapterConflict {
+				result.Status, result.Message, result.ErrorCode = dtos.EPUBChapterSkipped, "Chapter already stored", errors.CHAPTER_CONFLICT
+			} else if err != nil {
+				result.Status, result.Message, result.ErrorCode = dtos.EPUBChapterFailed, err.Error(), getErrorCode(err)
+			}
+		}
+
+		switch result.Status {
+		case dtos.EPUBChapterImported:
+			report.Imported++
+		case dtos.EPUBChapterSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+		report.Chapters = append(report.Chapters, result)
+	}
+
+	return report, nil
+}
+
+// saveCover saves the cover of a book in the cover directory. A cover that can't be saved is logged and left out,
+// the novel is still imported.
+//
+// Parameters:
+//   - novelUpdatesID (string): The ID of the novel, used as the name of the file.
+//   - book (*models.ImportedBook): The book.
+//
+// Returns:
+//   - string: The URL of the saved cover, empty if the book has none or it could not be saved.
+func (s *EPUBImportService) saveCover(novelUpdatesID string, book *models.ImportedBook) string {
+	if book.Cover == nil {
+		return ""
+	}
+
+	filename := novelUpdatesID + book.CoverExtension
+	if err := os.MkdirAll(s.CoverDir, 0755); err != nil {
+		log.Println(types.WrapError(errors.SAVING_EPUB_COVER, "Failed to create the cover directory", http.StatusInternalServerError, err))
+		return ""
+	}
+	if err := os.WriteFile(filepath.Join(s.CoverDir, filename), book.Cover, 0644); err != nil {
+		log.Println(types.WrapError(errors.SAVING_EPUB_COVER, "Failed to save the cover", http.StatusInternalServerError, err))
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s", s.CoverBaseURL, filename)
+}
+
+// getErrorCode returns the code of a custom error, or an empty string for any other error.
+func getErrorCode(err error) string {
+	var customErr *types.MyCustomError
+	if stdErrors.As(err, &customErr) {
+		return customErr.Code
+	}
+	return ""
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// feedSummaryLength is the number of characters of the beginning of a chapter shown in its feed entry.
+const feedSummaryLength = 300
+
+// FeedService builds the Atom feeds of the new chapters users subscribe to from feed readers, and manages the private
+// tokens of the feeds of their libraries.
+//
+// Fields:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to get the novels.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to get the chapters.
+//   - bookmarkRepo (interfaces.BookmarkRepositoryInterface): The repository used to get the libraries of the users.
+//   - feedTokenRepo (interfaces.FeedTokenRepositoryInterface): The repository used to store the feed tokens.
+//   - BaseURL (string): The URL the backend is served at, used in the links to the feeds.
+//   - FrontendURL (string): The URL the website is served at, used in the links to the chapters.
+type FeedService struct {
+	novelRepo     interfaces.NovelRepositoryInterface
+	chapterRepo   interfaces.ChapterRepositoryInterface
+	bookmarkRepo  interfaces.BookmarkRepositoryInterface
+	feedTokenRepo interfaces.FeedTokenRepositoryInterface
+	BaseURL       string
+	FrontendURL   string
+}
+
+// NewFeedService creates a new FeedService instance.
+//
+// Parameters:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to get the novels.
+//   - chapterRepo (interfaces.ChapterRepositoryInterface): The repository used to get the chapters.
+//   - bookmarkRepo (interfaces.BookmarkRepositoryInterface): The repository used to get the libraries of the users.
+//   - feedTokenRepo (interfaces.FeedTokenRepositoryInterface): The repository used to store the feed tokens.
+//   - baseURL (string): The URL the backend is served at.
+//   - frontendURL (string): The URL the website is served at.
+//
+// Returns:
+//   - *FeedService: A pointer to the newly created FeedService.
+func NewFeedService(novelRepo interfaces.NovelRepositoryInterface, chapterRepo interfaces.ChapterRepositoryInterface, bookmarkRepo interfaces.BookmarkRepositoryInterface, feedTokenRepo interfaces.FeedTokenRepositoryInterface, baseURL, frontendURL string) *FeedService {
+	return &FeedService{
+		novelRepo:     novelRepo,
+		chapterRepo:   chapterRepo,
+		bookmarkRepo:  bookmarkRepo,
+		feedTokenRepo: feedTokenRepo,
+		BaseURL:       baseURL,
+		FrontendURL:   frontendURL,
+	}
+}
+
+// GetNovelFeed builds the Atom feed of the most recently stored chapters of a novel.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - limit (int): The maximum number of chapters in the feed.
+//
+// Returns:
+//   - *dtos.AtomFeed: The feed, newest chapters first.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+func (s *FeedService) GetNovelFeed(novelID uint, limit int) (*dtos.AtomFeed, error) {
+	novel, err := s.novelRepo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters, err := s.chapterRepo.GetLatestChapters([]uint{novel.ID}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &dtos.AtomFeed{
+		Xmlns:    "http://www.w3.org/2005/Atom",
+		ID:       fmt.Sprintf("%snovel:%s:chapters", opdsIDPrefix, novel.NovelUpdatesID),
+		Title:    fmt.Sprintf("%s: New Chapters", novel.Title),
+		Subtitle: fmt.Sprintf("The latest chapters of %s", novel.Title),
+		Updated:  feedUpdated(chapters, novel.UpdatedAt),
+		Links: []dtos.AtomLink{
+			{Rel: "self", Href: fmt.Sprintf("%s/novels/%d/feed.atom", s.BaseURL, novel.ID), Type: dtos.AtomFeedType},
+			{Rel: "alternate", Href: fmt.Sprintf("%s/novels/%s", s.FrontendURL, url.PathEscape(novel.NovelUpdatesID)), Type: "text/html"},
+		},
+		Entries: make([]dtos.AtomEntry, 0, len(chapters)),
+	}
+
+	for _, chapter := range chapters {
+		feed.Entries = append(feed.Entries, s.chapterEntry(novel, chapter, chapter.Title))
+	}
+
+	return feed, nil
+}
+
+// GetLibraryFeed builds the Atom feed of the most recently stored chapters of the novels bookmarked by the user a
+// feed token belongs to.
+//
+// Parameters:
+//   - token (string): The feed token of the user.
+//   - limit (int): The maximum number of chapters in the feed.
+//
+// Returns:
+//   - *dtos.AtomFeed: The feed, newest chapters first.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidFeedToken: Returned if the token is empty or belongs to no user.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+//   - GETTING_BOOKMARKS: Returned if the bookmarked novels could not be retrieved.
+//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+func (s *FeedService) GetLibraryFeed(token string, limit int) (*dtos.AtomFeed, error) {
+	if token == "" {
+		return nil, errors.ErrInvalidFeedToken
+	}
+
+	feedToken, err := s.feedTokenRepo.GetFeedTokenByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	novels, err := s.bookmarkRepo.GetAllBookmarkedNovels(feedToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	novelsByID := make(map[uint]*models.Novel, len(novels))
+	novelIDs := make([]uint, 0, len(novels))
+	for i := range novels {
+		novelsByID[novels[i].ID] = &novels[i]
+		novelIDs = append(novelIDs, novels[i].ID)
+	}
+
+	chapters, err := s.chapterRepo.GetLatestChapters(novelIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &dtos.AtomFeed{
+		Xmlns:    "http://www.w3.org/2005/Atom",
+		ID:       fmt.Sprintf("%suser:%d:library", opdsIDPrefix, feedToken.UserID),
+		Title:    "Library: New Chapters",
+		Subtitle: "The latest chapters of the bookmarked novels",
+		Updated:  feedUpdated(chapters, feedToken.UpdatedAt),
+		Links: []dtos.AtomLink{
+			{Rel: "self", Href: s.libraryFeedURL(token), Type: dtos.AtomFeedType},
+		},
+		Entries: make([]dtos.AtomEntry, 0, len(chapters)),
+	}
+
+	for _, chapter := range chapters {
+		novel, ok := novelsByID[*chapter.NovelID]
+		if !ok {
+			continue
+		}
+		feed.Entries = append(feed.Entries, s.chapterEntry(novel, chapter, fmt.Sprintf("%s: %s", novel.Title, chapter.Title)))
+	}
+
+	return feed, nil
+}
+
+// GetFeedToken gets the feed token of a user, creating it the first time.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - *dtos.FeedTokenResponse: The token and the URL of the feed of the library of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+//   - GENERATING_FEED_TOKEN, SAVING_FEED_TOKEN: Returned if the feed token could not be created.
+func (s *FeedService) GetFeedToken(userID uint) (*dtos.FeedTokenResponse, error) {
+	feedToken, err := s.feedTokenRepo.GetFeedTokenByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if feedToken == nil {
+		return s.RegenerateFeedToken(userID)
+	}
+
+	return &dtos.FeedTokenResponse{Token: feedToken.Token, FeedURL: s.libraryFeedURL(feedToken.Token)}, nil
+}
+
+// RegenerateFeedToken replaces the feed token of a user, so the feeds subscribed to with the previous one stop
+// working.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - *dtos.FeedTokenResponse: The new token and the URL of the feed of the library of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GENERATING_FEED_TOKEN, SAVING_FEED_TOKEN: Returned if the feed token could not be created.
+func (s *FeedService) RegenerateFeedToken(userID uint) (*dtos.FeedTokenResponse, error) {
+	token := utils.GenerateVerificationToken()
+	if token == "" {
+		return nil, types.WrapError(errors.GENERATING_FEED_TOKEN, "Failed to generate the feed token", http.StatusInternalServerError, nil)
+	}
+
+	feedToken, err := s.feedTokenRepo.SaveFeedToken(userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.FeedTokenResponse{Token: feedToken.Token, FeedURL: s.libraryFeedURL(feedToken.Token)}, nil
+}
+
+// chapterEntry builds the feed entry of a chapter, linking to the chapter on the website.
+//
+// Parameters:
+//   - novel (*models.Novel): The novel of the chapter.
+//   - chapter (models.Chapter): The chapter.
+//   - title (string): The title of the entry.
+//
+// Returns:
+//   - dtos.AtomEntry: The entry of the chapter.
+func (s *FeedService) chapterEntry(novel *models.Novel, chapter models.Chapter, title string) dtos.AtomEntry {
+	entry := dtos.AtomEntry{
+		Title:     title,
+		ID:        fmt.Sprintf("%snovel:%s:chapter:%d", opdsIDPrefix, novel.NovelUpdatesID, chapter.ChapterNo),
+		Published: chapter.CreatedAt.UTC().Format(time.RFC3339),
+		Updated:   chapter.UpdatedAt.UTC().Format(time.RFC3339),
+		Links: []dtos.AtomLink{{
+			Rel:  "alternate",
+			Href: fmt.Sprintf("%s/novels/%s/%d", s.FrontendURL, url.PathEscape(novel.NovelUpdatesID), chapter.ChapterNo),
+			Type: "text/html",
+		}},
+	}
+
+	for _, author := range novel.Authors {
+		entry.Authors = append(entry.Authors, dtos.AtomAuthor{Name: author.Name})
+	}
+
+	if paragraphs := utils.SplitParagraphs(chapter.Body); len(paragraphs) > 0 {
+		summary := []rune(paragraphs[0])
+		if len(summary) > feedSummaryLength {
+			summary = append(summary[:feedSummaryLength], '…')
+		}
+		entry.Summary = &dtos.AtomText{Type: "text", Text: string(summary)}
+	}
+
+	return entry
+}
+
+// libraryFeedURL builds the URL of the feed of a library.
+func (s *FeedService) libraryFeedURL(token string) string {
+	return fmt.Sprintf("%s/novels/bookmarked/feed.atom?token=%s", s.BaseURL, url.QueryEscape(token))
+}
+
+// feedUpdated returns when a feed was last updated, when its newest chapter was stored or fallback if it has none.
+func feedUpdated(chapters []models.Chapter, fallback time.Time) string {
+	if len(chapters) > 0 {
+		fallback = chapters[0].CreatedAt
+	}
+	return fallback.UTC().Format(time.RFC3339)
+}
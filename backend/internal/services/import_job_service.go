@@ -0,0 +1,797 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	internalInterfaces "backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// importJobWorkerCount is the number of chapters of a job imported concurrently.
+	importJobWorkerCount = 10
+	// maxImportJobChapters is the maximum number of chapters a single job can import.
+	maxImportJobChapters = 20000
+	// importJobSubscriberBuffer is the number of events buffered for each subscriber before events start being dropped.
+	importJobSubscriberBuffer = 256
+	// maxImportChapterAttempts is the number of times a chapter failing with a transient error is tried in one run.
+	maxImportChapterAttempts = 3
+	// importChapterRetryDelay is the delay before the first retry of a chapter, multiplied by the attempt number for the
+	// following ones.
+	importChapterRetryDelay = 500 * time.Millisecond
+)
+
+// chapterImporter is the part of the chapter service used by the import jobs.
+type chapterImporter interface {
+	GetChapterNumbers(novelID uint) ([]uint, error)
+	ImportChapter(novelUpdatesID string, chapterNo int) (models.ImportedChapterMetadata, error)
+	CreateChapter(novelID uint, result models.ImportedChapterMetadata) error
+}
+
+// chapterOutcome is a status change of a chapter reported by a worker to the loop that owns the job.
+type chapterOutcome struct {
+	chapter   *models.ImportJobChapter
+	status    string
+	message   string
+	errorCode string
+	retryable bool
+}
+
+// ImportJobService manages chapter import jobs. Jobs are persisted so they survive the request that started them and
+// server restarts, and are run in the background by the service, which publishes their progress to subscribers.
+type ImportJobService struct {
+	repo           interfaces.ImportJobRepositoryInterface
+	novelRepo      interfaces.NovelRepositoryInterface
+	chapterService chapterImporter
+	sources        internalInterfaces.SourceStatusProvider
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	startMu     sync.Mutex
+	mu          sync.Mutex
+	running     map[uint]context.CancelCauseFunc
+	subscribers map[uint]map[chan dtos.ImportJobEvent]struct{}
+}
+
+// NewImportJobService creates a new ImportJobService instance.
+//
+// Parameters:
+//   - repo (interfaces.ImportJobRepositoryInterface): The repository used to persist the jobs.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to look up the novels.
+//   - chapterService (chapterImporter): The chapter service used to import and save the chapters.
+//   - sources (internalInterfaces.SourceStatusProvider): The status of the sources reported with the jobs, may be nil.
+//
+// Returns:
+//   - *ImportJobService: A pointer to the newly created ImportJobService.
+func NewImportJobService(repo interfaces.ImportJobRepositoryInterface, novelRepo interfaces.NovelRepositoryInterface, chapterService chapterImporter, sources internalInterfaces.SourceStatusProvider) *ImportJobService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ImportJobService{
+		repo:           repo,
+		novelRepo:      novelRepo,
+		chapterService: chapterService,
+		sources:        sources,
+		ctx:            ctx,
+		cancel:         cancel,
+		running:        make(map[uint]context.CancelCauseFunc),
+		subscribers:    make(map[uint]map[chan dtos.ImportJobEvent]struct{}),
+	}
+}
+
+// StartImportJob creates an import job for a range of chapters of a novel and starts running it in the background.
+// A delta job only contains the chapters of the range that are not stored yet, each one marked as a gap or a new
+// chapter.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - from (int): The first chapter to import, 1 if zero or negative.
+//   - to (int): The last chapter to import, the latest chapter of the novel if zero or negative.
+//   - delta (bool): Whether to only import the missing chapters of the range.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the created job.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrImportJobAlreadyRunning: Returned if the novel already has a queued or running job.
+//   - errors.ErrNoChapters: Returned if no range is given and the novel has no chapters.
+//   - errors.ErrInvalidChapterRange: Returned if the range is empty or too large.
+//   - errors.ErrNoMissingChapters: Returned if the job is a delta job and all the chapters of the range are stored.
+//   - GETTING_CHAPTERS: Returned if the stored chapters could not be retrieved.
+//   - CREATING_IMPORT_JOB: Returned if the job could not be created.
+func (s *ImportJobService) StartImportJob(novelUpdatesID string, from, to int, delta bool) (*models.ImportJob, error) {
+	s.startMu.Lock()
+	defer s.startMu.Unlock()
+
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetActiveImportJobByNovelID(novel.ID); err == nil {
+		return nil, errors.ErrImportJobAlreadyRunning
+	} else if err != errors.ErrImportJobNotFound {
+		return nil, err
+	}
+
+	from, to, err = getChapterRange(novel, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var chapters []models.ImportJobChapter
+	if delta {
+		existing, err := s.chapterService.GetChapterNumbers(novel.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		gaps, newChapters := utils.GetMissingChapterRanges(existing, from, to)
+		for _, gap := range gaps {
+			chapters = appendChapterRange(chapters, gap, models.ChapterKindGap)
+		}
+		if newChapters != nil {
+			chapters = appendChapterRange(chapters, *newChapters, models.ChapterKindNew)
+		}
+
+		if len(chapters) == 0 {
+			return nil, errors.ErrNoMissingChapters
+		}
+	} else {
+		chapters = appendChapterRange(make([]models.ImportJobChapter, 0, to-from+1), models.ChapterRange{From: from, To: to}, "")
+	}
+
+	job, err := s.repo.CreateImportJob(models.ImportJob{
+		NovelID:        novel.ID,
+		NovelUpdatesID: novel.NovelUpdatesID,
+		FromChapter:    from,
+		ToChapter:      to,
+		Delta:          delta,
+		State:          models.ImportJobQueued,
+		Chapters:       chapters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.run(job)
+
+	return job, nil
+}
+
+// GetOrStartImportJob returns the active import job of a novel, or starts a job importing all its chapters if it has
+// none.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - delta (bool): Whether a started job only imports the chapters that are not stored yet.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the active or created job, with its chapters.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrNoChapters: Returned if the novel has no chapters.
+//   - errors.ErrNoMissingChapters: Returned if a delta job is requested and all the chapters are stored.
+//   - CREATING_IMPORT_JOB: Returned if the job could not be created.
+//   - GETTING_IMPORT_JOB: Returned if the active job could not be retrieved.
+func (s *ImportJobService) GetOrStartImportJob(novelUpdatesID string, delta bool) (*models.ImportJob, error) {
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.repo.GetActiveImportJobByNovelID(novel.ID)
+	if err == nil {
+		return s.repo.GetImportJobByID(active.ID)
+	}
+	if err != errors.ErrImportJobNotFound {
+		return nil, err
+	}
+
+	job, err := s.StartImportJob(novelUpdatesID, 0, 0, delta)
+	if err == errors.ErrImportJobAlreadyRunning {
+		// Another request started a job in the meantime
+		return s.GetOrStartImportJob(novelUpdatesID, delta)
+	}
+	return job, err
+}
+
+// GetMissingChapters computes the chapters of a range of a novel that are not stored yet, separating the gaps below
+// the latest stored chapter from the new chapters above it.
+//
+// Parameters:
+//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+//   - from (int): The first chapter of the range, 1 if zero or negative.
+//   - to (int): The last chapter of the range, the latest chapter of the novel if zero or negative.
+//
+// Returns:
+//   - *dtos.MissingChaptersResponse: A pointer to the missing chapters of the range.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrNoChapters: Returned if no range is given and the novel has no chapters.
+//   - errors.ErrInvalidChapterRange: Returned if the range is empty or too large.
+//   - GETTING_CHAPTERS: Returned if the stored chapters could not be retrieved.
+func (s *ImportJobService) GetMissingChapters(novelUpdatesID string, from, to int) (*dtos.MissingChaptersResponse, error) {
+	novel, err := s.novelRepo.GetNovelByUpdatesID(novelUpdatesID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err = getChapterRange(novel, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.chapterService.GetChapterNumbers(novel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps, newChapters := utils.GetMissingChapterRanges(existing, from, to)
+
+	response := &dtos.MissingChaptersResponse{
+		Gaps:        make([]models.ChapterRange, 0, len(gaps)),
+		NewChapters: newChapters,
+	}
+	if len(existing) > 0 {
+		response.LatestStoredChapter = int(existing[len(existing)-1])
+	}
+	for _, gap := range gaps {
+		response.Gaps = append(response.Gaps, gap)
+		response.GapCount += gap.Len()
+	}
+	if newChapters != nil {
+		response.NewCount = newChapters.Len()
+	}
+
+	return response, nil
+}
+
+// GetImportJobByID retrieves an import job and the outcome of its chapters, along with the limits and the health of
+// the sources.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the job.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrImportJobNotFound: Returned if the job doesn't exist.
+//   - GETTING_IMPORT_JOB: Returned if the job could not be retrieved.
+func (s *ImportJobService) GetImportJobByID(id uint) (*models.ImportJob, error) {
+	job, err := s.repo.GetImportJobByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.sources != nil {
+		job.Sources = s.sources.SourceStatuses()
+	}
+	return job, nil
+}
+
+// GetImportJobs retrieves a paginated list of import jobs, newest first.
+//
+// Parameters:
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of jobs to return per page.
+//
+// Returns:
+//   - []models.ImportJob: A slice of import jobs, without their chapters.
+//   - int64: The total number of import jobs.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - GETTING_IMPORT_JOBS: Returned if the jobs could not be retrieved.
+func (s *ImportJobService) GetImportJobs(page, limit int) ([]models.ImportJob, int64, error) {
+	return s.repo.GetImportJobs(page, limit)
+}
+
+// CancelImportJob cancels a queued or running import job. Chapters being imported when the job is cancelled are
+// finished before the job stops.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the cancelled job.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrImportJobNotFound: Returned if the job doesn't exist.
+//   - errors.ErrImportJobNotCancellable: Returned if the job is already finished.
+//   - UPDATING_IMPORT_JOB: Returned if the job could not be saved.
+func (s *ImportJobService) CancelImportJob(id uint) (*models.ImportJob, error) {
+	job, err := s.repo.GetImportJobByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.IsFinished() {
+		return nil, errors.ErrImportJobNotCancellable
+	}
+
+	s.mu.Lock()
+	cancel, ok := s.running[id]
+	s.mu.Unlock()
+	if ok {
+		cancel(errors.ErrImportJobCancelled)
+	}
+
+	now := time.Now()
+	job.State = models.ImportJobCancelled
+	job.FinishedAt = &now
+	if err := s.repo.UpdateImportJob(job); err != nil {
+		return nil, err
+	}
+
+	s.publish(dtos.ImportJobEvent{JobID: job.ID, State: job.State})
+
+	return job, nil
+}
+
+// ResumeImportJob runs a finished import job again, importing only the chapters that were not downloaded or skipped.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+//
+// Returns:
+//   - *models.ImportJob: A pointer to the resumed job.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrImportJobNotFound: Returned if the job doesn't exist.
+//   - errors.ErrImportJobNotResumable: Returned if the job is still queued or running.
+//   - errors.ErrImportJobAlreadyRunning: Returned if another job of the novel is queued or running.
+//   - UPDATING_IMPORT_JOB: Returned if the job could not be saved.
+func (s *ImportJobService) ResumeImportJob(id uint) (*models.ImportJob, error) {
+	s.startMu.Lock()
+	defer s.startMu.Unlock()
+
+	job, err := s.repo.GetImportJobByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !job.IsFinished() || s.isRunning(id) {
+		return nil, errors.ErrImportJobNotResumable
+	}
+
+	if _, err := s.repo.GetActiveImportJobByNovelID(job.NovelID); err == nil {
+		return nil, errors.ErrImportJobAlreadyRunning
+	} else if err != errors.ErrImportJobNotFound {
+		return nil, err
+	}
+
+	job.State = models.ImportJobQueued
+	job.Error = ""
+	job.FinishedAt = nil
+	if err := s.repo.UpdateImportJob(job); err != nil {
+		return nil, err
+	}
+
+	s.run(job)
+
+	return job, nil
+}
+
+// ResumeInterruptedImportJobs runs again the jobs that were queued or running when the server stopped.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - GETTING_IMPORT_JOBS: Returned if the jobs could not be retrieved.
+func (s *ImportJobService) ResumeInterruptedImportJobs() error {
+	jobs, err := s.repo.GetImportJobsByState(models.ImportJobQueued, models.ImportJobRunning)
+	if err != nil {
+		return err
+	}
+
+	for i := range jobs {
+		if s.isRunning(jobs[i].ID) {
+			continue
+		}
+		log.Printf("Resuming interrupted import job %d of novel %s", jobs[i].ID, jobs[i].NovelUpdatesID)
+		s.run(&jobs[i])
+	}
+
+	return nil
+}
+
+// Subscribe returns a channel receiving the progress of a job until it stops running, when the channel is closed.
+// The channel is closed right away if the job is not running. Events are dropped if the subscriber falls too far
+// behind, so subscribers should reload the job once the channel is closed.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+//
+// Returns:
+//   - <-chan dtos.ImportJobEvent: The channel receiving the events of the job.
+//   - func(): A function that stops the subscription and closes the channel.
+func (s *ImportJobService) Subscribe(id uint) (<-chan dtos.ImportJobEvent, func()) {
+	events := make(chan dtos.ImportJobEvent, importJobSubscriberBuffer)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.running[id]; !ok {
+		close(events)
+		return events, func() {}
+	}
+
+	if s.subscribers[id] == nil {
+		s.subscribers[id] = make(map[chan dtos.ImportJobEvent]struct{})
+	}
+	s.subscribers[id][events] = struct{}{}
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.subscribers[id][events]; ok {
+			delete(s.subscribers[id], events)
+			close(events)
+		}
+	}
+
+	return events, unsubscribe
+}
+
+// Close stops all the running jobs without changing their state, so they are resumed by
+// ResumeInterruptedImportJobs on the next start, and waits for them to stop.
+func (s *ImportJobService) Close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run starts running a copy of a job in the background, the given job can still be used by the caller.
+//
+// Parameters:
+//   - job (*models.ImportJob): The job to run, with its chapters.
+func (s *ImportJobService) run(job *models.ImportJob) {
+	ctx, cancel := context.WithCancelCause(s.ctx)
+
+	owned := *job
+	owned.Chapters = append([]models.ImportJobChapter(nil), job.Chapters...)
+	job = &owned
+
+	s.mu.Lock()
+	s.running[job.ID] = cancel
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer s.finish(job.ID)
+		defer cancel(nil)
+
+		s.process(ctx, job)
+	}()
+}
+
+// process imports the chapters of a job that are not done yet and saves the outcome of each one. Only this goroutine
+// updates the job and its chapters, the workers report their progress through a channel.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the run, cancelled when the job is cancelled or the service is closed.
+//   - job (*models.ImportJob): The job to run, with its chapters.
+func (s *ImportJobService) process(ctx context.Context, job *models.ImportJob) {
+	now := time.Now()
+	job.State = models.ImportJobRunning
+	job.Error = ""
+	job.StartedAt = &now
+	job.FinishedAt = nil
+	s.saveJob(job)
+
+	// Load the stored chapters once instead of checking each chapter
+	existing, err := s.chapterService.GetChapterNumbers(job.NovelID)
+	if err != nil {
+		job.State = models.ImportJobFailed
+		job.Error = err.Error()
+		now = time.Now()
+		job.FinishedAt = &now
+		s.saveJob(job)
+		return
+	}
+
+	stored := make(map[int]struct{}, len(existing))
+	for _, chapterNo := range existing {
+		stored[int(chapterNo)] = struct{}{}
+	}
+
+	failed, succeeded := 0, 0
+
+	var pending []*models.ImportJobChapter
+	for i := range job.Chapters {
+		chapter := &job.Chapters[i]
+		if models.IsChapterDone(chapter.Status) {
+			continue
+		}
+
+		if _, ok := stored[chapter.ChapterNo]; ok {
+			s.saveChapter(job, chapterOutcome{chapter: chapter, status: models.ChapterSkipped})
+			succeeded++
+			continue
+		}
+		pending = append(pending, chapter)
+	}
+
+	queue := make(chan *models.ImportJobChapter)
+	outcomes := make(chan chapterOutcome)
+
+	var wg sync.WaitGroup
+	for i := 0; i < importJobWorkerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chapter := range queue {
+				s.importChapter(ctx, job, chapter, outcomes)
+			}
+		}()
+	}
+
+	// Populate queue with chapters to process until the job is cancelled
+	go func() {
+		defer close(queue)
+		for _, chapter := range pending {
+			select {
+			case <-ctx.Done():
+				return
+			case queue <- chapter:
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	for outcome := range outcomes {
+		s.saveChapter(job, outcome)
+
+		switch outcome.status {
+		case models.ChapterError:
+			failed++
+		case models.ChapterDownloaded, models.ChapterSkipped:
+			succeeded++
+		}
+	}
+
+	if ctx.Err() != nil {
+		if context.Cause(ctx) != errors.ErrImportJobCancelled {
+			// The server is shutting down, leave the job running so it is resumed on the next start
+			log.Printf("Import job %d interrupted", job.ID)
+			return
+		}
+		job.State = models.ImportJobCancelled
+	} else if failed > 0 && succeeded == 0 {
+		job.State = models.ImportJobFailed
+		job.Error = fmt.Sprintf("All %d chapters failed to import", failed)
+	} else {
+		job.State = models.ImportJobCompleted
+	}
+
+	now = time.Now()
+	job.FinishedAt = &now
+	s.saveJob(job)
+
+	log.Printf("Import job %d %s (%d processed, %d failed)", job.ID, job.State, succeeded, failed)
+}
+
+// importChapter imports a single chapter of a job and reports its progress. Chapters failing with a transient error,
+// like a timeout or an unavailable source, are tried again after a delay, while permanent errors, like a missing
+// chapter or a blocked request, fail the chapter right away.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the run, retries stop when it is cancelled.
+//   - job (*models.ImportJob): The job the chapter belongs to.
+//   - chapter (*models.ImportJobChapter): The chapter to import, it must not be modified by the worker.
+//   - outcomes (chan<- chapterOutcome): The channel the progress is reported to.
+func (s *ImportJobService) importChapter(ctx context.Context, job *models.ImportJob, chapter *models.ImportJobChapter, outcomes chan<- chapterOutcome) {
+	var result models.ImportedChapterMetadata
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		outcomes <- chapterOutcome{chapter: chapter, status: models.ChapterDownloading}
+
+		result, err = s.chapterService.ImportChapter(job.NovelUpdatesID, chapter.ChapterNo)
+		if err == nil {
+			break
+		}
+
+		failure := chapterOutcome{
+			chapter:   chapter,
+			status:    models.ChapterError,
+			message:   err.Error(),
+			errorCode: utils.GetImportErrorCode(err),
+			retryable: utils.IsRetryableImportError(err),
+		}
+		if !failure.retryable || attempt >= maxImportChapterAttempts {
+			outcomes <- failure
+			return
+		}
+
+		failure.status = models.ChapterRetrying
+		outcomes <- failure
+
+		select {
+		case <-ctx.Done():
+			failure.status = models.ChapterError
+			outcomes <- failure
+			return
+		case <-time.After(importChapterRetryDelay * time.Duration(attempt)):
+		}
+	}
+
+	if err := s.chapterService.CreateChapter(job.NovelID, result); err == errors.ErrChapterConflict {
+		// The chapter was stored since the job started
+		outcomes <- chapterOutcome{chapter: chapter, status: models.ChapterSkipped}
+		return
+	} else if err != nil {
+		outcome := chapterOutcome{chapter: chapter, status: models.ChapterError, message: "save error: " + err.Error()}
+		if customErr, ok := err.(*types.MyCustomError); ok {
+			outcome.errorCode = customErr.Code
+		}
+		outcomes <- outcome
+		return
+	}
+
+	outcomes <- chapterOutcome{chapter: chapter, status: models.ChapterDownloaded}
+}
+
+// saveChapter applies a status change to a chapter of a job, saves it and publishes it.
+//
+// Parameters:
+//   - job (*models.ImportJob): The job the chapter belongs to.
+//   - outcome (chapterOutcome): The status change of the chapter.
+func (s *ImportJobService) saveChapter(job *models.ImportJob, outcome chapterOutcome) {
+	chapter := outcome.chapter
+	if outcome.status == models.ChapterDownloading {
+		chapter.Attempts++
+	}
+	chapter.Status = outcome.status
+	chapter.Message = outcome.message
+	chapter.ErrorCode = outcome.errorCode
+	chapter.Retryable = outcome.retryable
+	chapter.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateImportJobChapter(chapter); err != nil {
+		log.Printf("Failed to save chapter %d of import job %d: %v", chapter.ChapterNo, job.ID, err)
+	}
+
+	s.publish(dtos.ImportJobEvent{
+		JobID: job.ID,
+		State: job.State,
+		Chapter: &dtos.ChapterStatus{
+			ChapterNo: chapter.ChapterNo,
+			Status:    chapter.Status,
+			Message:   chapter.Message,
+			ErrorCode: chapter.ErrorCode,
+			Retryable: chapter.Retryable,
+		},
+	})
+}
+
+// saveJob saves the state of a job and publishes it.
+//
+// Parameters:
+//   - job (*models.ImportJob): The job to save.
+func (s *ImportJobService) saveJob(job *models.ImportJob) {
+	if err := s.repo.UpdateImportJob(job); err != nil {
+		log.Printf("Failed to save import job %d: %v", job.ID, err)
+	}
+
+	s.publish(dtos.ImportJobEvent{JobID: job.ID, State: job.State})
+}
+
+// publish sends an event to the subscribers of its job, dropping it for subscribers whose buffer is full.
+//
+// Parameters:
+//   - event (dtos.ImportJobEvent): The event to send.
+func (s *ImportJobService) publish(event dtos.ImportJobEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for subscriber := range s.subscribers[event.JobID] {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+}
+
+// finish forgets a job that stopped running and closes the channels of its subscribers.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+func (s *ImportJobService) finish(id uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.running, id)
+	for subscriber := range s.subscribers[id] {
+		close(subscriber)
+	}
+	delete(s.subscribers, id)
+}
+
+// isRunning reports whether a job is being run by this service.
+//
+// Parameters:
+//   - id (uint): The ID of the job.
+//
+// Returns:
+//   - bool: True if the job is running.
+func (s *ImportJobService) isRunning(id uint) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.running[id]
+	return ok
+}
+
+// getChapterRange applies the defaults of a chapter range of a novel and validates it.
+//
+// Parameters:
+//   - novel (*models.Novel): The novel the range belongs to.
+//   - from (int): The first chapter of the range, 1 if zero or negative.
+//   - to (int): The last chapter of the range, the latest chapter of the novel if zero or negative.
+//
+// Returns:
+//   - int: The first chapter of the range.
+//   - int: The last chapter of the range.
+//   - error: An error object indicating the type of error encountered, or nil if the range is valid.
+//
+// Error types:
+//   - errors.ErrNoChapters: Returned if no end is given and the novel has no chapters.
+//   - errors.ErrInvalidChapterRange: Returned if the range is empty or too large.
+func getChapterRange(novel *models.Novel, from, to int) (int, int, error) {
+	if from <= 0 {
+		from = 1
+	}
+	if to <= 0 {
+		if novel.LatestChapter <= 0 {
+			return 0, 0, errors.ErrNoChapters
+		}
+		to = novel.LatestChapter
+	}
+	if from > to || to-from+1 > maxImportJobChapters {
+		return 0, 0, errors.ErrInvalidChapterRange
+	}
+	return from, to, nil
+}
+
+// appendChapterRange appends the pending entries of a range of chapters to the chapters of a job.
+//
+// Parameters:
+//   - chapters ([]models.ImportJobChapter): The chapters of the job.
+//   - chapterRange (models.ChapterRange): The range to append.
+//   - kind (string): The kind of the chapters of the range, empty if the job is not a delta job.
+//
+// Returns:
+//   - []models.ImportJobChapter: The chapters of the job with the range appended.
+func appendChapterRange(chapters []models.ImportJobChapter, chapterRange models.ChapterRange, kind string) []models.ImportJobChapter {
+	for chapterNo := chapterRange.From; chapterNo <= chapterRange.To; chapterNo++ {
+		chapters = append(chapters, models.ImportJobChapter{ChapterNo: chapterNo, Kind: kind, Status: models.ChapterPending})
+	}
+	return chapters
+}
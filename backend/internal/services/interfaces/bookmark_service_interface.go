@@ -5,7 +5,8 @@ import (
 )
 
 type BookmarkServiceInterface interface {
-	GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.Novel, int64, error)
+	GetBookmarkedNovelsByUserID(userID uint, page, limit int) ([]models.LibraryEntry, int64, error)
+	GetContinueReading(userID uint, statuses []string, limit int) ([]models.LibraryEntry, error)
 	GetBookmarkByUserIDAndNovelID(userID uint, novelID string) (models.BookmarkedNovel, error)
 	UpdateBookmark(novel models.BookmarkedNovel) (models.BookmarkedNovel, error)
 	CreateBookmark(bookmarkedNovel models.BookmarkedNovel) (*models.BookmarkedNovel, error)
@@ -0,0 +1,106 @@
+package interfaces
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+)
+
+// ChapterRevisionServiceInterface defines methods for scraping chapters again and managing the revisions of their
+// content.
+type ChapterRevisionServiceInterface interface {
+	// RescrapeChapter scrapes a chapter again and stores the result as a new revision. The sources are tried in the
+	// priority of the novel unless a source is given, and the content served to readers only changes if the new
+	// revision is made current.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - chapterNo (uint): The number of the chapter.
+	//   - source (string): The name of the only source to scrape the chapter from, empty to try all of them.
+	//   - makeCurrent (bool): Whether the new revision replaces the content served to readers.
+	//
+	// Returns:
+	//   - *models.ChapterRevision: A pointer to the created revision.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrChapterNotFound: Returned if the chapter is not stored or is not found on the sources.
+	//   - errors.ErrSourceNotRegistered: Returned if there is no source with the given name.
+	//   - errors.ErrChapterRevisionUnchanged: Returned if the scraped content is identical to an existing revision.
+	//   - CHAPTER_EMPTY, SOURCE_BLOCKED, PARSING_SOURCE, SOURCE_TIMEOUT, SOURCE_DOWN: Returned if the chapter could not
+	//     be scraped.
+	//   - CREATING_CHAPTER_REVISION: Returned if the revision could not be created.
+	//   - UPDATING_CHAPTER_REVISION: Returned if the revision could not be made current.
+	RescrapeChapter(novelUpdatesID string, chapterNo uint, source string, makeCurrent bool) (*models.ChapterRevision, error)
+
+	// GetChapterRevisions retrieves the revisions of a chapter, ordered by revision number. A chapter that was never
+	// scraped again has a single current revision holding its content.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - chapterNo (uint): The number of the chapter.
+	//
+	// Returns:
+	//   - []models.ChapterRevision: The revisions of the chapter.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+	//   - GETTING_CHAPTER_REVISIONS: Returned if the revisions could not be retrieved.
+	GetChapterRevisions(novelUpdatesID string, chapterNo uint) ([]models.ChapterRevision, error)
+
+	// SetCurrentChapterRevision makes a revision the content of a chapter served to readers.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - chapterNo (uint): The number of the chapter.
+	//   - revision (int): The number of the revision.
+	//
+	// Returns:
+	//   - *models.ChapterRevision: A pointer to the current revision.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+	//   - errors.ErrChapterRevisionNotFound: Returned if the chapter has no such revision.
+	//   - UPDATING_CHAPTER_REVISION: Returned if the revision could not be made current.
+	SetCurrentChapterRevision(novelUpdatesID string, chapterNo uint, revision int) (*models.ChapterRevision, error)
+
+	// DiffChapterRevisions computes the paragraph level differences between two revisions of a chapter.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - chapterNo (uint): The number of the chapter.
+	//   - from (int): The older revision, the current revision if zero.
+	//   - to (int): The newer revision, the latest revision if zero.
+	//
+	// Returns:
+	//   - *dtos.ChapterRevisionDiff: A pointer to the differences between the revisions.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+	//   - errors.ErrChapterRevisionNotFound: Returned if the chapter has no such revisions.
+	//   - GETTING_CHAPTER_REVISIONS: Returned if the revisions could not be retrieved.
+	DiffChapterRevisions(novelUpdatesID string, chapterNo uint, from, to int) (*dtos.ChapterRevisionDiff, error)
+
+	// EditChapter stores the title or the body written by a moderator as a new manual revision of a chapter and makes it
+	// the content served to readers. The previous content stays available as an older revision.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - chapterNo (uint): The number of the chapter.
+	//   - title (*string): The new title of the chapter, nil to keep the current one.
+	//   - body (*string): The new body of the chapter, nil to keep the current one.
+	//
+	// Returns:
+	//   - *models.ChapterRevision: A pointer to the created revision.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrChapterNotFound: Returned if the chapter is not stored.
+	//   - errors.ErrInvalidChapter: Returned if the edited title or body is invalid.
+	//   - errors.ErrChapterRevisionUnchanged: Returned if the edit leaves the content of the chapter as it is.
+	//   - CREATING_CHAPTER_REVISION: Returned if the revision could not be created.
+	//   - UPDATING_CHAPTER_REVISION: Returned if the revision could not be made current.
+	EditChapter(novelUpdatesID string, chapterNo uint, title, body *string) (*models.ChapterRevision, error)
+}
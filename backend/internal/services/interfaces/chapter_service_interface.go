@@ -1,13 +1,21 @@
 package interfaces
 
 import (
+	"backend/internal/dtos"
 	"backend/internal/models"
 )
 
 type ChapterServiceInterface interface {
 	IsChapterCreated(chapterNo uint, novelID uint) bool
+	GetChapterNumbers(novelID uint) ([]uint, error)
 	CreateChapter(novelID uint, result models.ImportedChapterMetadata) error
 	ImportChapter(novelUpdatesID string, chapterNo int) (models.ImportedChapterMetadata, error)
-	GetChapterByNovelUpdatesIDAndChapterNo(novelTitle string, chapterNo uint) (*models.Chapter, error)
+	ImportChapterFromSource(novelUpdatesID string, chapterNo int, source string) (models.ImportedChapterMetadata, error)
+	GetChapterByNovelUpdatesIDAndChapterNo(novelTitle string, chapterNo uint) (*dtos.ChapterResponse, error)
 	GetChaptersByNovelUpdatesID(novelTitle string, page, limit int) ([]models.Chapter, int64, error)
+	DeleteChapter(novelUpdatesID string, chapterNo uint) error
+	DeleteChapters(novelUpdatesID string, from, to uint) (int64, error)
+	RenumberChapters(novelUpdatesID string, from, to uint, offset int) (int64, error)
+	InsertChapter(novelUpdatesID string, chapterNo uint, title, body string, shift bool) (*models.Chapter, error)
+	GetTableOfContents(novelUpdatesID string, cursor string, limit int) ([]dtos.ChapterTOCEntry, string, error)
 }
\ No newline at end of file
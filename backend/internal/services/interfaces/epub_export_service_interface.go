@@ -0,0 +1,43 @@
+package interfaces
+
+import (
+	"backend/internal/dtos"
+	"io"
+)
+
+// EPUBExportServiceInterface defines methods for exporting the stored chapters of novels as EPUB files.
+type EPUBExportServiceInterface interface {
+	// PrepareEPUBExport checks that a novel exists and has stored chapters in a range, so the errors can be reported
+	// before the EPUB starts being written.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - from (int): The first chapter of the range, 1 if zero or negative.
+	//   - to (int): The last chapter of the range, the last stored chapter if zero or negative.
+	//
+	// Returns:
+	//   - *dtos.EPUBExport: The export to be written with WriteEPUB.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidChapterRange: Returned if the range is empty.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - errors.ErrNoChapters: Returned if the novel has no stored chapters in the range.
+	//   - GETTING_TOTAL_CHAPTERS: Returned if the chapters could not be counted.
+	PrepareEPUBExport(novelID uint, from, to int) (*dtos.EPUBExport, error)
+
+	// WriteEPUB writes an EPUB 3 of a prepared export to a stream, reading the chapters a batch at a time.
+	//
+	// Parameters:
+	//   - w (io.Writer): The stream the EPUB is written to.
+	//   - export (*dtos.EPUBExport): The export returned by PrepareEPUBExport.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - WRITING_EPUB: Returned if the stream could not be written to.
+	//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+	WriteEPUB(w io.Writer, export *dtos.EPUBExport) error
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import "backend/internal/dtos"
+
+// EPUBImportServiceInterface defines methods for importing novels from uploaded EPUB files.
+type EPUBImportServiceInterface interface {
+	// ImportNovelFromEPUB creates a novel from the metadata of an EPUB and stores each document of its reading order as
+	// a chapter, numbered in reading order. Documents that are not chapters, like cover pages, are skipped, and
+	// chapters that are already stored are kept, so the same file can be uploaded again to fill in the chapters that
+	// failed.
+	//
+	// Parameters:
+	//   - data ([]byte): The content of the EPUB file.
+	//   - novelUpdatesID (string): The ID of the novel, derived from its title if empty.
+	//
+	// Returns:
+	//   - *dtos.EPUBImportReport: The novel and the result of each document of the reading order.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - INVALID_EPUB: Returned if the file is not a valid EPUB or has no title.
+	//   - errors.ErrInvalidNovelUpdatesID: Returned if the ID is invalid or can't be derived from the title.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrImportingNovel: Returned if the novel could not be stored.
+	//   - TAG_ASSOCIATION_ERROR, AUTHOR_ASSOCIATION_ERROR: Returned if the tags or the authors could not be stored.
+	ImportNovelFromEPUB(data []byte, novelUpdatesID string) (*dtos.EPUBImportReport, error)
+}
@@ -0,0 +1,72 @@
+package interfaces
+
+import "backend/internal/dtos"
+
+// FeedServiceInterface defines methods for building the Atom feeds of new chapters and managing the private tokens of
+// the feeds of the libraries of the users.
+type FeedServiceInterface interface {
+	// GetNovelFeed builds the Atom feed of the most recently stored chapters of a novel.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - limit (int): The maximum number of chapters in the feed.
+	//
+	// Returns:
+	//   - *dtos.AtomFeed: The feed, newest chapters first.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+	GetNovelFeed(novelID uint, limit int) (*dtos.AtomFeed, error)
+
+	// GetLibraryFeed builds the Atom feed of the most recently stored chapters of the novels bookmarked by the user a
+	// feed token belongs to.
+	//
+	// Parameters:
+	//   - token (string): The feed token of the user.
+	//   - limit (int): The maximum number of chapters in the feed.
+	//
+	// Returns:
+	//   - *dtos.AtomFeed: The feed, newest chapters first.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidFeedToken: Returned if the token is empty or belongs to no user.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+	//   - GETTING_BOOKMARKS: Returned if the bookmarked novels could not be retrieved.
+	//   - GETTING_CHAPTERS: Returned if the chapters could not be retrieved.
+	GetLibraryFeed(token string, limit int) (*dtos.AtomFeed, error)
+
+	// GetFeedToken gets the feed token of a user, creating it the first time.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - *dtos.FeedTokenResponse: The token and the URL of the feed of the library of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_FEED_TOKEN: Returned if the feed token could not be retrieved.
+	//   - GENERATING_FEED_TOKEN, SAVING_FEED_TOKEN: Returned if the feed token could not be created.
+	GetFeedToken(userID uint) (*dtos.FeedTokenResponse, error)
+
+	// RegenerateFeedToken replaces the feed token of a user, so the feeds subscribed to with the previous one stop
+	// working.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - *dtos.FeedTokenResponse: The new token and the URL of the feed of the library of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GENERATING_FEED_TOKEN, SAVING_FEED_TOKEN: Returned if the feed token could not be created.
+	RegenerateFeedToken(userID uint) (*dtos.FeedTokenResponse, error)
+}
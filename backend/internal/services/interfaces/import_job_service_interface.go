@@ -0,0 +1,143 @@
+package interfaces
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+)
+
+// ImportJobServiceInterface defines methods for starting, inspecting and controlling background chapter import jobs.
+type ImportJobServiceInterface interface {
+	// StartImportJob creates an import job for a range of chapters of a novel and starts running it in the background.
+	// A delta job only contains the chapters of the range that are not stored yet, each one marked as a gap or a new
+	// chapter.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - from (int): The first chapter to import, 1 if zero or negative.
+	//   - to (int): The last chapter to import, the latest chapter of the novel if zero or negative.
+	//   - delta (bool): Whether to only import the missing chapters of the range.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the created job.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - errors.ErrImportJobAlreadyRunning: Returned if the novel already has a queued or running job.
+	//   - errors.ErrNoChapters: Returned if no range is given and the novel has no chapters.
+	//   - errors.ErrInvalidChapterRange: Returned if the range is empty or too large.
+	//   - errors.ErrNoMissingChapters: Returned if the job is a delta job and all the chapters of the range are stored.
+	//   - GETTING_CHAPTERS: Returned if the stored chapters could not be retrieved.
+	//   - CREATING_IMPORT_JOB: Returned if the job could not be created.
+	StartImportJob(novelUpdatesID string, from, to int, delta bool) (*models.ImportJob, error)
+
+	// GetOrStartImportJob returns the active import job of a novel, or starts a job importing all its chapters if it
+	// has none.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - delta (bool): Whether a started job only imports the chapters that are not stored yet.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the active or created job, with its chapters.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - errors.ErrNoChapters: Returned if the novel has no chapters.
+	//   - errors.ErrNoMissingChapters: Returned if a delta job is requested and all the chapters are stored.
+	//   - CREATING_IMPORT_JOB: Returned if the job could not be created.
+	//   - GETTING_IMPORT_JOB: Returned if the active job could not be retrieved.
+	GetOrStartImportJob(novelUpdatesID string, delta bool) (*models.ImportJob, error)
+
+	// GetMissingChapters computes the chapters of a range of a novel that are not stored yet, separating the gaps
+	// below the latest stored chapter from the new chapters above it.
+	//
+	// Parameters:
+	//   - novelUpdatesID (string): The NovelUpdates ID of the novel.
+	//   - from (int): The first chapter of the range, 1 if zero or negative.
+	//   - to (int): The last chapter of the range, the latest chapter of the novel if zero or negative.
+	//
+	// Returns:
+	//   - *dtos.MissingChaptersResponse: A pointer to the missing chapters of the range.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - errors.ErrNoChapters: Returned if no range is given and the novel has no chapters.
+	//   - errors.ErrInvalidChapterRange: Returned if the range is empty or too large.
+	//   - GETTING_CHAPTERS: Returned if the stored chapters could not be retrieved.
+	GetMissingChapters(novelUpdatesID string, from, to int) (*dtos.MissingChaptersResponse, error)
+
+	// GetImportJobByID retrieves an import job and the outcome of its chapters, along with the limits and the health
+	// of the sources.
+	//
+	// Parameters:
+	//   - id (uint): The ID of the job.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the job.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrImportJobNotFound: Returned if the job doesn't exist.
+	//   - GETTING_IMPORT_JOB: Returned if the job could not be retrieved.
+	GetImportJobByID(id uint) (*models.ImportJob, error)
+
+	// GetImportJobs retrieves a paginated list of import jobs, newest first.
+	//
+	// Parameters:
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of jobs to return per page.
+	//
+	// Returns:
+	//   - []models.ImportJob: A slice of import jobs, without their chapters.
+	//   - int64: The total number of import jobs.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - GETTING_IMPORT_JOBS: Returned if the jobs could not be retrieved.
+	GetImportJobs(page, limit int) ([]models.ImportJob, int64, error)
+
+	// CancelImportJob cancels a queued or running import job.
+	//
+	// Parameters:
+	//   - id (uint): The ID of the job.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the cancelled job.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrImportJobNotFound: Returned if the job doesn't exist.
+	//   - errors.ErrImportJobNotCancellable: Returned if the job is already finished.
+	//   - UPDATING_IMPORT_JOB: Returned if the job could not be saved.
+	CancelImportJob(id uint) (*models.ImportJob, error)
+
+	// ResumeImportJob runs a finished import job again, importing only the chapters that were not downloaded or
+	// skipped.
+	//
+	// Parameters:
+	//   - id (uint): The ID of the job.
+	//
+	// Returns:
+	//   - *models.ImportJob: A pointer to the resumed job.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrImportJobNotFound: Returned if the job doesn't exist.
+	//   - errors.ErrImportJobNotResumable: Returned if the job is still queued or running.
+	//   - errors.ErrImportJobAlreadyRunning: Returned if another job of the novel is queued or running.
+	//   - UPDATING_IMPORT_JOB: Returned if the job could not be saved.
+	ResumeImportJob(id uint) (*models.ImportJob, error)
+
+	// Subscribe returns a channel receiving the progress of a job until it stops running, when the channel is closed.
+	//
+	// Parameters:
+	//   - id (uint): The ID of the job.
+	//
+	// Returns:
+	//   - <-chan dtos.ImportJobEvent: The channel receiving the events of the job.
+	//   - func(): A function that stops the subscription and closes the channel.
+	Subscribe(id uint) (<-chan dtos.ImportJobEvent, func())
+}
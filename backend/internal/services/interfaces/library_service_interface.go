@@ -0,0 +1,39 @@
+package interfaces
+
+import "backend/internal/dtos"
+
+// LibraryServiceInterface defines methods for exporting the libraries of the users and importing them back.
+type LibraryServiceInterface interface {
+	// ExportLibrary retrieves the whole library of a user, in the order the novels were bookmarked.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - []dtos.LibraryRow: The bookmarked novels of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - GETTING_BOOKMARKS: Returned if the bookmarks could not be retrieved.
+	ExportLibrary(userID uint) ([]dtos.LibraryRow, error)
+
+	// ImportLibrary bookmarks the novels of a library for a user. Each row is matched by its NovelUpdates ID, which may be
+	// the URL of the page of the novel, or else by its title. Novels already bookmarked have their bookmark updated, and
+	// the statuses move on with the progress as updating the bookmarks does. A row that fails doesn't stop the others.
+	// At most 20 unknown novels are imported, the rows of the others fail so the library can be imported again.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - rows ([]dtos.LibraryRow): The bookmarked novels of the library.
+	//   - createNovels (bool): Whether the novels that are unknown are imported from NovelUpdates, which the callers only
+	//     allow to the users allowed to create novels.
+	//
+	// Returns:
+	//   - *dtos.LibraryImportReport: A pointer to what importing each row did.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidLibraryFile: Returned if the library has no novels.
+	//   - errors.ErrLibraryImportTooLarge: Returned if the library has too many novels.
+	ImportLibrary(userID uint, rows []dtos.LibraryRow, createNovels bool) (*dtos.LibraryImportReport, error)
+}
@@ -1,6 +1,7 @@
 package interfaces
 
 import (
+	"backend/internal/dtos"
 	"backend/internal/models"
 )
 
@@ -102,6 +103,70 @@ type NovelServiceInterface interface {
 	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
 	GetNovels(page, limit int) ([]models.Novel, int64, error)
 
+	// GetNovelsByQuery retrieves a paginated list of the novels matching the filters of a query, in its order.
+	//
+	// Parameters:
+	//   - query (models.NovelQuery): The filters and the order of the novels.
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of Novel structs representing the novels on the specified page.
+	//   - int64: The total number of novels matching the query.
+	//   - error: An error object; returns nil if no error occurs.
+	//
+	// Error types:
+	//
+	// Validation errors:
+	//   - INVALID_TAG, INVALID_GENRE, INVALID_AUTHOR: Returned if the name of a tag, genre or author is invalid.
+	//   - INVALID_NOVEL_QUERY: Returned if the match mode, the sort, the year range or the minimum number of chapters is
+	//     invalid.
+	//
+	// Repository errors:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNoNovels: Returned if no novels are found.
+	//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+	GetNovelsByQuery(query models.NovelQuery, page, limit int) ([]models.Novel, int64, error)
+
+	// GetNovelsPage retrieves a page of the novels matching the filters of a query, in its order, continuing the listing
+	// after the novel of a cursor.
+	//
+	// Parameters:
+	//   - query (models.NovelQuery): The filters and the order of the novels. When continuing a listing, the order can be
+	//     left empty to keep the one of the cursor.
+	//   - cursor (string): The cursor returned with the previous page, or an empty string for the first page.
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of Novel structs representing the novels of the page.
+	//   - string: The cursor of the next page, or an empty string if it is the last page.
+	//   - error: An error object; returns nil if no error occurs.
+	//
+	// Error types:
+	//
+	// Validation errors:
+	//   - INVALID_TAG, INVALID_GENRE, INVALID_AUTHOR: Returned if the name of a tag, genre or author is invalid.
+	//   - INVALID_NOVEL_QUERY: Returned if the match mode, the sort, the year range or the minimum number of chapters is
+	//     invalid.
+	//   - errors.ErrInvalidCursor: Returned if the cursor is invalid or was returned for another order.
+	//
+	// Repository errors:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels.
+	GetNovelsPage(query models.NovelQuery, cursor string, limit int) ([]models.Novel, string, error)
+
+	// GetAllNovels retrieves every novel, a page at a time so that a large library isn't loaded in a single query.
+	//
+	// Returns:
+	//   - []models.Novel: A slice of all the novels, in the order they were added.
+	//   - error: An error object; returns nil if no error occurs.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels.
+	GetAllNovels() ([]models.Novel, error)
+
 	// GetNovelByID retrieves a novel from the repository based on its ID.
 	//
 	// Parameters:
@@ -167,4 +232,76 @@ type NovelServiceInterface interface {
 	//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
 	//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
 	CreateNovel(novelUpdatesID string) (*models.Novel, error)
+
+	// GetNovelSourcePriority retrieves the source priority of a novel and the order its chapters are fetched in.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - *dtos.SourcePriorityResponse: The source priority of the novel.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+	//   - errors.ErrGettingNovel: Returned if there's a general error retrieving the novel.
+	GetNovelSourcePriority(novelID uint) (*dtos.SourcePriorityResponse, error)
+
+	// UpdateNovelSourcePriority changes the sources the chapters of a novel are fetched from first. The other sources
+	// are still tried afterwards, in default order.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - sources ([]string): The names of the sources to try first, in order. Empty to use the default order.
+	//
+	// Returns:
+	//   - *dtos.SourcePriorityResponse: The new source priority of the novel.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidSourcePriority: Returned if a source is not registered or is listed more than once.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+	//   - errors.ErrGettingNovel: Returned if there's a general error retrieving the novel.
+	//   - UPDATING_SOURCE_PRIORITY: Returned if the source priority could not be updated.
+	UpdateNovelSourcePriority(novelID uint, sources []string) (*dtos.SourcePriorityResponse, error)
+
+	// UpdateNovel edits the metadata of a novel. The edited fields are locked so the imports of the novel don't overwrite
+	// them, unless the request sets the locked fields itself.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - request (dtos.NovelUpdateRequest): The edited fields and, optionally, the fields to lock.
+	//   - replace (bool): Whether every editable field is replaced, in which case they are all required.
+	//
+	// Returns:
+	//   - *models.Novel: The updated novel.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrIncompleteNovelUpdate: Returned if a field is missing when replacing the novel.
+	//   - errors.ErrNovelTitleRequired, errors.ErrNovelFieldTooLong: Returned if a field is invalid.
+	//   - errors.ErrTagRequired, errors.ErrGenreRequired, errors.ErrAuthorRequired and the matching too long errors:
+	//     Returned if the name of a tag, genre or author is invalid.
+	//   - errors.ErrInvalidLockedField: Returned if a locked field cannot be locked.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+	//   - errors.ErrNovelTitleTaken: Returned if another novel has the new title.
+	//   - UPDATING_NOVEL: Returned if the novel could not be updated.
+	UpdateNovel(novelID uint, request dtos.NovelUpdateRequest, replace bool) (*models.Novel, error)
+
+	// DeleteNovel deletes a novel. It's hidden with its chapters until it's imported again.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+	//   - DELETING_NOVEL: Returned if the novel could not be deleted.
+	DeleteNovel(novelID uint) error
 }
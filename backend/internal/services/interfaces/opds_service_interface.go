@@ -0,0 +1,156 @@
+package interfaces
+
+import "backend/internal/dtos"
+
+// OPDSServiceInterface defines methods for building the OPDS 1.2 catalog e-reader apps browse the library with.
+type OPDSServiceInterface interface {
+	// GetRootFeed builds the navigation feed the catalog starts at, linking to all the novels and to the genres, tags and
+	// authors.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The root feed.
+	GetRootFeed() *dtos.OPDSFeed
+
+	// GetNovelsFeed builds the acquisition feed of all the novels.
+	//
+	// Parameters:
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+	GetNovelsFeed(page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetGenresFeed builds the navigation feed of the genres, linking to the novels of each.
+	//
+	// Parameters:
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of genres per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_GENRES: Returned if the genres could not be retrieved.
+	GetGenresFeed(page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetTagsFeed builds the navigation feed of the tags, linking to the novels of each.
+	//
+	// Parameters:
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of tags per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_TAGS: Returned if the tags could not be retrieved.
+	GetTagsFeed(page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetAuthorsFeed builds the navigation feed of the authors, linking to the novels of each.
+	//
+	// Parameters:
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of authors per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_AUTHORS: Returned if the authors could not be retrieved.
+	GetAuthorsFeed(page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetGenreFeed builds the acquisition feed of the novels of a genre.
+	//
+	// Parameters:
+	//   - genreName (string): The name of the genre.
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrGenreRequired, errors.ErrGenreTooShort, errors.ErrGenreTooLong: Returned if the genre name is invalid.
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+	GetGenreFeed(genreName string, page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetTagFeed builds the acquisition feed of the novels of a tag.
+	//
+	// Parameters:
+	//   - tagName (string): The name of the tag.
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrTagRequired, errors.ErrTagTooShort, errors.ErrTagTooLong: Returned if the tag name is invalid.
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+	GetTagFeed(tagName string, page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetAuthorFeed builds the acquisition feed of the novels of an author.
+	//
+	// Parameters:
+	//   - authorName (string): The name of the author.
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrAuthorRequired, errors.ErrAuthorTooShort, errors.ErrAuthorTooLong: Returned if the author name is
+	//     invalid.
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+	GetAuthorFeed(authorName string, page, limit int) (*dtos.OPDSFeed, error)
+
+	// SearchFeed builds the acquisition feed of the novels whose title contains a query.
+	//
+	// Parameters:
+	//   - query (string): The text to search for in the titles.
+	//   - page (int): The page number (starting from 1).
+	//   - limit (int): The number of novels per page.
+	//
+	// Returns:
+	//   - *dtos.OPDSFeed: The page of the feed.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrSearchQueryRequired: Returned if the query is empty.
+	//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+	SearchFeed(query string, page, limit int) (*dtos.OPDSFeed, error)
+
+	// GetOpenSearchDescription builds the OpenSearch description telling the clients how to search the catalog.
+	//
+	// Returns:
+	//   - *dtos.OpenSearchDescription: The OpenSearch description.
+	GetOpenSearchDescription() *dtos.OpenSearchDescription
+}
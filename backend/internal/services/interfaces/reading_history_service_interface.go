@@ -0,0 +1,44 @@
+package interfaces
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+)
+
+// ReadingHistoryServiceInterface defines methods for recording what the users read and computing their reading
+// statistics from it.
+type ReadingHistoryServiceInterface interface {
+	// RecordChapterRead adds a chapter fetched by a user to their reading history. Moving on to a later chapter of the
+	// same novel finishes the chapter fetched before, with the time between both as the time spent reading it, up to 30
+	// minutes. Fetching the same chapter again within 30 minutes is not recorded.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - chapter (*models.Chapter): The chapter fetched.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_EVENTS, SAVING_READING_EVENT: Returned if the events could not be recorded.
+	RecordChapterRead(userID uint, chapter *models.Chapter) error
+
+	// GetReadingStats computes the reading statistics of a user over the last days, counting the days in a timezone.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - days (int): The number of days of the period, today included.
+	//   - timezone (string): The IANA timezone the days are counted in, UTC if empty.
+	//
+	// Returns:
+	//   - *dtos.ReadingStats: A pointer to the reading statistics.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidReadingStatsPeriod: Returned if the number of days is out of range.
+	//   - errors.ErrInvalidReadingStatsTimezone: Returned if the timezone is unknown.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_EVENTS: Returned if the reading history could not be retrieved.
+	GetReadingStats(userID uint, days int, timezone string) (*dtos.ReadingStats, error)
+}
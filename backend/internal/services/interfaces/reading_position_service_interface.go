@@ -0,0 +1,61 @@
+package interfaces
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+)
+
+// ReadingPositionServiceInterface defines methods for syncing where the users are reading their novels across their
+// devices.
+type ReadingPositionServiceInterface interface {
+	// SyncReadingPosition stores the reading position of a user in a novel sent from a device. The position read last
+	// wins: a position reached before the stored one is not kept, and both outcomes return the losing position. A time
+	// in the future, from a device whose clock is ahead, counts as now so it can't keep winning.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - novelID (uint): The ID of the novel.
+	//   - request (dtos.ReadingPositionRequest): The reading position sent by the device.
+	//
+	// Returns:
+	//   - *dtos.ReadingPositionSyncResponse: A pointer to the position kept and the losing position.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidReadingPosition: Returned if the position is invalid.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - GETTING_READING_POSITION, SAVING_READING_POSITION: Returned if the position could not be stored.
+	SyncReadingPosition(userID, novelID uint, request dtos.ReadingPositionRequest) (*dtos.ReadingPositionSyncResponse, error)
+
+	// GetReadingPosition retrieves the latest reading position of a user in a novel.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - *models.ReadingPosition: A pointer to the reading position.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrReadingPositionNotFound: Returned if the user never synced the novel.
+	//   - GETTING_READING_POSITION: Returned if the reading position could not be retrieved.
+	GetReadingPosition(userID, novelID uint) (*models.ReadingPosition, error)
+
+	// GetReadingPositions retrieves the latest reading positions of a user in all the novels they synced, most recently
+	// read first.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - []models.ReadingPosition: The reading positions of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_READING_POSITION: Returned if the reading positions could not be retrieved.
+	GetReadingPositions(userID uint) ([]models.ReadingPosition, error)
+}
@@ -0,0 +1,28 @@
+package interfaces
+
+import "backend/internal/dtos"
+
+// RefreshSchedulerServiceInterface defines methods for inspecting and running the scheduled refresh of the novels.
+type RefreshSchedulerServiceInterface interface {
+	// GetStatus returns the status of the scheduler and of each of its schedules.
+	//
+	// Returns:
+	//   - dtos.RefreshSchedulerStatus: The status of the scheduler.
+	GetStatus() dtos.RefreshSchedulerStatus
+
+	// RunSchedule refreshes the novels of a schedule right away and queues the import of their new chapters. Only one
+	// schedule runs at a time.
+	//
+	// Parameters:
+	//   - name (string): The name of the schedule.
+	//
+	// Returns:
+	//   - *dtos.RefreshRun: A pointer to the outcome of the run.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrRefreshScheduleNotFound: Returned if no schedule has the given name.
+	//   - errors.ErrRefreshAlreadyRunning: Returned if a schedule is already running.
+	//   - errors.ErrGettingNovels: Returned if the novels to refresh could not be retrieved.
+	RunSchedule(name string) (*dtos.RefreshRun, error)
+}
@@ -0,0 +1,44 @@
+package interfaces
+
+import "backend/internal/models"
+
+// SearchServiceInterface defines methods for the full-text search of the novels and of the chapters of a novel.
+type SearchServiceInterface interface {
+	// SearchNovels searches the titles, synopses and authors of the novels, most relevant first.
+	//
+	// Parameters:
+	//   - query (string): The search query. Every word must match a word, or the beginning of one.
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of novels to return per page.
+	//
+	// Returns:
+	//   - []models.NovelSearchResult: A slice of the matching novels with highlighted snippets.
+	//   - int64: The total number of matching novels.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrSearchQueryRequired: Returned if the query has no words.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - SEARCHING_NOVELS: Returned if an error occurs while searching the novels.
+	SearchNovels(query string, page, limit int) ([]models.NovelSearchResult, int64, error)
+
+	// SearchChapters searches the text of the chapters of a novel, most relevant first.
+	//
+	// Parameters:
+	//   - novelID (uint): The ID of the novel.
+	//   - query (string): The search query. Every word must match a word, or the beginning of one.
+	//   - page (int): The page number for pagination (1-based).
+	//   - limit (int): The maximum number of chapters to return per page.
+	//
+	// Returns:
+	//   - []models.ChapterSearchResult: A slice of the matching chapters with highlighted snippets.
+	//   - int64: The total number of matching chapters.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrSearchQueryRequired: Returned if the query has no words.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - SEARCHING_CHAPTERS: Returned if an error occurs while searching the chapters.
+	SearchChapters(novelID uint, query string, page, limit int) ([]models.ChapterSearchResult, int64, error)
+}
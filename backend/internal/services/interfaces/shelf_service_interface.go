@@ -0,0 +1,123 @@
+package interfaces
+
+import "backend/internal/models"
+
+// ShelfServiceInterface defines methods for managing the shelves the users organize their libraries with.
+type ShelfServiceInterface interface {
+	// GetShelves retrieves the shelves of a user with their novels, ordered by name.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//
+	// Returns:
+	//   - []models.Shelf: The shelves of the user.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES: Returned if the shelves could not be retrieved.
+	GetShelves(userID uint) ([]models.Shelf, error)
+
+	// GetShelf retrieves a shelf of a user with its novels.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - shelfID (uint): The ID of the shelf.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the shelf.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES: Returned if the shelf could not be retrieved.
+	GetShelf(userID, shelfID uint) (*models.Shelf, error)
+
+	// CreateShelf creates an empty shelf for a user.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - name (string): The name of the shelf, trimmed before it's stored.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the created shelf.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidShelf: Returned if the name is blank or too long.
+	//   - errors.ErrShelfConflict: Returned if the user already has a shelf with this name.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES, SAVING_SHELF: Returned if the shelf could not be stored.
+	CreateShelf(userID uint, name string) (*models.Shelf, error)
+
+	// RenameShelf changes the name of a shelf of a user.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - shelfID (uint): The ID of the shelf.
+	//   - name (string): The new name of the shelf, trimmed before it's stored.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the renamed shelf.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrInvalidShelf: Returned if the name is blank or too long.
+	//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+	//   - errors.ErrShelfConflict: Returned if the user already has another shelf with this name.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES, SAVING_SHELF: Returned if the shelf could not be renamed.
+	RenameShelf(userID, shelfID uint, name string) (*models.Shelf, error)
+
+	// DeleteShelf deletes a shelf of a user. The novels on it are only taken off the shelf.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - shelfID (uint): The ID of the shelf.
+	//
+	// Returns:
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES, DELETING_SHELF: Returned if the shelf could not be deleted.
+	DeleteShelf(userID, shelfID uint) error
+
+	// AddNovelToShelf puts a novel on a shelf of a user. Putting a novel already on the shelf changes nothing.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - shelfID (uint): The ID of the shelf.
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the shelf with its novels.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES, SAVING_SHELF: Returned if the novel could not be put on the shelf.
+	AddNovelToShelf(userID, shelfID, novelID uint) (*models.Shelf, error)
+
+	// RemoveNovelFromShelf takes a novel off a shelf of a user. Taking off a novel that isn't on the shelf changes nothing.
+	//
+	// Parameters:
+	//   - userID (uint): The ID of the user.
+	//   - shelfID (uint): The ID of the shelf.
+	//   - novelID (uint): The ID of the novel.
+	//
+	// Returns:
+	//   - *models.Shelf: A pointer to the shelf with its novels.
+	//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+	//
+	// Error types:
+	//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+	//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+	//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+	//   - GETTING_SHELVES, SAVING_SHELF: Returned if the novel could not be taken off the shelf.
+	RemoveNovelFromShelf(userID, shelfID, novelID uint) (*models.Shelf, error)
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"backend/internal/validators"
+	stdErrors "errors"
+	"strings"
+)
+
+// maxLibraryImportRows is the maximum number of novels imported at once.
+const maxLibraryImportRows = 5000
+
+// maxLibraryCreatedNovels is the maximum number of unknown novels imported from NovelUpdates by a library import, since
+// each one is scraped while the request waits.
+const maxLibraryCreatedNovels = 20
+
+// novelCreator is the part of the novel service used to import the novels a library has that are unknown.
+type novelCreator interface {
+	CreateNovel(novelUpdatesID string) (*models.Novel, error)
+}
+
+// LibraryService exports the libraries of the users and imports them back, including the libraries exported from
+// other trackers.
+//
+// Fields:
+//   - bookmarkRepo (interfaces.BookmarkRepositoryInterface): The repository used to store the bookmarks.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to match the novels of the libraries.
+//   - novelService (novelCreator): The service used to import the novels that are unknown.
+type LibraryService struct {
+	bookmarkRepo interfaces.BookmarkRepositoryInterface
+	novelRepo    interfaces.NovelRepositoryInterface
+	novelService novelCreator
+}
+
+// NewLibraryService creates a new LibraryService instance.
+//
+// Parameters:
+//   - bookmarkRepo (interfaces.BookmarkRepositoryInterface): The repository used to store the bookmarks.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to match the novels of the libraries.
+//   - novelService (novelCreator): The service used to import the novels that are unknown.
+//
+// Returns:
+//   - *LibraryService: A pointer to the newly created LibraryService.
+func NewLibraryService(bookmarkRepo interfaces.BookmarkRepositoryInterface, novelRepo interfaces.NovelRepositoryInterface, novelService novelCreator) *LibraryService {
+	return &LibraryService{
+		bookmarkRepo: bookmarkRepo,
+		novelRepo:    novelRepo,
+		novelService: novelService,
+	}
+}
+
+// ExportLibrary retrieves the whole library of a user, in the order the novels were bookmarked.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - []dtos.LibraryRow: The bookmarked novels of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - GETTING_BOOKMARKS: Returned if the bookmarks could not be retrieved.
+func (s *LibraryService) ExportLibrary(userID uint) ([]dtos.LibraryRow, error) {
+	entries, err := s.bookmarkRepo.GetAllLibraryEntries(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]dtos.LibraryRow, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, dtos.LibraryRow{
+			NovelID:        entry.Novel.ID,
+			NovelUpdatesID: entry.Novel.NovelUpdatesID,
+			Title:          entry.Novel.Title,
+			Status:         entry.Status,
+			Score:          entry.Score,
+			CurrentChapter: entry.CurrentChapter,
+		})
+	}
+	return rows, nil
+}
+
+// ImportLibrary bookmarks the novels of a library for a user. Each row is matched by its NovelUpdates ID, which may be
+// the URL of the page of the novel, or else by its title. Novels already bookmarked have their bookmark updated, and
+// the statuses move on with the progress as updating the bookmarks does. A row that fails doesn't stop the others.
+// At most 20 unknown novels are imported, the rows of the others fail so the library can be imported again.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - rows ([]dtos.LibraryRow): The bookmarked novels of the library.
+//   - createNovels (bool): Whether the novels that are unknown are imported from NovelUpdates, which the callers only
+//     allow to the users allowed to create novels.
+//
+// Returns:
+//   - *dtos.LibraryImportReport: A pointer to what importing each row did.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidLibraryFile: Returned if the library has no novels.
+//   - errors.ErrLibraryImportTooLarge: Returned if the library has too many novels.
+func (s *LibraryService) ImportLibrary(userID uint, rows []dtos.LibraryRow, createNovels bool) (*dtos.LibraryImportReport, error) {
+	if len(rows) == 0 {
+		return nil, errors.ErrInvalidLibraryFile
+	}
+	if len(rows) > maxLibraryImportRows {
+		return nil, errors.ErrLibraryImportTooLarge
+	}
+
+	report := &dtos.LibraryImportReport{Rows: make([]dtos.LibraryImportRowResult, 0, len(rows))}
+	createdNovels := 0
+	for i, row := range rows {
+		result := dtos.LibraryImportRowResult{
+			Row:            i + 1,
+			NovelUpdatesID: strings.TrimSpace(row.NovelUpdatesID),
+			Title:          strings.TrimSpace(row.Title),
+		}
+
+		if err := s.importLibraryRow(userID, row, createNovels, &createdNovels, &result); err != nil {
+			result.Result = dtos.LibraryImportFailed
+			result.Error = err.Error()
+			var httpErr types.HTTPError
+			if stdErrors.As(err, &httpErr) {
+				result.Code = httpErr.ErrorCode()
+			}
+		}
+
+		switch result.Result {
+		case dtos.LibraryImportCreated:
+			report.Created++
+		case dtos.LibraryImportUpdated:
+			report.Updated++
+		default:
+			report.Failed++
+		}
+		report.Rows = append(report.Rows, result)
+	}
+	return report, nil
+}
+
+// importLibraryRow bookmarks the novel of a row of a library, recording the novel matched and the result in result.
+// createdNovels counts the unknown novels the import imported so far.
+func (s *LibraryService) importLibraryRow(userID uint, row dtos.LibraryRow, createNovels bool, createdNovels *int, result *dtos.LibraryImportRowResult) error {
+	novel, created, err := s.matchLibraryNovel(result.NovelUpdatesID, result.Title, createNovels, createdNovels)
+	if err != nil {
+		return err
+	}
+	result.NovelID = novel.ID
+	result.NovelCreated = created
+
+	bookmark := models.BookmarkedNovel{
+		NovelID:        int(novel.ID),
+		UserID:         int(userID),
+		Status:         libraryBookmarkStatus(row.Status, row.CurrentChapter),
+		Score:          row.Score,
+		CurrentChapter: row.CurrentChapter,
+	}
+	if err := validators.ValidateBookmark(bookmark); err != nil {
+		return err
+	}
+
+	existing, err := s.bookmarkRepo.GetBookmark(userID, novel.ID)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		applyBookmarkProgress(&bookmark, 0, novel)
+		if _, err := s.bookmarkRepo.CreateBookmark(bookmark); err != nil {
+			return err
+		}
+		result.Result = dtos.LibraryImportCreated
+		return nil
+	}
+
+	applyBookmarkProgress(&bookmark, existing.CurrentChapter, novel)
+	if _, err := s.bookmarkRepo.UpdateBookmark(bookmark); err != nil {
+		return err
+	}
+	result.Result = dtos.LibraryImportUpdated
+	return nil
+}
+
+// matchLibraryNovel finds the novel of a row of a library by its NovelUpdates ID or else by its title, importing it
+// from NovelUpdates when it's unknown and createNovels is set, unless createdNovels reached maxLibraryCreatedNovels. It
+// also returns whether the novel was imported.
+func (s *LibraryService) matchLibraryNovel(novelUpdatesID, title string, createNovels bool, createdNovels *int) (*models.Novel, bool, error) {
+	if novelUpdatesID == "" && title == "" {
+		return nil, false, errors.ErrInvalidBookmark
+	}
+
+	parsedID := ""
+	if novelUpdatesID != "" {
+		// Other trackers export the URL of the page of the novel, which ends with its ID
+		segments := strings.FieldsFunc(novelUpdatesID, func(r rune) bool { return r == '/' })
+		if len(segments) == 0 {
+			return nil, false, errors.ErrInvalidNovelUpdatesID
+		}
+
+		var err error
+		if parsedID, err = utils.NewNovelUpdatesIDParser().Parse(segments[len(segments)-1]); err != nil {
+			return nil, false, err
+		}
+
+		novel, err := s.novelRepo.GetNovelByUpdatesID(parsedID)
+		if err != errors.ErrNovelNotFound {
+			return novel, false, err
+		}
+	}
+
+	if title != "" {
+		novel, err := s.novelRepo.GetNovelByTitle(title)
+		if err != errors.ErrNovelNotFound {
+			return novel, false, err
+		}
+	}
+
+	if parsedID == "" || !createNovels {
+		return nil, false, errors.ErrNovelNotFound
+	}
+	if *createdNovels >= maxLibraryCreatedNovels {
+		return nil, false, errors.ErrLibraryCreateLimit
+	}
+
+	novel, err := s.novelService.CreateNovel(parsedID)
+	if err != nil {
+		return nil, false, err
+	}
+	*createdNovels++
+	return novel, true, nil
+}
+
+// libraryBookmarkStatus normalizes the status of a row of a library, which other trackers write differently. Rows
+// without a status are being read, or planned when no chapter was read.
+func libraryBookmarkStatus(status string, currentChapter int) string {
+	if status = models.NormalizeBookmarkStatus(status); status != "" {
+		return status
+	}
+
+	if currentChapter > 0 {
+		return models.BookmarkStatusReading
+	}
+	return models.BookmarkStatusPlanToRead
+}
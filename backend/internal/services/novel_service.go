@@ -1,37 +1,38 @@
 package services
 
 import (
+	"backend/internal/dtos"
+	internalInterfaces "backend/internal/interfaces"
 	"backend/internal/models"
 	"backend/internal/repositories/interfaces"
 	"backend/internal/types"
 	"backend/internal/types/errors"
 	"backend/internal/utils"
 	"backend/internal/validators"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
-	"os"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 // NovelService struct manages novel-related operations.
 type NovelService struct {
-	repo           interfaces.NovelRepositoryInterface
-	scriptExecutor utils.ScriptExecutor
+	repo   interfaces.NovelRepositoryInterface
+	source internalInterfaces.Source
 }
 
 // NewNovelService creates a new NovelService instance.
 //
 // Parameters:
 //   - repo (interfaces.NovelRepositoryInterface): The novel repository to use.
-//   - scriptExecutor (utils.ScriptExecutor): The script executor to use.
+//   - source (internalInterfaces.Source): The source novels are imported from.
 //
 // Returns:
 //   - *NovelService: A new NovelService instance.
-func NewNovelService(repo interfaces.NovelRepositoryInterface, scriptExecutor utils.ScriptExecutor) *NovelService {
-	return &NovelService{repo: repo, scriptExecutor: scriptExecutor}
+func NewNovelService(repo interfaces.NovelRepositoryInterface, source internalInterfaces.Source) *NovelService {
+	return &NovelService{repo: repo, source: source}
 }
 
 // GetNovelsByAuthorName retrieves novels by author name, handling pagination.
@@ -153,6 +154,125 @@ func (s *NovelService) GetNovels(page, limit int) ([]models.Novel, int64, error)
 	return s.repo.GetNovels(page, limit)
 }
 
+// GetNovelsByQuery retrieves a paginated list of the novels matching the filters of a query, in its order.
+//
+// Parameters:
+//   - query (models.NovelQuery): The filters and the order of the novels.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - []models.Novel: A slice of Novel structs representing the novels on the specified page.
+//   - int64: The total number of novels matching the query.
+//   - error: An error object; returns nil if no error occurs.
+//
+// Error types:
+//
+// Validation errors:
+//   - INVALID_TAG, INVALID_GENRE, INVALID_AUTHOR: Returned if the name of a tag, genre or author is invalid.
+//   - INVALID_NOVEL_QUERY: Returned if the match mode, the sort, the year range or the minimum number of chapters is
+//     invalid.
+//
+// Repository errors:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNoNovels: Returned if no novels are found.
+//   - errors.ErrGettingTotalNovels: Returned if an error occurs while retrieving the total number of novels.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels themselves.
+func (s *NovelService) GetNovelsByQuery(query models.NovelQuery, page, limit int) ([]models.Novel, int64, error) {
+	if err := validators.ValidateNovelQuery(query); err != nil {
+		return nil, 0, err
+	}
+
+	if query.IsEmpty() {
+		return s.repo.GetNovels(page, limit)
+	}
+
+	return s.repo.GetNovelsByQuery(query, page, limit)
+}
+
+// GetNovelsPage retrieves a page of the novels matching the filters of a query, in its order, continuing the listing
+// after the novel of a cursor.
+//
+// Parameters:
+//   - query (models.NovelQuery): The filters and the order of the novels. When continuing a listing, the order can be
+//     left empty to keep the one of the cursor.
+//   - cursor (string): The cursor returned with the previous page, or an empty string for the first page.
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - []models.Novel: A slice of Novel structs representing the novels of the page.
+//   - string: The cursor of the next page, or an empty string if it is the last page.
+//   - error: An error object; returns nil if no error occurs.
+//
+// Error types:
+//
+// Validation errors:
+//   - INVALID_TAG, INVALID_GENRE, INVALID_AUTHOR: Returned if the name of a tag, genre or author is invalid.
+//   - INVALID_NOVEL_QUERY: Returned if the match mode, the sort, the year range or the minimum number of chapters is
+//     invalid.
+//   - errors.ErrInvalidCursor: Returned if the cursor is invalid or was returned for another order.
+//
+// Repository errors:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels.
+func (s *NovelService) GetNovelsPage(query models.NovelQuery, cursor string, limit int) ([]models.Novel, string, error) {
+	var after *models.NovelCursor
+	if cursor != "" {
+		decoded, err := utils.DecodeNovelCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if query.Sort == "" && !query.Descending {
+			query.Sort, query.Descending = decoded.Sort, decoded.Descending
+		} else if query.Sort != decoded.Sort || query.Descending != decoded.Descending {
+			return nil, "", errors.ErrInvalidCursor
+		}
+		after = decoded
+	}
+
+	if err := validators.ValidateNovelQuery(query); err != nil {
+		return nil, "", err
+	}
+
+	novels, next, err := s.repo.GetNovelsPage(query, after, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if next == nil {
+		return novels, "", nil
+	}
+	return novels, utils.EncodeNovelCursor(*next), nil
+}
+
+// GetAllNovels retrieves every novel, a page at a time so that a large library isn't loaded in a single query.
+//
+// Returns:
+//   - []models.Novel: A slice of all the novels, in the order they were added.
+//   - error: An error object; returns nil if no error occurs.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingNovels: Returned if an error occurs while retrieving the novels.
+func (s *NovelService) GetAllNovels() ([]models.Novel, error) {
+	var novels []models.Novel
+	var after *models.NovelCursor
+
+	for {
+		page, next, err := s.repo.GetNovelsPage(models.NovelQuery{}, after, utils.MaxLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		novels = append(novels, page...)
+		if next == nil {
+			return novels, nil
+		}
+		after = next
+	}
+}
+
 // GetNovelByUpdatesID retrieves a novel from the repository using its NovelUpdates ID.
 //
 // Parameters:
@@ -198,10 +318,192 @@ func (s *NovelService) GetNovelByID(id uint) (*models.Novel, error) {
 	return s.repo.GetNovelByID(id)
 }
 
+// UpdateNovel edits the metadata of a novel. The edited fields are locked so the imports of the novel don't overwrite
+// them, unless the request sets the locked fields itself.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - request (dtos.NovelUpdateRequest): The edited fields and, optionally, the fields to lock.
+//   - replace (bool): Whether every editable field is replaced, in which case they are all required.
+//
+// Returns:
+//   - *models.Novel: The updated novel.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrIncompleteNovelUpdate: Returned if a field is missing when replacing the novel.
+//   - errors.ErrNovelTitleRequired, errors.ErrNovelFieldTooLong: Returned if a field is invalid.
+//   - errors.ErrTagRequired, errors.ErrGenreRequired, errors.ErrAuthorRequired and the matching too long errors:
+//     Returned if the name of a tag, genre or author is invalid.
+//   - errors.ErrInvalidLockedField: Returned if a locked field cannot be locked.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+//   - errors.ErrNovelTitleTaken: Returned if another novel has the new title.
+//   - UPDATING_NOVEL: Returned if the novel could not be updated.
+func (s *NovelService) UpdateNovel(novelID uint, request dtos.NovelUpdateRequest, replace bool) (*models.Novel, error) {
+	novel, err := s.repo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	setField := func(field string, value *string, target *string) {
+		if value != nil {
+			*target = strings.TrimSpace(*value)
+			fields = append(fields, field)
+		}
+	}
+	setField(models.NovelFieldTitle, request.Title, &novel.Title)
+	setField(models.NovelFieldSynopsis, request.Synopsis, &novel.Synopsis)
+	setField(models.NovelFieldCoverUrl, request.CoverUrl, &novel.CoverUrl)
+	setField(models.NovelFieldLanguage, request.Language, &novel.Language)
+	setField(models.NovelFieldStatus, request.Status, &novel.Status)
+	setField(models.NovelFieldYear, request.Year, &novel.Year)
+	setField(models.NovelFieldReleaseFrequency, request.ReleaseFrequency, &novel.ReleaseFrequency)
+
+	if request.Tags != nil {
+		novel.Tags = make([]models.Tag, 0, len(*request.Tags))
+		for _, name := range *request.Tags {
+			novel.Tags = append(novel.Tags, models.Tag{Name: strings.TrimSpace(name)})
+		}
+		fields = append(fields, models.NovelFieldTags)
+	}
+	if request.Genres != nil {
+		novel.Genres = make([]models.Genre, 0, len(*request.Genres))
+		for _, name := range *request.Genres {
+			novel.Genres = append(novel.Genres, models.Genre{Name: strings.TrimSpace(name)})
+		}
+		fields = append(fields, models.NovelFieldGenres)
+	}
+	if request.Authors != nil {
+		novel.Authors = make([]models.Author, 0, len(*request.Authors))
+		for _, name := range *request.Authors {
+			novel.Authors = append(novel.Authors, models.Author{Name: strings.TrimSpace(name)})
+		}
+		fields = append(fields, models.NovelFieldAuthors)
+	}
+
+	if replace && len(fields) != len(models.LockableNovelFields) {
+		return nil, errors.ErrIncompleteNovelUpdate
+	}
+
+	if err := validators.ValidateNovelUpdate(*novel); err != nil {
+		return nil, err
+	}
+
+	if request.LockedFields != nil {
+		if err := validators.ValidateLockedFields(*request.LockedFields); err != nil {
+			return nil, err
+		}
+		novel.SetLockedFields(*request.LockedFields)
+	} else {
+		novel.SetLockedFields(append(novel.GetLockedFields(), fields...))
+	}
+
+	return s.repo.UpdateNovel(*novel, fields)
+}
+
+// DeleteNovel deletes a novel. It's hidden with its chapters until it's imported again.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+//   - DELETING_NOVEL: Returned if the novel could not be deleted.
+func (s *NovelService) DeleteNovel(novelID uint) error {
+	return s.repo.DeleteNovel(novelID)
+}
+
+// GetNovelSourcePriority retrieves the source priority of a novel and the order its chapters are fetched in.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *dtos.SourcePriorityResponse: The source priority of the novel.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+//   - errors.ErrGettingNovel: Returned if there's a general error retrieving the novel.
+func (s *NovelService) GetNovelSourcePriority(novelID uint) (*dtos.SourcePriorityResponse, error) {
+	novel, err := s.repo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toSourcePriorityResponse(novel.GetSourcePriority()), nil
+}
+
+// UpdateNovelSourcePriority changes the sources the chapters of a novel are fetched from first. The other sources are
+// still tried afterwards, in default order.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - sources ([]string): The names of the sources to try first, in order. Empty to use the default order.
+//
+// Returns:
+//   - *dtos.SourcePriorityResponse: The new source priority of the novel.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidSourcePriority: Returned if a source is not registered or is listed more than once.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel with the given ID is not found.
+//   - errors.ErrGettingNovel: Returned if there's a general error retrieving the novel.
+//   - UPDATING_SOURCE_PRIORITY: Returned if the source priority could not be updated.
+func (s *NovelService) UpdateNovelSourcePriority(novelID uint, sources []string) (*dtos.SourcePriorityResponse, error) {
+	available := sourceNames(s.source)
+	priority := make([]string, 0, len(sources))
+	for _, name := range sources {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(available, name) || slices.Contains(priority, name) {
+			return nil, errors.ErrInvalidSourcePriority
+		}
+		priority = append(priority, name)
+	}
+
+	novel, err := s.repo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	novel.SetSourcePriority(priority)
+	if err := s.repo.UpdateNovelSourcePriority(novel.ID, novel.SourcePriority); err != nil {
+		return nil, err
+	}
+
+	return s.toSourcePriorityResponse(priority), nil
+}
+
+// toSourcePriorityResponse describes a source priority along with the order it results in.
+//
+// Parameters:
+//   - priority ([]string): The names of the sources to try first, in order.
+//
+// Returns:
+//   - *dtos.SourcePriorityResponse: The source priority.
+func (s *NovelService) toSourcePriorityResponse(priority []string) *dtos.SourcePriorityResponse {
+	if priority == nil {
+		priority = []string{}
+	}
+
+	return &dtos.SourcePriorityResponse{
+		Sources:   priority,
+		Order:     prioritizeSources(s.source, priority),
+		Available: sourceNames(s.source),
+	}
+}
+
 // CreateNovel creates a new novel in the database using data scraped from NovelUpdates.
 //
-// It takes a NovelUpdates ID, parses it, executes a Python script to scrape novel data,
-// handles potential errors during script execution and JSON unmarshalling,
+// It takes a NovelUpdates ID, parses it, fetches the novel data from the configured source,
 // cleans the scraped data, and finally saves the novel to the database.
 //
 // Parameters:
@@ -217,6 +519,10 @@ func (s *NovelService) GetNovelByID(id uint) (*models.Novel, error) {
 //   - errors.ErrNovelNotFound: Returned if the novel is not found on NovelUpdates.
 //   - errors.SCRIPT_ERROR:  Indicates failure during Python script execution.  HTTP Status: 503
 //   - errors.IMPORTING_NOVEL: Indicates failure during JSON unmarshalling of the script's output. HTTP Status: 500
+//   - errors.SOURCE_DOWN: Indicates the source website could not be reached. HTTP Status: 503
+//   - errors.SOURCE_TIMEOUT: Indicates the source website took too long to respond. HTTP Status: 504
+//   - errors.SOURCE_BLOCKED: Indicates the source website denied the request or answered with a captcha. HTTP Status: 502
+//   - errors.PARSING_SOURCE: Indicates the source page didn't have the expected structure. HTTP Status: 502
 //
 // Validation errors:
 //   - errors.ErrInvalidLatestChapter: Returned if the latest chapter value is invalid.
@@ -232,28 +538,10 @@ func (s *NovelService) CreateNovel(novelUpdatesID string) (*models.Novel, error)
 		return nil, err
 	}
 
-	// Execute the Python script
-	output, err := s.scriptExecutor.ExecuteScript(os.Getenv("PYTHON"), "-m", "novel_updates_scraper.client", "import-novel", novelUpdatesID)
-
-	if err != nil {
-		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to execute Python script: "+err.Error(), http.StatusServiceUnavailable, err)
-	}
-
-	var scriptError utils.ScriptError
-
-	// Check if the script returned a specific error
-	if json.Unmarshal(output, &scriptError) == nil {
-		if scriptError.Status == 404 {
-			return nil, errors.ErrNovelNotFound
-		}
-		return nil, types.WrapError(errors.SCRIPT_ERROR, scriptError.Error, http.StatusServiceUnavailable, nil)
-	}
-
-	// Ensure the output is unmarshaled into a valid JSON object
-	var result models.ImportedNovel
-	err = json.Unmarshal(output, &result)
+	// Fetch the novel from the source
+	result, err := s.source.FetchNovelMetadata(novelUpdatesID)
 	if err != nil {
-		return nil, types.WrapError(errors.IMPORTING_NOVEL, "An error occurred while importing the novel: "+err.Error(), http.StatusInternalServerError, err)
+		return nil, err
 	}
 
 	year := strings.ReplaceAll(result.Year, "\n", "")
@@ -0,0 +1,511 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"backend/internal/validators"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// opdsTitle is the title of the catalog.
+	opdsTitle = "PeakNovelGo"
+	// opdsIDPrefix is the prefix of the identifiers of the feeds and entries of the catalog.
+	opdsIDPrefix = "urn:peaknovelgo:"
+)
+
+// opdsNamespaces holds the namespaces declared by every feed.
+var opdsNamespaces = dtos.OPDSFeed{
+	Xmlns:           "http://www.w3.org/2005/Atom",
+	XmlnsDC:         "http://purl.org/dc/terms/",
+	XmlnsOpenSearch: "http://a9.com/-/spec/opensearch/1.1/",
+	XmlnsOPDS:       "http://opds-spec.org/2010/catalog",
+	XmlnsThr:        "http://purl.org/syndication/thread/1.0",
+}
+
+// OPDSService builds the OPDS 1.2 catalog e-reader apps browse the library with.
+//
+// Fields:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to get the novels.
+//   - taxonomyRepo (interfaces.TaxonomyRepositoryInterface): The repository used to get the genres, tags and authors.
+//   - BaseURL (string): The URL the backend is served at, prepended to the links of the catalog.
+type OPDSService struct {
+	novelRepo    interfaces.NovelRepositoryInterface
+	taxonomyRepo interfaces.TaxonomyRepositoryInterface
+	BaseURL      string
+}
+
+// NewOPDSService creates a new OPDSService instance.
+//
+// Parameters:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to get the novels.
+//   - taxonomyRepo (interfaces.TaxonomyRepositoryInterface): The repository used to get the genres, tags and authors.
+//   - baseURL (string): The URL the backend is served at.
+//
+// Returns:
+//   - *OPDSService: A pointer to the newly created OPDSService.
+func NewOPDSService(novelRepo interfaces.NovelRepositoryInterface, taxonomyRepo interfaces.TaxonomyRepositoryInterface, baseURL string) *OPDSService {
+	return &OPDSService{
+		novelRepo:    novelRepo,
+		taxonomyRepo: taxonomyRepo,
+		BaseURL:      baseURL,
+	}
+}
+
+// GetRootFeed builds the navigation feed the catalog starts at, linking to all the novels and to the genres, tags and
+// authors.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The root feed.
+func (s *OPDSService) GetRootFeed() *dtos.OPDSFeed {
+	feed := s.newFeed("", "/opds", opdsTitle, dtos.OPDSNavigationType)
+
+	for _, section := range []struct{ id, path, title, description, feedType string }{
+		{"novels", "/opds/novels", "All Novels", "Every novel of the library.", dtos.OPDSAcquisitionType},
+		{"genres", "/opds/genres", "Genres", "Novels by genre.", dtos.OPDSNavigationType},
+		{"tags", "/opds/tags", "Tags", "Novels by tag.", dtos.OPDSNavigationType},
+		{"authors", "/opds/authors", "Authors", "Novels by author.", dtos.OPDSNavigationType},
+	} {
+		feed.Entries = append(feed.Entries, dtos.OPDSEntry{
+			Title:   section.title,
+			ID:      opdsIDPrefix + section.id,
+			Updated: feed.Updated,
+			Content: &dtos.OPDSContent{Type: "text", Text: section.description},
+			Links:   []dtos.OPDSLink{{Rel: "subsection", Href: s.url(section.path), Type: section.feedType}},
+		})
+	}
+
+	return feed
+}
+
+// GetNovelsFeed builds the acquisition feed of all the novels.
+//
+// Parameters:
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+func (s *OPDSService) GetNovelsFeed(page, limit int) (*dtos.OPDSFeed, error) {
+	novels, total, err := s.novelRepo.GetNovels(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.novelsFeed("novels", "/opds/novels", "All Novels", nil, novels, total, page, limit)
+}
+
+// GetGenresFeed builds the navigation feed of the genres, linking to the novels of each.
+//
+// Parameters:
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of genres per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_GENRES: Returned if the genres could not be retrieved.
+func (s *OPDSService) GetGenresFeed(page, limit int) (*dtos.OPDSFeed, error) {
+	genres, total, err := s.taxonomyRepo.GetGenres(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.taxonomyFeed("genres", "/opds/genres", "Genres", genres, total, page, limit)
+}
+
+// GetTagsFeed builds the navigation feed of the tags, linking to the novels of each.
+//
+// Parameters:
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of tags per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_TAGS: Returned if the tags could not be retrieved.
+func (s *OPDSService) GetTagsFeed(page, limit int) (*dtos.OPDSFeed, error) {
+	tags, total, err := s.taxonomyRepo.GetTags(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.taxonomyFeed("tags", "/opds/tags", "Tags", tags, total, page, limit)
+}
+
+// GetAuthorsFeed builds the navigation feed of the authors, linking to the novels of each.
+//
+// Parameters:
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of authors per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_AUTHORS: Returned if the authors could not be retrieved.
+func (s *OPDSService) GetAuthorsFeed(page, limit int) (*dtos.OPDSFeed, error) {
+	authors, total, err := s.taxonomyRepo.GetAuthors(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.taxonomyFeed("authors", "/opds/authors", "Authors", authors, total, page, limit)
+}
+
+// GetGenreFeed builds the acquisition feed of the novels of a genre.
+//
+// Parameters:
+//   - genreName (string): The name of the genre.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrGenreRequired, errors.ErrGenreTooShort, errors.ErrGenreTooLong: Returned if the genre name is invalid.
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+func (s *OPDSService) GetGenreFeed(genreName string, page, limit int) (*dtos.OPDSFeed, error) {
+	if err := validators.ValidateGenre(genreName); err != nil {
+		return nil, err
+	}
+
+	novels, total, err := s.novelRepo.GetNovelsByGenreName(genreName, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.novelsFeed("genres:"+genreName, "/opds/genres/"+url.PathEscape(genreName), genreName, nil, novels, total, page, limit)
+}
+
+// GetTagFeed builds the acquisition feed of the novels of a tag.
+//
+// Parameters:
+//   - tagName (string): The name of the tag.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrTagRequired, errors.ErrTagTooShort, errors.ErrTagTooLong: Returned if the tag name is invalid.
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+func (s *OPDSService) GetTagFeed(tagName string, page, limit int) (*dtos.OPDSFeed, error) {
+	if err := validators.ValidateTag(tagName); err != nil {
+		return nil, err
+	}
+
+	novels, total, err := s.novelRepo.GetNovelsByTagName(tagName, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.novelsFeed("tags:"+tagName, "/opds/tags/"+url.PathEscape(tagName), tagName, nil, novels, total, page, limit)
+}
+
+// GetAuthorFeed builds the acquisition feed of the novels of an author.
+//
+// Parameters:
+//   - authorName (string): The name of the author.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrAuthorRequired, errors.ErrAuthorTooShort, errors.ErrAuthorTooLong: Returned if the author name is
+//     invalid.
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+func (s *OPDSService) GetAuthorFeed(authorName string, page, limit int) (*dtos.OPDSFeed, error) {
+	if err := validators.ValidateAuthor(authorName); err != nil {
+		return nil, err
+	}
+
+	novels, total, err := s.novelRepo.GetNovelsByAuthorName(authorName, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.novelsFeed("authors:"+authorName, "/opds/authors/"+url.PathEscape(authorName), authorName, nil, novels, total, page, limit)
+}
+
+// SearchFeed builds the acquisition feed of the novels whose title contains a query.
+//
+// Parameters:
+//   - query (string): The text to search for in the titles.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrSearchQueryRequired: Returned if the query is empty.
+//   - errors.ErrPageOutOfRange: Returned if the page is past the last one.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrGettingTotalNovels, errors.ErrGettingNovels: Returned if the novels could not be retrieved.
+func (s *OPDSService) SearchFeed(query string, page, limit int) (*dtos.OPDSFeed, error) {
+	if query == "" {
+		return nil, errors.ErrSearchQueryRequired
+	}
+
+	novels, total, err := s.novelRepo.SearchNovelsByTitle(query, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.novelsFeed("search:"+query, "/opds/search", fmt.Sprintf("Search: %s", query), url.Values{"q": {query}}, novels, total, page, limit)
+}
+
+// GetOpenSearchDescription builds the OpenSearch description telling the clients how to search the catalog.
+//
+// Returns:
+//   - *dtos.OpenSearchDescription: The OpenSearch description.
+func (s *OPDSService) GetOpenSearchDescription() *dtos.OpenSearchDescription {
+	return &dtos.OpenSearchDescription{
+		Xmlns:          "http://a9.com/-/spec/opensearch/1.1/",
+		ShortName:      opdsTitle,
+		Description:    "Search the novels by title",
+		InputEncoding:  "UTF-8",
+		OutputEncoding: "UTF-8",
+		URLs: []dtos.OpenSearchURL{{
+			Type:     dtos.OPDSAcquisitionType,
+			Template: s.url("/opds/search") + "?q={searchTerms}",
+		}},
+	}
+}
+
+// novelsFeed builds a page of an acquisition feed of novels.
+//
+// Parameters:
+//   - id (string): The identifier of the feed, without the prefix.
+//   - path (string): The path of the feed.
+//   - title (string): The title of the feed.
+//   - query (url.Values): The query parameters of the feed other than the pagination ones.
+//   - novels ([]models.Novel): The novels of the page.
+//   - total (int64): The total number of novels of the feed.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of novels per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: errors.ErrPageOutOfRange if the page is past the last one.
+func (s *OPDSService) novelsFeed(id, path, title string, query url.Values, novels []models.Novel, total int64, page, limit int) (*dtos.OPDSFeed, error) {
+	if total > 0 && utils.IsPageOutOfRange(page, total, limit) {
+		return nil, errors.ErrPageOutOfRange
+	}
+
+	feed := s.newFeed(id, path, title, dtos.OPDSAcquisitionType)
+	s.paginate(feed, path, query, dtos.OPDSAcquisitionType, total, page, limit)
+
+	for _, novel := range novels {
+		feed.Entries = append(feed.Entries, s.novelEntry(novel))
+	}
+
+	return feed, nil
+}
+
+// taxonomyFeed builds a page of a navigation feed of genres, tags or authors, each entry linking to the acquisition
+// feed of its novels.
+//
+// Parameters:
+//   - kind (string): The kind of the entries ("genres", "tags" or "authors"), used as the identifier and path prefix.
+//   - path (string): The path of the feed.
+//   - title (string): The title of the feed.
+//   - entries ([]models.TaxonomyEntry): The entries of the page.
+//   - total (int64): The total number of entries of the feed.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of entries per page.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The page of the feed.
+//   - error: errors.ErrPageOutOfRange if the page is past the last one.
+func (s *OPDSService) taxonomyFeed(kind, path, title string, entries []models.TaxonomyEntry, total int64, page, limit int) (*dtos.OPDSFeed, error) {
+	if total > 0 && utils.IsPageOutOfRange(page, total, limit) {
+		return nil, errors.ErrPageOutOfRange
+	}
+
+	feed := s.newFeed(kind, path, title, dtos.OPDSNavigationType)
+	s.paginate(feed, path, nil, dtos.OPDSNavigationType, total, page, limit)
+
+	for _, entry := range entries {
+		description := entry.Description
+		if description == "" {
+			description = fmt.Sprintf("%d novels", entry.NovelCount)
+		}
+
+		feed.Entries = append(feed.Entries, dtos.OPDSEntry{
+			Title:   entry.Name,
+			ID:      fmt.Sprintf("%s%s:%d", opdsIDPrefix, kind, entry.ID),
+			Updated: feed.Updated,
+			Content: &dtos.OPDSContent{Type: "text", Text: description},
+			Links: []dtos.OPDSLink{{
+				Rel:   "subsection",
+				Href:  s.url("/opds/" + kind + "/" + url.PathEscape(entry.Name)),
+				Type:  dtos.OPDSAcquisitionType,
+				Count: entry.NovelCount,
+			}},
+		})
+	}
+
+	return feed, nil
+}
+
+// novelEntry builds the acquisition entry of a novel, linking to the EPUB of its stored chapters and to its cover.
+//
+// Parameters:
+//   - novel (models.Novel): The novel, with its authors, genres and tags.
+//
+// Returns:
+//   - dtos.OPDSEntry: The entry of the novel.
+func (s *OPDSService) novelEntry(novel models.Novel) dtos.OPDSEntry {
+	entry := dtos.OPDSEntry{
+		Title:    novel.Title,
+		ID:       opdsIDPrefix + "novel:" + novel.NovelUpdatesID,
+		Updated:  novel.UpdatedAt.UTC().Format(time.RFC3339),
+		Language: novel.Language,
+		Issued:   novel.Year,
+		Links: []dtos.OPDSLink{{
+			Rel:  "http://opds-spec.org/acquisition/open-access",
+			Href: s.url(fmt.Sprintf("/novels/%d/export.epub", novel.ID)),
+			Type: "application/epub+zip",
+		}},
+	}
+
+	if novel.Synopsis != "" {
+		entry.Summary = &dtos.OPDSContent{Type: "text", Text: novel.Synopsis}
+	}
+	if novel.CoverUrl != "" {
+		entry.Links = append(entry.Links,
+			dtos.OPDSLink{Rel: "http://opds-spec.org/image", Href: novel.CoverUrl},
+			dtos.OPDSLink{Rel: "http://opds-spec.org/image/thumbnail", Href: novel.CoverUrl},
+		)
+	}
+	for _, author := range novel.Authors {
+		entry.Authors = append(entry.Authors, dtos.OPDSAuthor{Name: author.Name, URI: s.url("/opds/authors/" + url.PathEscape(author.Name))})
+	}
+	for _, genre := range novel.Genres {
+		entry.Categories = append(entry.Categories, dtos.OPDSCategory{Scheme: s.url("/opds/genres"), Term: genre.Name, Label: genre.Name})
+	}
+	for _, tag := range novel.Tags {
+		entry.Categories = append(entry.Categories, dtos.OPDSCategory{Scheme: s.url("/opds/tags"), Term: tag.Name, Label: tag.Name})
+	}
+
+	return entry
+}
+
+// newFeed builds an empty feed with the links every feed has.
+//
+// Parameters:
+//   - id (string): The identifier of the feed, without the prefix. Empty for the root feed.
+//   - path (string): The path of the feed.
+//   - title (string): The title of the feed.
+//   - feedType (string): The media type of the feed.
+//
+// Returns:
+//   - *dtos.OPDSFeed: The feed.
+func (s *OPDSService) newFeed(id, path, title, feedType string) *dtos.OPDSFeed {
+	feed := opdsNamespaces
+	feed.ID = opdsIDPrefix + "catalog"
+	if id != "" {
+		feed.ID += ":" + id
+	}
+	feed.Title = title
+	feed.Updated = time.Now().UTC().Format(time.RFC3339)
+	feed.Links = []dtos.OPDSLink{
+		{Rel: "self", Href: s.url(path), Type: feedType},
+		{Rel: "start", Href: s.url("/opds"), Type: dtos.OPDSNavigationType},
+		{Rel: "search", Href: s.url("/opds/opensearch.xml"), Type: dtos.OpenSearchDescriptionType},
+	}
+	if path != "/opds" {
+		feed.Links = append(feed.Links, dtos.OPDSLink{Rel: "up", Href: s.url("/opds"), Type: dtos.OPDSNavigationType})
+	}
+
+	return &feed
+}
+
+// paginate adds the pagination links and the OpenSearch counts of a page to a feed. The links keep the limit and the
+// other query parameters, so the clients can follow them as they are.
+//
+// Parameters:
+//   - feed (*dtos.OPDSFeed): The feed.
+//   - path (string): The path of the feed.
+//   - query (url.Values): The query parameters of the feed other than the pagination ones.
+//   - feedType (string): The media type of the feed.
+//   - total (int64): The total number of entries of the feed.
+//   - page (int): The page number (starting from 1).
+//   - limit (int): The number of entries per page.
+func (s *OPDSService) paginate(feed *dtos.OPDSFeed, path string, query url.Values, feedType string, total int64, page, limit int) {
+	feed.TotalResults = total
+	feed.ItemsPerPage = limit
+	feed.StartIndex = (page-1)*limit + 1
+
+	pageURL := func(page int) string {
+		values := url.Values{}
+		for key, value := range query {
+			values[key] = value
+		}
+		values.Set("page", strconv.Itoa(page))
+		values.Set("limit", strconv.Itoa(limit))
+		return s.url(path) + "?" + values.Encode()
+	}
+
+	feed.Links[0].Href = pageURL(page)
+
+	totalPages := int(utils.CalculateTotalPages(total, limit))
+	if totalPages == 0 {
+		return
+	}
+
+	feed.Links = append(feed.Links,
+		dtos.OPDSLink{Rel: "first", Href: pageURL(1), Type: feedType},
+		dtos.OPDSLink{Rel: "last", Href: pageURL(totalPages), Type: feedType},
+	)
+	if page > 1 {
+		feed.Links = append(feed.Links, dtos.OPDSLink{Rel: "previous", Href: pageURL(page - 1), Type: feedType})
+	}
+	if page < totalPages {
+		feed.Links = append(feed.Links, dtos.OPDSLink{Rel: "next", Href: pageURL(page + 1), Type: feedType})
+	}
+}
+
+// url builds the absolute URL of a path of the backend.
+func (s *OPDSService) url(path string) string {
+	return s.BaseURL + path
+}
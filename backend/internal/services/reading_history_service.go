@@ -0,0 +1,238 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// readingIdleTimeout is the longest time counted as spent reading a chapter. Fetching it again within it is a
+	// reload, not a new read.
+	readingIdleTimeout = 30 * time.Minute
+	// maxReadingStatsDays is the longest period the reading statistics are computed over.
+	maxReadingStatsDays = 365
+	// favouriteReadingShares is the number of favourite genres and tags in the reading statistics.
+	favouriteReadingShares = 5
+	// readingStreakDays is the number of days, today included, the reading streaks are looked for in, so the
+	// statistics don't load the whole reading history.
+	readingStreakDays = 2 * maxReadingStatsDays
+	// readingStatsDayLayout is the layout of the days in the reading statistics.
+	readingStatsDayLayout = "2006-01-02"
+)
+
+// ReadingHistoryService records what the users read and computes their reading statistics from it.
+//
+// Fields:
+//   - repo (interfaces.ReadingEventRepositoryInterface): The repository used to store the reading history.
+type ReadingHistoryService struct {
+	repo interfaces.ReadingEventRepositoryInterface
+}
+
+// NewReadingHistoryService creates a new ReadingHistoryService instance.
+//
+// Parameters:
+//   - repo (interfaces.ReadingEventRepositoryInterface): The repository used to store the reading history.
+//
+// Returns:
+//   - *ReadingHistoryService: A pointer to the newly created ReadingHistoryService.
+func NewReadingHistoryService(repo interfaces.ReadingEventRepositoryInterface) *ReadingHistoryService {
+	return &ReadingHistoryService{repo: repo}
+}
+
+// RecordChapterRead adds a chapter fetched by a user to their reading history. Moving on to a later chapter of the
+// same novel finishes the chapter fetched before, with the time between both as the time spent reading it, up to
+// readingIdleTimeout. Fetching the same chapter again within readingIdleTimeout is not recorded.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - chapter (*models.Chapter): The chapter fetched.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_EVENTS, SAVING_READING_EVENT: Returned if the events could not be recorded.
+func (s *ReadingHistoryService) RecordChapterRead(userID uint, chapter *models.Chapter) error {
+	if chapter.NovelID == nil {
+		return nil
+	}
+
+	last, err := s.repo.GetLastOpenedReadingEvent(userID)
+	if err != nil {
+		return err
+	}
+
+	if last != nil && last.NovelID == *chapter.NovelID {
+		elapsed := time.Since(last.CreatedAt)
+		if last.ChapterNo == chapter.ChapterNo && elapsed < readingIdleTimeout {
+			return nil
+		}
+
+		if last.ChapterNo < chapter.ChapterNo {
+			if _, err := s.repo.CreateReadingEvent(models.ReadingEvent{
+				UserID:    userID,
+				NovelID:   last.NovelID,
+				ChapterNo: last.ChapterNo,
+				Event:     models.ReadingEventFinished,
+				WordCount: last.WordCount,
+				Seconds:   int(min(elapsed, readingIdleTimeout).Seconds()),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = s.repo.CreateReadingEvent(models.ReadingEvent{
+		UserID:    userID,
+		NovelID:   *chapter.NovelID,
+		ChapterNo: chapter.ChapterNo,
+		Event:     models.ReadingEventOpened,
+		WordCount: chapter.WordCount,
+	})
+	return err
+}
+
+// GetReadingStats computes the reading statistics of a user over the last days, counting the days in a timezone.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - days (int): The number of days of the period, today included.
+//   - timezone (string): The IANA timezone the days are counted in, UTC if empty.
+//
+// Returns:
+//   - *dtos.ReadingStats: A pointer to the reading statistics.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidReadingStatsPeriod: Returned if the number of days is out of range.
+//   - errors.ErrInvalidReadingStatsTimezone: Returned if the timezone is unknown.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_EVENTS: Returned if the reading history could not be retrieved.
+func (s *ReadingHistoryService) GetReadingStats(userID uint, days int, timezone string) (*dtos.ReadingStats, error) {
+	if days < 1 || days > maxReadingStatsDays {
+		return nil, errors.ErrInvalidReadingStatsPeriod
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.ErrInvalidReadingStatsTimezone
+	}
+
+	now := time.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	from := today.AddDate(0, 0, 1-days)
+
+	// The streaks need the days before the period too
+	events, err := s.repo.GetReadingEvents(userID, today.AddDate(0, 0, 1-readingStreakDays))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &dtos.ReadingStats{
+		From:     from.Format(readingStatsDayLayout),
+		To:       today.Format(readingStatsDayLayout),
+		Timezone: location.String(),
+	}
+
+	dayIndex := make(map[string]int, days)
+	weekIndex := make(map[string]int)
+	for day := from; !day.After(today); day = day.AddDate(0, 0, 1) {
+		dayIndex[day.Format(readingStatsDayLayout)] = len(stats.Days)
+		stats.Days = append(stats.Days, dtos.ReadingStatsPeriod{Period: day.Format(readingStatsDayLayout)})
+
+		week := isoWeek(day)
+		if _, ok := weekIndex[week]; !ok {
+			weekIndex[week] = len(stats.Weeks)
+			stats.Weeks = append(stats.Weeks, dtos.ReadingStatsPeriod{Period: week})
+		}
+	}
+
+	readingDays := make(map[string]bool)
+	for _, event := range events {
+		readAt := event.CreatedAt.In(location)
+		day := readAt.Format(readingStatsDayLayout)
+		readingDays[day] = true
+
+		i, inPeriod := dayIndex[day]
+		if !inPeriod || event.Event != models.ReadingEventFinished {
+			continue
+		}
+
+		for _, period := range []*dtos.ReadingStatsPeriod{&stats.Days[i], &stats.Weeks[weekIndex[isoWeek(readAt)]]} {
+			period.Chapters++
+			period.Words += int64(event.WordCount)
+			period.Seconds += int64(event.Seconds)
+		}
+		stats.ChaptersRead++
+		stats.WordsRead += int64(event.WordCount)
+		stats.SecondsRead += int64(event.Seconds)
+	}
+
+	stats.CurrentStreak, stats.LongestStreak = readingStreaks(readingDays, today)
+
+	if stats.FavouriteGenres, err = s.repo.GetReadingShares(userID, models.TaxonomyGenres, from, favouriteReadingShares); err != nil {
+		return nil, err
+	}
+	if stats.FavouriteTags, err = s.repo.GetReadingShares(userID, models.TaxonomyTags, from, favouriteReadingShares); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// isoWeek formats the ISO week of a day.
+//
+// Parameters:
+//   - day (time.Time): The day.
+//
+// Returns:
+//   - string: The ISO week, such as 2006-W01.
+func isoWeek(day time.Time) string {
+	year, week := day.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// readingStreaks computes the streaks of consecutive days a user read on. The current streak still counts on a day
+// the user hasn't read yet, as long as they read the day before.
+//
+// Parameters:
+//   - readingDays (map[string]bool): The days the user read on.
+//   - today (time.Time): The start of today.
+//
+// Returns:
+//   - int: The current streak.
+//   - int: The longest streak.
+func readingStreaks(readingDays map[string]bool, today time.Time) (int, int) {
+	current := 0
+	day := today
+	if !readingDays[day.Format(readingStatsDayLayout)] {
+		day = day.AddDate(0, 0, -1)
+	}
+	for readingDays[day.Format(readingStatsDayLayout)] {
+		current++
+		day = day.AddDate(0, 0, -1)
+	}
+
+	longest := 0
+	for value := range readingDays {
+		day, err := time.ParseInLocation(readingStatsDayLayout, value, today.Location())
+		if err != nil || readingDays[day.AddDate(0, 0, -1).Format(readingStatsDayLayout)] {
+			continue
+		}
+
+		// The day starts a streak
+		streak := 0
+		for readingDays[day.Format(readingStatsDayLayout)] {
+			streak++
+			day = day.AddDate(0, 0, 1)
+		}
+		longest = max(longest, streak)
+	}
+
+	return current, longest
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxDeviceIDLength is the maximum number of characters of the identifier of a device.
+const maxDeviceIDLength = 100
+
+// ReadingPositionService syncs where the users are reading their novels across their devices.
+//
+// Fields:
+//   - repo (interfaces.ReadingPositionRepositoryInterface): The repository used to store the reading positions.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to check the novels exist.
+//   - bookmarkRepo (interfaces.BookmarkRepositoryInterface): The repository used to move the bookmarks on as the users
+//     read.
+type ReadingPositionService struct {
+	repo         interfaces.ReadingPositionRepositoryInterface
+	novelRepo    interfaces.NovelRepositoryInterface
+	bookmarkRepo interfaces.BookmarkRepositoryInterface
+}
+
+// NewReadingPositionService creates a new ReadingPositionService instance.
+//
+// Parameters:
+//   - repo (interfaces.ReadingPositionRepositoryInterface): The repository used to store the reading positions.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to check the novels exist.
+//   - bookmarkRepo (interfaces.BookmarkRepositoryInterface): The repository used to move the bookmarks on as the users
+//     read.
+//
+// Returns:
+//   - *ReadingPositionService: A pointer to the newly created ReadingPositionService.
+func NewReadingPositionService(repo interfaces.ReadingPositionRepositoryInterface, novelRepo interfaces.NovelRepositoryInterface, bookmarkRepo interfaces.BookmarkRepositoryInterface) *ReadingPositionService {
+	return &ReadingPositionService{
+		repo:         repo,
+		novelRepo:    novelRepo,
+		bookmarkRepo: bookmarkRepo,
+	}
+}
+
+// SyncReadingPosition stores the reading position of a user in a novel sent from a device. The position read last
+// wins: a position reached before the stored one is not kept, and both outcomes return the losing position. A time
+// in the future, from a device whose clock is ahead, counts as now so it can't keep winning. A position kept further
+// than the bookmark of the novel moves its status on, as updating the bookmark does.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - novelID (uint): The ID of the novel.
+//   - request (dtos.ReadingPositionRequest): The reading position sent by the device.
+//
+// Returns:
+//   - *dtos.ReadingPositionSyncResponse: A pointer to the position kept and the losing position.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidReadingPosition: Returned if the position is invalid.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - GETTING_READING_POSITION, SAVING_READING_POSITION: Returned if the position could not be stored.
+//   - GETTING_BOOKMARK, UPDATING_BOOKMARK: Returned if the bookmark of the novel could not be moved on.
+func (s *ReadingPositionService) SyncReadingPosition(userID, novelID uint, request dtos.ReadingPositionRequest) (*dtos.ReadingPositionSyncResponse, error) {
+	deviceID := strings.TrimSpace(request.DeviceID)
+	if request.ChapterNo == 0 || request.Paragraph < 0 || request.Percent < 0 || request.Percent > 100 ||
+		deviceID == "" || utf8.RuneCountInString(deviceID) > maxDeviceIDLength {
+		return nil, errors.ErrInvalidReadingPosition
+	}
+
+	novel, err := s.novelRepo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// PostgreSQL keeps microseconds, so the times are compared at the precision they are stored with
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	readAt := now
+	if request.ReadAt != nil && request.ReadAt.Before(now) {
+		readAt = request.ReadAt.UTC().Truncate(time.Microsecond)
+	}
+
+	position := models.ReadingPosition{
+		UserID:    userID,
+		NovelID:   novelID,
+		ChapterNo: request.ChapterNo,
+		Paragraph: request.Paragraph,
+		Percent:   request.Percent,
+		DeviceID:  deviceID,
+		ReadAt:    readAt,
+	}
+
+	previous, err := s.repo.GetReadingPosition(userID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark, err := s.bookmarkRepo.GetBookmark(userID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := s.repo.SaveReadingPosition(position)
+	if err != nil {
+		return nil, err
+	}
+
+	// Saving the position moved the current chapter of the bookmark, which may complete the novel
+	if applied && bookmark != nil {
+		progressed := *bookmark
+		progressed.CurrentChapter = int(position.ChapterNo)
+		applyBookmarkProgress(&progressed, bookmark.CurrentChapter, novel)
+		if progressed.Status != bookmark.Status {
+			if _, err := s.bookmarkRepo.UpdateBookmark(progressed); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	current, err := s.repo.GetReadingPosition(userID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dtos.ReadingPositionSyncResponse{Position: *current, Applied: applied}
+	if !applied {
+		response.Superseded = &position
+	} else if previous != nil && previous.DeviceID != deviceID {
+		response.Superseded = previous
+	}
+	return response, nil
+}
+
+// GetReadingPosition retrieves the latest reading position of a user in a novel.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *models.ReadingPosition: A pointer to the reading position.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrReadingPositionNotFound: Returned if the user never synced the novel.
+//   - GETTING_READING_POSITION: Returned if the reading position could not be retrieved.
+func (s *ReadingPositionService) GetReadingPosition(userID, novelID uint) (*models.ReadingPosition, error) {
+	position, err := s.repo.GetReadingPosition(userID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if position == nil {
+		return nil, errors.ErrReadingPositionNotFound
+	}
+	return position, nil
+}
+
+// GetReadingPositions retrieves the latest reading positions of a user in all the novels they synced, most recently
+// read first.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - []models.ReadingPosition: The reading positions of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_READING_POSITION: Returned if the reading positions could not be retrieved.
+func (s *ReadingPositionService) GetReadingPositions(userID uint) ([]models.ReadingPosition, error) {
+	return s.repo.GetReadingPositions(userID)
+}
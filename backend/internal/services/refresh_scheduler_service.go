@@ -0,0 +1,376 @@
+package services
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// novelRefresher is the part of the novel service used to refresh the metadata of the novels.
+type novelRefresher interface {
+	CreateNovel(novelUpdatesID string) (*models.Novel, error)
+}
+
+// chapterEnqueuer is the part of the import job service used to import the new chapters of the novels.
+type chapterEnqueuer interface {
+	GetMissingChapters(novelUpdatesID string, from, to int) (*dtos.MissingChaptersResponse, error)
+	StartImportJob(novelUpdatesID string, from, to int, delta bool) (*models.ImportJob, error)
+}
+
+// RefreshSchedule configures when a group of novels is refreshed.
+//
+// Fields:
+//   - Name (string): The name of the schedule.
+//   - Cron (string): The five-field cron expression of the schedule (e.g., "0 */6 * * *").
+//   - MaxReleaseInterval (float64): The longest release interval in days of the novels refreshed by the schedule. A
+//     novel is refreshed by the first schedule its release frequency fits in, 0 takes the novels of every release
+//     frequency left, including unknown ones.
+type RefreshSchedule struct {
+	Name               string
+	Cron               string
+	MaxReleaseInterval float64
+}
+
+// refreshSchedule is a parsed RefreshSchedule and its state.
+type refreshSchedule struct {
+	RefreshSchedule
+	cron    *utils.CronSchedule
+	nextRun time.Time
+	lastRun *dtos.RefreshRun
+}
+
+// RefreshSchedulerService periodically refreshes the metadata of the novels that are not completed and queues the
+// import of their new chapters. Novels are split between the schedules by release frequency, so novels releasing
+// often can be refreshed more often than the others.
+type RefreshSchedulerService struct {
+	novelRepo        interfaces.NovelRepositoryInterface
+	novelService     novelRefresher
+	importJobService chapterEnqueuer
+	schedules        []*refreshSchedule
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	runMu      sync.Mutex
+	mu         sync.Mutex
+	started    bool
+	currentRun *dtos.RefreshRun
+}
+
+// NewRefreshSchedulerService creates a new RefreshSchedulerService instance. The scheduler doesn't run until Start is
+// called.
+//
+// Parameters:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to find the novels to refresh.
+//   - novelService (novelRefresher): The novel service used to refresh the metadata of the novels.
+//   - importJobService (chapterEnqueuer): The import job service used to import the new chapters.
+//   - schedules ([]RefreshSchedule): The schedules, in the order the novels are matched against them.
+//
+// Returns:
+//   - *RefreshSchedulerService: A pointer to the newly created RefreshSchedulerService.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - INVALID_CRON_EXPRESSION: Returned if the cron expression of a schedule is invalid.
+func NewRefreshSchedulerService(novelRepo interfaces.NovelRepositoryInterface, novelService novelRefresher, importJobService chapterEnqueuer, schedules []RefreshSchedule) (*RefreshSchedulerService, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &RefreshSchedulerService{
+		novelRepo:        novelRepo,
+		novelService:     novelService,
+		importJobService: importJobService,
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+
+	for _, schedule := range schedules {
+		cron, err := utils.ParseCronSchedule(schedule.Cron)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		s.schedules = append(s.schedules, &refreshSchedule{RefreshSchedule: schedule, cron: cron})
+	}
+
+	return s, nil
+}
+
+// Start runs the schedules in the background until Close is called.
+func (s *RefreshSchedulerService) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started || len(s.schedules) == 0 {
+		return
+	}
+	s.started = true
+
+	now := time.Now()
+	for _, schedule := range s.schedules {
+		schedule.nextRun = schedule.cron.Next(now)
+	}
+
+	s.wg.Add(1)
+	go s.loop()
+}
+
+// Close stops the scheduler, interrupting the run in progress, and waits for it to stop.
+func (s *RefreshSchedulerService) Close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// GetStatus returns the status of the scheduler and of each of its schedules.
+//
+// Returns:
+//   - dtos.RefreshSchedulerStatus: The status of the scheduler.
+func (s *RefreshSchedulerService) GetStatus() dtos.RefreshSchedulerStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := dtos.RefreshSchedulerStatus{
+		Running:   s.started && s.ctx.Err() == nil,
+		Schedules: make([]dtos.RefreshScheduleStatus, 0, len(s.schedules)),
+	}
+	if s.currentRun != nil {
+		run := copyRefreshRun(*s.currentRun)
+		status.CurrentRun = &run
+	}
+
+	for _, schedule := range s.schedules {
+		scheduleStatus := dtos.RefreshScheduleStatus{
+			Name:               schedule.Name,
+			Cron:               schedule.cron.String(),
+			MaxReleaseInterval: schedule.MaxReleaseInterval,
+		}
+		if status.Running && !schedule.nextRun.IsZero() {
+			nextRun := schedule.nextRun
+			scheduleStatus.NextRun = &nextRun
+		}
+		if schedule.lastRun != nil {
+			run := copyRefreshRun(*schedule.lastRun)
+			scheduleStatus.LastRun = &run
+		}
+		status.Schedules = append(status.Schedules, scheduleStatus)
+	}
+
+	return status
+}
+
+// RunSchedule refreshes the novels of a schedule right away and queues the import of their new chapters. Only one
+// schedule runs at a time.
+//
+// Parameters:
+//   - name (string): The name of the schedule.
+//
+// Returns:
+//   - *dtos.RefreshRun: A pointer to the outcome of the run.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrRefreshScheduleNotFound: Returned if no schedule has the given name.
+//   - errors.ErrRefreshAlreadyRunning: Returned if a schedule is already running.
+//   - errors.ErrGettingNovels: Returned if the novels to refresh could not be retrieved.
+func (s *RefreshSchedulerService) RunSchedule(name string) (*dtos.RefreshRun, error) {
+	var schedule *refreshSchedule
+	for _, candidate := range s.schedules {
+		if candidate.Name == name {
+			schedule = candidate
+		}
+	}
+	if schedule == nil {
+		return nil, errors.ErrRefreshScheduleNotFound
+	}
+
+	if !s.runMu.TryLock() {
+		return nil, errors.ErrRefreshAlreadyRunning
+	}
+	defer s.runMu.Unlock()
+
+	return s.run(schedule)
+}
+
+// loop waits for the next due schedule and runs it, until the scheduler is closed.
+func (s *RefreshSchedulerService) loop() {
+	defer s.wg.Done()
+
+	for {
+		s.mu.Lock()
+		var next *refreshSchedule
+		for _, schedule := range s.schedules {
+			if !schedule.nextRun.IsZero() && (next == nil || schedule.nextRun.Before(next.nextRun)) {
+				next = schedule
+			}
+		}
+		s.mu.Unlock()
+
+		if next == nil {
+			log.Println("No novel refresh schedule will run again")
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next.nextRun))
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runMu.Lock()
+		if _, err := s.run(next); err != nil {
+			log.Printf("Novel refresh schedule %s failed: %v", next.Name, err)
+		}
+		s.runMu.Unlock()
+
+		s.mu.Lock()
+		next.nextRun = next.cron.Next(time.Now())
+		s.mu.Unlock()
+	}
+}
+
+// run refreshes the novels of a schedule, one at a time, and queues the import of their new chapters. The caller
+// must hold runMu.
+//
+// Parameters:
+//   - schedule (*refreshSchedule): The schedule to run.
+//
+// Returns:
+//   - *dtos.RefreshRun: A pointer to the outcome of the run.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrGettingNovels: Returned if the novels to refresh could not be retrieved.
+func (s *RefreshSchedulerService) run(schedule *refreshSchedule) (*dtos.RefreshRun, error) {
+	novels, err := s.novelRepo.GetOngoingNovels()
+	if err != nil {
+		return nil, err
+	}
+
+	run := &dtos.RefreshRun{Schedule: schedule.Name, StartedAt: time.Now(), Novels: []dtos.NovelStatus{}}
+	s.mu.Lock()
+	s.currentRun = run
+	s.mu.Unlock()
+
+	for _, novel := range novels {
+		if s.ctx.Err() != nil {
+			break
+		}
+		if s.scheduleOf(novel) != schedule {
+			continue
+		}
+
+		status := s.refreshNovel(novel)
+
+		s.mu.Lock()
+		run.Checked++
+		switch status.Status {
+		case "error":
+			run.Failed++
+			run.Novels = append(run.Novels, status)
+		case "updated":
+			run.Updated++
+			run.Novels = append(run.Novels, status)
+		}
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	schedule.lastRun = run
+	s.currentRun = nil
+	s.mu.Unlock()
+
+	log.Printf("Novel refresh schedule %s checked %d novels (%d updated, %d failed)", schedule.Name, run.Checked, run.Updated, run.Failed)
+
+	return run, nil
+}
+
+// refreshNovel refreshes the metadata of a novel and queues the import of the chapters released after its latest
+// stored chapter. The new chapters are worked out from the stored chapters rather than from the latest chapter saved
+// with the novel, so that the chapters of a run whose job could not be queued are queued again by the next run.
+//
+// Parameters:
+//   - novel (models.Novel): The novel as it was before the refresh.
+//
+// Returns:
+//   - dtos.NovelStatus: The outcome of the refresh, with the status "unchanged", "updated" or "error".
+func (s *RefreshSchedulerService) refreshNovel(novel models.Novel) dtos.NovelStatus {
+	updated, err := s.novelService.CreateNovel(novel.NovelUpdatesID)
+	if err != nil {
+		return dtos.NovelStatus{NovelUpdatesId: novel.NovelUpdatesID, Status: "error", Message: err.Error()}
+	}
+
+	if updated.LatestChapter <= 0 {
+		return dtos.NovelStatus{NovelUpdatesId: novel.NovelUpdatesID, Status: "unchanged"}
+	}
+
+	// Only the latest stored chapter is needed, which is reported whatever the range
+	missing, err := s.importJobService.GetMissingChapters(novel.NovelUpdatesID, updated.LatestChapter, updated.LatestChapter)
+	if err != nil {
+		return dtos.NovelStatus{NovelUpdatesId: novel.NovelUpdatesID, Status: "error", Message: err.Error()}
+	}
+
+	from, to := missing.LatestStoredChapter+1, min(updated.LatestChapter, missing.LatestStoredChapter+maxImportJobChapters)
+	if from > to {
+		return dtos.NovelStatus{NovelUpdatesId: novel.NovelUpdatesID, Status: "unchanged"}
+	}
+
+	job, err := s.importJobService.StartImportJob(novel.NovelUpdatesID, from, to, true)
+	if err != nil {
+		return dtos.NovelStatus{
+			NovelUpdatesId: novel.NovelUpdatesID,
+			Status:         "error",
+			Message:        fmt.Sprintf("Failed to queue chapters %d-%d: %s", from, to, err.Error()),
+		}
+	}
+
+	s.mu.Lock()
+	s.currentRun.NewChapters += len(job.Chapters)
+	s.mu.Unlock()
+
+	return dtos.NovelStatus{
+		NovelUpdatesId: novel.NovelUpdatesID,
+		Status:         "updated",
+		Message:        fmt.Sprintf("Import job %d queued %d new chapters", job.ID, len(job.Chapters)),
+	}
+}
+
+// scheduleOf returns the schedule refreshing a novel, nil if none of the schedules takes its release frequency.
+//
+// Parameters:
+//   - novel (models.Novel): The novel.
+//
+// Returns:
+//   - *refreshSchedule: The first schedule the release frequency of the novel fits in.
+func (s *RefreshSchedulerService) scheduleOf(novel models.Novel) *refreshSchedule {
+	days, known := utils.ParseReleaseFrequency(novel.ReleaseFrequency)
+
+	for _, schedule := range s.schedules {
+		if schedule.MaxReleaseInterval <= 0 || (known && days <= schedule.MaxReleaseInterval) {
+			return schedule
+		}
+	}
+	return nil
+}
+
+// copyRefreshRun copies a run so it can be read while the original is updated.
+//
+// Parameters:
+//   - run (dtos.RefreshRun): The run to copy.
+//
+// Returns:
+//   - dtos.RefreshRun: The copy of the run.
+func copyRefreshRun(run dtos.RefreshRun) dtos.RefreshRun {
+	run.Novels = append([]dtos.NovelStatus(nil), run.Novels...)
+	return run
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+)
+
+// SearchService handles the full-text search of the novels and of the chapters of a novel.
+//
+// Fields:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to check the novels exist.
+//   - searchRepo (interfaces.SearchRepositoryInterface): The repository used to search.
+type SearchService struct {
+	novelRepo  interfaces.NovelRepositoryInterface
+	searchRepo interfaces.SearchRepositoryInterface
+}
+
+// NewSearchService creates a new SearchService instance.
+//
+// Parameters:
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to check the novels exist.
+//   - searchRepo (interfaces.SearchRepositoryInterface): The repository used to search.
+//
+// Returns:
+//   - *SearchService: A pointer to the newly created SearchService.
+func NewSearchService(novelRepo interfaces.NovelRepositoryInterface, searchRepo interfaces.SearchRepositoryInterface) *SearchService {
+	return &SearchService{
+		novelRepo:  novelRepo,
+		searchRepo: searchRepo,
+	}
+}
+
+// SearchNovels searches the titles, synopses and authors of the novels, most relevant first.
+//
+// Parameters:
+//   - query (string): The search query. Every word must match a word, or the beginning of one.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of novels to return per page.
+//
+// Returns:
+//   - []models.NovelSearchResult: A slice of the matching novels with highlighted snippets.
+//   - int64: The total number of matching novels.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrSearchQueryRequired: Returned if the query has no words.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - SEARCHING_NOVELS: Returned if an error occurs while searching the novels.
+func (s *SearchService) SearchNovels(query string, page, limit int) ([]models.NovelSearchResult, int64, error) {
+	terms := utils.SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, 0, errors.ErrSearchQueryRequired
+	}
+
+	return s.searchRepo.SearchNovels(terms, page, limit)
+}
+
+// SearchChapters searches the text of the chapters of a novel, most relevant first.
+//
+// Parameters:
+//   - novelID (uint): The ID of the novel.
+//   - query (string): The search query. Every word must match a word, or the beginning of one.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of chapters to return per page.
+//
+// Returns:
+//   - []models.ChapterSearchResult: A slice of the matching chapters with highlighted snippets.
+//   - int64: The total number of matching chapters.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrSearchQueryRequired: Returned if the query has no words.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - SEARCHING_CHAPTERS: Returned if an error occurs while searching the chapters.
+func (s *SearchService) SearchChapters(novelID uint, query string, page, limit int) ([]models.ChapterSearchResult, int64, error) {
+	terms := utils.SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, 0, errors.ErrSearchQueryRequired
+	}
+
+	if _, err := s.novelRepo.GetNovelByID(novelID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.searchRepo.SearchChapters(novelID, terms, page, limit)
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"backend/internal/validators"
+	"strings"
+)
+
+// ShelfService manages the shelves the users organize their libraries with. A novel can be on any number of shelves,
+// whatever the status of its bookmark, or without being bookmarked.
+//
+// Fields:
+//   - repo (interfaces.ShelfRepositoryInterface): The repository used to store the shelves.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to check the novels exist.
+type ShelfService struct {
+	repo      interfaces.ShelfRepositoryInterface
+	novelRepo interfaces.NovelRepositoryInterface
+}
+
+// NewShelfService creates a new ShelfService instance.
+//
+// Parameters:
+//   - repo (interfaces.ShelfRepositoryInterface): The repository used to store the shelves.
+//   - novelRepo (interfaces.NovelRepositoryInterface): The repository used to check the novels exist.
+//
+// Returns:
+//   - *ShelfService: A pointer to the newly created ShelfService.
+func NewShelfService(repo interfaces.ShelfRepositoryInterface, novelRepo interfaces.NovelRepositoryInterface) *ShelfService {
+	return &ShelfService{
+		repo:      repo,
+		novelRepo: novelRepo,
+	}
+}
+
+// GetShelves retrieves the shelves of a user with their novels, ordered by name.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//
+// Returns:
+//   - []models.Shelf: The shelves of the user.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES: Returned if the shelves could not be retrieved.
+func (s *ShelfService) GetShelves(userID uint) ([]models.Shelf, error) {
+	return s.repo.GetShelves(userID)
+}
+
+// GetShelf retrieves a shelf of a user with its novels.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the shelf.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES: Returned if the shelf could not be retrieved.
+func (s *ShelfService) GetShelf(userID, shelfID uint) (*models.Shelf, error) {
+	shelf, err := s.repo.GetShelf(userID, shelfID)
+	if err != nil {
+		return nil, err
+	}
+	if shelf == nil {
+		return nil, errors.ErrShelfNotFound
+	}
+	return shelf, nil
+}
+
+// CreateShelf creates an empty shelf for a user.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - name (string): The name of the shelf, trimmed before it's stored.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the created shelf.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidShelf: Returned if the name is blank or too long.
+//   - errors.ErrShelfConflict: Returned if the user already has a shelf with this name.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES, SAVING_SHELF: Returned if the shelf could not be stored.
+func (s *ShelfService) CreateShelf(userID uint, name string) (*models.Shelf, error) {
+	name = strings.TrimSpace(name)
+	if err := s.checkShelfName(userID, 0, name); err != nil {
+		return nil, err
+	}
+
+	shelf, err := s.repo.CreateShelf(models.Shelf{UserID: userID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	shelf.Novels = []models.Novel{}
+	return shelf, nil
+}
+
+// RenameShelf changes the name of a shelf of a user.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//   - name (string): The new name of the shelf, trimmed before it's stored.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the renamed shelf.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidShelf: Returned if the name is blank or too long.
+//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+//   - errors.ErrShelfConflict: Returned if the user already has another shelf with this name.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES, SAVING_SHELF: Returned if the shelf could not be renamed.
+func (s *ShelfService) RenameShelf(userID, shelfID uint, name string) (*models.Shelf, error) {
+	name = strings.TrimSpace(name)
+	shelf, err := s.GetShelf(userID, shelfID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkShelfName(userID, shelfID, name); err != nil {
+		return nil, err
+	}
+	if err := s.repo.RenameShelf(shelfID, name); err != nil {
+		return nil, err
+	}
+
+	shelf.Name = name
+	return shelf, nil
+}
+
+// DeleteShelf deletes a shelf of a user. The novels on it are only taken off the shelf.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES, DELETING_SHELF: Returned if the shelf could not be deleted.
+func (s *ShelfService) DeleteShelf(userID, shelfID uint) error {
+	shelf, err := s.GetShelf(userID, shelfID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteShelf(shelf)
+}
+
+// AddNovelToShelf puts a novel on a shelf of a user. Putting a novel already on the shelf changes nothing.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the shelf with its novels.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES, SAVING_SHELF: Returned if the novel could not be put on the shelf.
+func (s *ShelfService) AddNovelToShelf(userID, shelfID, novelID uint) (*models.Shelf, error) {
+	shelf, novel, err := s.getShelfAndNovel(userID, shelfID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AddNovelToShelf(shelf, novel); err != nil {
+		return nil, err
+	}
+	return s.GetShelf(userID, shelfID)
+}
+
+// RemoveNovelFromShelf takes a novel off a shelf of a user. Taking off a novel that isn't on the shelf changes nothing.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the shelf with its novels.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrShelfNotFound: Returned if the user has no shelf with this ID.
+//   - errors.ErrNovelNotFound: Returned if the novel doesn't exist.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_SHELVES, SAVING_SHELF: Returned if the novel could not be taken off the shelf.
+func (s *ShelfService) RemoveNovelFromShelf(userID, shelfID, novelID uint) (*models.Shelf, error) {
+	shelf, novel, err := s.getShelfAndNovel(userID, shelfID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RemoveNovelFromShelf(shelf, novel); err != nil {
+		return nil, err
+	}
+	return s.GetShelf(userID, shelfID)
+}
+
+// checkShelfName checks a name is valid and no other shelf of the user has it.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf named, or 0 for a new shelf.
+//   - name (string): The trimmed name.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the name can be used.
+func (s *ShelfService) checkShelfName(userID, shelfID uint, name string) error {
+	if err := validators.ValidateShelfName(name); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetShelfByName(userID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != shelfID {
+		return errors.ErrShelfConflict
+	}
+	return nil
+}
+
+// getShelfAndNovel retrieves a shelf of a user and a novel to put on it or take off it.
+//
+// Parameters:
+//   - userID (uint): The ID of the user.
+//   - shelfID (uint): The ID of the shelf.
+//   - novelID (uint): The ID of the novel.
+//
+// Returns:
+//   - *models.Shelf: A pointer to the shelf.
+//   - *models.Novel: A pointer to the novel.
+//   - error: An error object indicating the type of error encountered, or nil if both exist.
+func (s *ShelfService) getShelfAndNovel(userID, shelfID, novelID uint) (*models.Shelf, *models.Novel, error) {
+	shelf, err := s.GetShelf(userID, shelfID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	novel, err := s.novelRepo.GetNovelByID(novelID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shelf, novel, nil
+}
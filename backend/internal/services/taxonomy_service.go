@@ -0,0 +1,164 @@
+package services
+
+import (
+	"backend/internal/models"
+	"backend/internal/repositories/interfaces"
+	"backend/internal/types/errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTaxonomyDescriptionLength is the maximum number of characters of the description of a genre or tag.
+const maxTaxonomyDescriptionLength = 1000
+
+// TaxonomyService handles the browsing of the genres, tags and authors the novels are classified by, and their
+// curation by the administrators.
+//
+// Fields:
+//   - taxonomyRepo (interfaces.TaxonomyRepositoryInterface): The repository used to manage the entries.
+type TaxonomyService struct {
+	taxonomyRepo interfaces.TaxonomyRepositoryInterface
+}
+
+// NewTaxonomyService creates a new TaxonomyService instance.
+//
+// Parameters:
+//   - taxonomyRepo (interfaces.TaxonomyRepositoryInterface): The repository used to manage the entries.
+//
+// Returns:
+//   - *TaxonomyService: A pointer to the newly created TaxonomyService.
+func NewTaxonomyService(taxonomyRepo interfaces.TaxonomyRepositoryInterface) *TaxonomyService {
+	return &TaxonomyService{taxonomyRepo: taxonomyRepo}
+}
+
+// GetEntries retrieves a paginated list of the entries of a taxonomy, ordered by name, with the number of novels of
+// each.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entries.
+//   - search (string): The text the names must contain, ignoring case. Empty to retrieve all the entries.
+//   - page (int): The page number for pagination (1-based).
+//   - limit (int): The maximum number of entries to return per page.
+//
+// Returns:
+//   - []models.TaxonomyEntry: A slice of entries.
+//   - int64: The total number of matching entries.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - GETTING_GENRES, GETTING_TAGS, GETTING_AUTHORS: Returned if an error occurs while retrieving the entries.
+func (s *TaxonomyService) GetEntries(kind models.TaxonomyKind, search string, page, limit int) ([]models.TaxonomyEntry, int64, error) {
+	if !kind.IsValid() {
+		return nil, 0, errors.ErrInvalidTaxonomyKind
+	}
+
+	return s.taxonomyRepo.GetEntries(kind, strings.TrimSpace(search), page, limit)
+}
+
+// GetEntry retrieves an entry of a taxonomy with the number of novels it is associated with.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entry.
+//   - id (uint): The ID of the entry.
+//
+// Returns:
+//   - *models.TaxonomyEntry: The entry.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if the entry doesn't exist.
+//   - GETTING_TAXONOMY_ENTRY: Returned if an error occurs while retrieving the entry.
+func (s *TaxonomyService) GetEntry(kind models.TaxonomyKind, id uint) (*models.TaxonomyEntry, error) {
+	if !kind.IsValid() {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	return s.taxonomyRepo.GetEntry(kind, id)
+}
+
+// UpdateEntryDescription changes the description of a genre or tag.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entry, genres or tags.
+//   - id (uint): The ID of the entry.
+//   - description (string): The new description, at most 1000 characters long.
+//
+// Returns:
+//   - *models.TaxonomyEntry: The updated entry.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrInvalidTaxonomyDescription: Returned if the description is too long or the entry is an author.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if the entry doesn't exist.
+//   - UPDATING_TAXONOMY_ENTRY: Returned if an error occurs while updating the entry.
+func (s *TaxonomyService) UpdateEntryDescription(kind models.TaxonomyKind, id uint, description string) (*models.TaxonomyEntry, error) {
+	if !kind.IsValid() {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	description = strings.TrimSpace(description)
+	if !kind.HasDescription() || utf8.RuneCountInString(description) > maxTaxonomyDescriptionLength {
+		return nil, errors.ErrInvalidTaxonomyDescription
+	}
+
+	return s.taxonomyRepo.UpdateEntryDescription(kind, id, description)
+}
+
+// MergeEntries merges an entry of a taxonomy into another, such as a duplicate spelled differently. The novels of the
+// merged entry are associated with the entry it is merged into instead, and the merged entry is deleted.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entries.
+//   - sourceID (uint): The ID of the entry merged and deleted.
+//   - targetID (uint): The ID of the entry kept.
+//
+// Returns:
+//   - *models.TaxonomyEntry: The entry kept, with the novels of both entries.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrMergeTaxonomyEntryIntoItself: Returned if both IDs are the same.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if either entry doesn't exist.
+//   - MERGING_TAXONOMY_ENTRIES: Returned if an error occurs while merging the entries.
+func (s *TaxonomyService) MergeEntries(kind models.TaxonomyKind, sourceID, targetID uint) (*models.TaxonomyEntry, error) {
+	if !kind.IsValid() {
+		return nil, errors.ErrInvalidTaxonomyKind
+	}
+
+	if sourceID == targetID {
+		return nil, errors.ErrMergeTaxonomyEntryIntoItself
+	}
+
+	return s.taxonomyRepo.MergeEntries(kind, sourceID, targetID)
+}
+
+// DeleteEntry deletes an entry of a taxonomy no novel is associated with.
+//
+// Parameters:
+//   - kind (models.TaxonomyKind): The taxonomy of the entry.
+//   - id (uint): The ID of the entry.
+//
+// Returns:
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - errors.ErrInvalidTaxonomyKind: Returned if the kind is not a taxonomy.
+//   - errors.ErrDatabaseOffline: Returned if the database is offline.
+//   - errors.ErrTaxonomyEntryNotFound: Returned if the entry doesn't exist.
+//   - errors.ErrTaxonomyEntryInUse: Returned if novels are still associated with the entry.
+//   - DELETING_TAXONOMY_ENTRY: Returned if an error occurs while deleting the entry.
+func (s *TaxonomyService) DeleteEntry(kind models.TaxonomyKind, id uint) error {
+	if !kind.IsValid() {
+		return errors.ErrInvalidTaxonomyKind
+	}
+
+	return s.taxonomyRepo.DeleteEntry(kind, id)
+}
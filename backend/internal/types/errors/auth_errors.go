@@ -13,6 +13,7 @@ const (
 	PASSWORD_DIFF         = "PASSWORD_DIFF"
 	HASH_PASSWORD         = "HASH_PASSWORD"
 	INVALID_CREDENTIALS   = "INVALID_CREDENTIALS"
+	UNAUTHENTICATED       = "UNAUTHENTICATED"
 )
 
 var (
@@ -44,4 +45,11 @@ var (
 		StatusCode: http.StatusUnauthorized,
 		Code:       INVALID_CREDENTIALS,
 	}
+
+	// Authentication errors
+	ErrUnauthenticated = &types.MyCustomError{
+		Message:    "Unauthenticated",
+		StatusCode: http.StatusUnauthorized,
+		Code:       UNAUTHENTICATED,
+	}
 )
@@ -17,6 +17,9 @@ const (
 	GETTING_TOTAL_BOOKMARKS   = "GETTING_TOTAL_BOOKMARKS"
 	UPDATING_BOOKMARK         = "UPDATING_BOOKMARK"
 	DELETING_BOOKMARK         = "DELETING_BOOKMARK"
+	INVALID_BOOKMARK          = "INVALID_BOOKMARK"
+	INVALID_BOOKMARK_STATUS   = "INVALID_BOOKMARK_STATUS"
+	INVALID_BOOKMARK_SCORE    = "INVALID_BOOKMARK_SCORE"
 )
 
 var (
@@ -40,4 +43,19 @@ var (
 		StatusCode: http.StatusNotFound,
 		Code:       NO_BOOKMARKS,
 	}
+	ErrInvalidBookmark = &types.MyCustomError{
+		Message:    "Invalid bookmark (it needs a novel and a current chapter that isn't negative)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_BOOKMARK,
+	}
+	ErrInvalidBookmarkStatus = &types.MyCustomError{
+		Message:    "Invalid bookmark status (it must be reading, completed, on-hold, dropped or plan-to-read)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_BOOKMARK_STATUS,
+	}
+	ErrInvalidBookmarkScore = &types.MyCustomError{
+		Message:    "Invalid bookmark score (it must be between 0, not rated, and 5)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_BOOKMARK_SCORE,
+	}
 )
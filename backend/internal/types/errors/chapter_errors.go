@@ -8,6 +8,7 @@ import (
 const (
 	// Chapter errors
 	CHAPTER_NOT_FOUND        = "CHAPTER_NOT_FOUND"
+	CHAPTER_EMPTY            = "CHAPTER_EMPTY"
 	CHAPTER_ALREADY_IMPORTED = "CHAPTER_ALREADY_IMPORTED"
 	CHAPTER_CONFLICT         = "CHAPTER_CONFLICT"
 	NO_CHAPTERS              = "NO_CHAPTERS"
@@ -15,6 +16,13 @@ const (
 	GETTING_CHAPTERS         = "GETTING_CHAPTERS"
 	GETTING_CHAPTER          = "GETTING_CHAPTER"
 	GETTING_TOTAL_CHAPTERS   = "GETTING_TOTAL_CHAPTERS"
+
+	// Chapter management errors
+	INVALID_CHAPTER             = "INVALID_CHAPTER"
+	INVALID_CHAPTER_RENUMBERING = "INVALID_CHAPTER_RENUMBERING"
+	DELETING_CHAPTERS           = "DELETING_CHAPTERS"
+	RENUMBERING_CHAPTERS        = "RENUMBERING_CHAPTERS"
+	INSERTING_CHAPTER           = "INSERTING_CHAPTER"
 )
 
 var (
@@ -23,6 +31,11 @@ var (
 		StatusCode: http.StatusNotFound,
 		Code:       CHAPTER_NOT_FOUND,
 	}
+	ErrChapterEmpty = &types.MyCustomError{
+		Message:    "Chapter has no content",
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       CHAPTER_EMPTY,
+	}
 	ErrChapterAlreadyImported = &types.MyCustomError{
 		Message:    "Chapter already imported",
 		StatusCode: http.StatusConflict,
@@ -38,4 +51,19 @@ var (
 		StatusCode: http.StatusNotFound,
 		Code:       NO_CHAPTERS,
 	}
+	ErrInvalidChapter = &types.MyCustomError{
+		Message:    "A chapter needs a number, a title of at most 255 characters and a body",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_CHAPTER,
+	}
+	ErrInvalidChapterRenumbering = &types.MyCustomError{
+		Message:    "The chapters must be moved by a non-zero offset that keeps their numbers positive",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_CHAPTER_RENUMBERING,
+	}
+	ErrChapterRenumberingConflict = &types.MyCustomError{
+		Message:    "Other chapters already have the new numbers",
+		StatusCode: http.StatusConflict,
+		Code:       CHAPTER_CONFLICT,
+	}
 )
@@ -0,0 +1,34 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Chapter revision errors
+	CHAPTER_REVISION_NOT_FOUND = "CHAPTER_REVISION_NOT_FOUND"
+	CHAPTER_REVISION_UNCHANGED = "CHAPTER_REVISION_UNCHANGED"
+	INVALID_CHAPTER_REVISION   = "INVALID_CHAPTER_REVISION"
+	CREATING_CHAPTER_REVISION  = "CREATING_CHAPTER_REVISION"
+	GETTING_CHAPTER_REVISIONS  = "GETTING_CHAPTER_REVISIONS"
+	UPDATING_CHAPTER_REVISION  = "UPDATING_CHAPTER_REVISION"
+)
+
+var (
+	ErrChapterRevisionNotFound = &types.MyCustomError{
+		Message:    "Chapter revision not found",
+		StatusCode: http.StatusNotFound,
+		Code:       CHAPTER_REVISION_NOT_FOUND,
+	}
+	ErrChapterRevisionUnchanged = &types.MyCustomError{
+		Message:    "The scraped content is identical to an existing revision",
+		StatusCode: http.StatusConflict,
+		Code:       CHAPTER_REVISION_UNCHANGED,
+	}
+	ErrInvalidChapterRevision = &types.MyCustomError{
+		Message:    "Invalid chapter revision",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_CHAPTER_REVISION,
+	}
+)
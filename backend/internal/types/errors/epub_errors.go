@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// EPUB errors
+	EPUB_REQUIRED        = "EPUB_REQUIRED"
+	EPUB_TOO_LARGE       = "EPUB_TOO_LARGE"
+	INVALID_EPUB         = "INVALID_EPUB"
+	EPUB_NOT_CHAPTER     = "EPUB_NOT_CHAPTER"
+	PARSING_EPUB_CHAPTER = "PARSING_EPUB_CHAPTER"
+	SAVING_EPUB_COVER    = "SAVING_EPUB_COVER"
+	EPUB_NOVEL_CONFLICT  = "EPUB_NOVEL_CONFLICT"
+
+	// EPUB export errors
+	WRITING_EPUB        = "WRITING_EPUB"
+	FETCHING_EPUB_COVER = "FETCHING_EPUB_COVER"
+)
+
+var (
+	ErrEPUBRequired = &types.MyCustomError{
+		Message:    "An EPUB file is required",
+		StatusCode: http.StatusBadRequest,
+		Code:       EPUB_REQUIRED,
+	}
+	ErrEPUBTooLarge = &types.MyCustomError{
+		Message:    "EPUB file is too large",
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Code:       EPUB_TOO_LARGE,
+	}
+	ErrInvalidEPUB = &types.MyCustomError{
+		Message:    "File is not a valid EPUB",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_EPUB,
+	}
+	ErrEPUBNovelConflict = &types.MyCustomError{
+		Message:    "A novel imported from its source already has this ID, choose another novel_updates_id",
+		StatusCode: http.StatusConflict,
+		Code:       EPUB_NOVEL_CONFLICT,
+	}
+)
@@ -0,0 +1,22 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Feed errors
+	INVALID_FEED_TOKEN    = "INVALID_FEED_TOKEN"
+	GETTING_FEED_TOKEN    = "GETTING_FEED_TOKEN"
+	SAVING_FEED_TOKEN     = "SAVING_FEED_TOKEN"
+	GENERATING_FEED_TOKEN = "GENERATING_FEED_TOKEN"
+)
+
+var (
+	ErrInvalidFeedToken = &types.MyCustomError{
+		Message:    "Invalid feed token",
+		StatusCode: http.StatusUnauthorized,
+		Code:       INVALID_FEED_TOKEN,
+	}
+)
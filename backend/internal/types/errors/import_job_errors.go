@@ -0,0 +1,59 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Import job errors
+	IMPORT_JOB_NOT_FOUND       = "IMPORT_JOB_NOT_FOUND"
+	IMPORT_JOB_ALREADY_RUNNING = "IMPORT_JOB_ALREADY_RUNNING"
+	IMPORT_JOB_NOT_CANCELLABLE = "IMPORT_JOB_NOT_CANCELLABLE"
+	IMPORT_JOB_NOT_RESUMABLE   = "IMPORT_JOB_NOT_RESUMABLE"
+	IMPORT_JOB_CANCELLED       = "IMPORT_JOB_CANCELLED"
+	INVALID_CHAPTER_RANGE      = "INVALID_CHAPTER_RANGE"
+	NO_MISSING_CHAPTERS        = "NO_MISSING_CHAPTERS"
+	CREATING_IMPORT_JOB        = "CREATING_IMPORT_JOB"
+	GETTING_IMPORT_JOB         = "GETTING_IMPORT_JOB"
+	GETTING_IMPORT_JOBS        = "GETTING_IMPORT_JOBS"
+	UPDATING_IMPORT_JOB        = "UPDATING_IMPORT_JOB"
+)
+
+var (
+	ErrImportJobNotFound = &types.MyCustomError{
+		Message:    "Import job not found",
+		StatusCode: http.StatusNotFound,
+		Code:       IMPORT_JOB_NOT_FOUND,
+	}
+	ErrImportJobAlreadyRunning = &types.MyCustomError{
+		Message:    "An import job is already running for this novel",
+		StatusCode: http.StatusConflict,
+		Code:       IMPORT_JOB_ALREADY_RUNNING,
+	}
+	ErrImportJobNotCancellable = &types.MyCustomError{
+		Message:    "Only queued or running import jobs can be cancelled",
+		StatusCode: http.StatusConflict,
+		Code:       IMPORT_JOB_NOT_CANCELLABLE,
+	}
+	ErrImportJobNotResumable = &types.MyCustomError{
+		Message:    "Only finished import jobs can be resumed",
+		StatusCode: http.StatusConflict,
+		Code:       IMPORT_JOB_NOT_RESUMABLE,
+	}
+	ErrImportJobCancelled = &types.MyCustomError{
+		Message:    "Import job cancelled",
+		StatusCode: http.StatusConflict,
+		Code:       IMPORT_JOB_CANCELLED,
+	}
+	ErrInvalidChapterRange = &types.MyCustomError{
+		Message:    "Invalid chapter range",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_CHAPTER_RANGE,
+	}
+	ErrNoMissingChapters = &types.MyCustomError{
+		Message:    "All the chapters of the range are already imported",
+		StatusCode: http.StatusConflict,
+		Code:       NO_MISSING_CHAPTERS,
+	}
+)
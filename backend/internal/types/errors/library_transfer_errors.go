@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Library import and export errors
+	INVALID_LIBRARY_FORMAT   = "INVALID_LIBRARY_FORMAT"
+	INVALID_LIBRARY_FILE     = "INVALID_LIBRARY_FILE"
+	LIBRARY_IMPORT_TOO_LARGE = "LIBRARY_IMPORT_TOO_LARGE"
+	EXPORTING_LIBRARY        = "EXPORTING_LIBRARY"
+	LIBRARY_CREATE_FORBIDDEN = "LIBRARY_CREATE_FORBIDDEN"
+	LIBRARY_CREATE_LIMIT     = "LIBRARY_CREATE_LIMIT"
+)
+
+var (
+	ErrInvalidLibraryFormat = &types.MyCustomError{
+		Message:    "Invalid library format (it must be json or csv)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_LIBRARY_FORMAT,
+	}
+	ErrInvalidLibraryFile = &types.MyCustomError{
+		Message:    "Invalid library file (it must be a JSON array of novels, or a CSV file with a header naming a novel_updates_id or a title column)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_LIBRARY_FILE,
+	}
+	ErrLibraryImportTooLarge = &types.MyCustomError{
+		Message:    "The library file has too many novels (at most 5000 are imported at once)",
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Code:       LIBRARY_IMPORT_TOO_LARGE,
+	}
+	ErrLibraryCreateForbidden = &types.MyCustomError{
+		Message:    "Only users allowed to create novels can import the unknown novels of a library",
+		StatusCode: http.StatusForbidden,
+		Code:       LIBRARY_CREATE_FORBIDDEN,
+	}
+	ErrLibraryCreateLimit = &types.MyCustomError{
+		Message:    "Too many unknown novels were imported from NovelUpdates at once (at most 20), import the library again for the others",
+		StatusCode: http.StatusTooManyRequests,
+		Code:       LIBRARY_CREATE_LIMIT,
+	}
+)
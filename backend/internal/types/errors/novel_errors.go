@@ -9,14 +9,17 @@ const (
 	// Author errors
 	INVALID_AUTHOR           = "INVALID_AUTHOR"
 	AUTHOR_ASSOCIATION_ERROR = "AUTHOR_ASSOCIATION_ERROR"
+	GETTING_AUTHORS          = "GETTING_AUTHORS"
 
 	// Genre errors
 	INVALID_GENRE           = "INVALID_GENRE"
 	GENRE_ASSOCIATION_ERROR = "GENRE_ASSOCIATION_ERROR"
+	GETTING_GENRES          = "GETTING_GENRES"
 
 	// Tag errors
 	INVALID_TAG           = "INVALID_TAG"
 	TAG_ASSOCIATION_ERROR = "TAG_ASSOCIATION_ERROR"
+	GETTING_TAGS          = "GETTING_TAGS"
 
 	// NovelUpdatesId errors
 	INVALID_NOVEL_UPDATES_ID = "INVALID_NOVEL_UPDATES_ID"
@@ -33,6 +36,12 @@ const (
 	GETTING_NOVELS         = "GETTING_NOVELS"
 	GETTING_NOVEL          = "GETTING_NOVEL"
 	GETTING_TOTAL_NOVELS   = "GETTING_TOTAL_NOVELS"
+
+	// Novel edition errors
+	INVALID_NOVEL_UPDATE = "INVALID_NOVEL_UPDATE"
+	INVALID_LOCKED_FIELD = "INVALID_LOCKED_FIELD"
+	UPDATING_NOVEL       = "UPDATING_NOVEL"
+	DELETING_NOVEL       = "DELETING_NOVEL"
 )
 
 var (
@@ -126,4 +135,34 @@ var (
 		StatusCode: http.StatusInternalServerError,
 		Code:       GETTING_TOTAL_NOVELS,
 	}
+	ErrInvalidNovelUpdate = &types.MyCustomError{
+		Message:    "Invalid novel update",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_UPDATE,
+	}
+	ErrIncompleteNovelUpdate = &types.MyCustomError{
+		Message:    "Every editable field of the novel is required to replace it",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_UPDATE,
+	}
+	ErrNovelTitleRequired = &types.MyCustomError{
+		Message:    "Title is required",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_UPDATE,
+	}
+	ErrNovelFieldTooLong = &types.MyCustomError{
+		Message:    "The title must be at most 200 characters long, the synopsis 25000, the status 500 and the other fields 255",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_UPDATE,
+	}
+	ErrInvalidLockedField = &types.MyCustomError{
+		Message:    "Only the title, synopsis, coverUrl, language, status, year, releaseFrequency, tags, genres and authors can be locked",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_LOCKED_FIELD,
+	}
+	ErrNovelTitleTaken = &types.MyCustomError{
+		Message:    "Another novel already has this title",
+		StatusCode: http.StatusConflict,
+		Code:       NOVEL_CONFLICT,
+	}
 )
@@ -0,0 +1,39 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Novel query errors
+	INVALID_NOVEL_QUERY = "INVALID_NOVEL_QUERY"
+)
+
+var (
+	ErrInvalidNovelSort = &types.MyCustomError{
+		Message:    "Sort must be one of title, created, updated, latest_chapter, year or popularity",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_QUERY,
+	}
+	ErrInvalidNovelOrder = &types.MyCustomError{
+		Message:    "Order must be asc or desc",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_QUERY,
+	}
+	ErrInvalidNovelMatch = &types.MyCustomError{
+		Message:    "Match must be all or any",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_QUERY,
+	}
+	ErrInvalidYearRange = &types.MyCustomError{
+		Message:    "The first year cannot be after the last year",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_QUERY,
+	}
+	ErrInvalidMinChapters = &types.MyCustomError{
+		Message:    "The minimum number of chapters cannot be negative",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_NOVEL_QUERY,
+	}
+)
@@ -10,6 +10,7 @@ const (
 	INVALID_LIMIT     = "INVALID_LIMIT"
 	PAGE_OUT_OF_RANGE = "PAGE_OUT_OF_RANGE"
 	NO_RESULTS        = "NO_RESULTS"
+	INVALID_CURSOR    = "INVALID_CURSOR"
 )
 
 var (
@@ -33,4 +34,9 @@ var (
 		StatusCode: http.StatusNotFound,
 		Code:       NO_RESULTS,
 	}
+	ErrInvalidCursor = &types.MyCustomError{
+		Message:    "Invalid cursor (it must be one returned by the same listing, with the same sort)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_CURSOR,
+	}
 )
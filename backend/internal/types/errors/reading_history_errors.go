@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Reading history errors
+	INVALID_READING_STATS  = "INVALID_READING_STATS"
+	GETTING_READING_EVENTS = "GETTING_READING_EVENTS"
+	SAVING_READING_EVENT   = "SAVING_READING_EVENT"
+)
+
+var (
+	ErrInvalidReadingStatsPeriod = &types.MyCustomError{
+		Message:    "Invalid reading statistics period (it must be between 1 and 365 days)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_READING_STATS,
+	}
+	ErrInvalidReadingStatsTimezone = &types.MyCustomError{
+		Message:    "Invalid reading statistics timezone (it must be an IANA timezone such as Europe/Madrid)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_READING_STATS,
+	}
+)
@@ -0,0 +1,27 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Reading position errors
+	INVALID_READING_POSITION   = "INVALID_READING_POSITION"
+	READING_POSITION_NOT_FOUND = "READING_POSITION_NOT_FOUND"
+	GETTING_READING_POSITION   = "GETTING_READING_POSITION"
+	SAVING_READING_POSITION    = "SAVING_READING_POSITION"
+)
+
+var (
+	ErrInvalidReadingPosition = &types.MyCustomError{
+		Message:    "Invalid reading position (it needs a chapter number, a paragraph index, a percent between 0 and 100 and a device ID)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_READING_POSITION,
+	}
+	ErrReadingPositionNotFound = &types.MyCustomError{
+		Message:    "The novel has no reading position",
+		StatusCode: http.StatusNotFound,
+		Code:       READING_POSITION_NOT_FOUND,
+	}
+)
@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Scheduler errors
+	INVALID_CRON_EXPRESSION    = "INVALID_CRON_EXPRESSION"
+	REFRESH_SCHEDULE_NOT_FOUND = "REFRESH_SCHEDULE_NOT_FOUND"
+	REFRESH_ALREADY_RUNNING    = "REFRESH_ALREADY_RUNNING"
+)
+
+var (
+	ErrRefreshScheduleNotFound = &types.MyCustomError{
+		Message:    "Refresh schedule not found",
+		StatusCode: http.StatusNotFound,
+		Code:       REFRESH_SCHEDULE_NOT_FOUND,
+	}
+	ErrRefreshAlreadyRunning = &types.MyCustomError{
+		Message:    "A refresh is already running",
+		StatusCode: http.StatusConflict,
+		Code:       REFRESH_ALREADY_RUNNING,
+	}
+)
@@ -1,5 +1,32 @@
 package errors
 
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
 const (
-	SCRIPT_ERROR = "SCRIPT_ERROR"
+	// Script errors
+	SCRIPT_ERROR              = "SCRIPT_ERROR"
+	SCRIPT_WORKER_CRASHED     = "SCRIPT_WORKER_CRASHED"
+	SCRIPT_WORKER_TIMEOUT     = "SCRIPT_WORKER_TIMEOUT"
+	SCRIPT_WORKER_POOL_CLOSED = "SCRIPT_WORKER_POOL_CLOSED"
+)
+
+var (
+	ErrScriptWorkerCrashed = &types.MyCustomError{
+		Message:    "Script worker crashed while handling the request",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       SCRIPT_WORKER_CRASHED,
+	}
+	ErrScriptWorkerTimeout = &types.MyCustomError{
+		Message:    "Script worker timed out while handling the request",
+		StatusCode: http.StatusGatewayTimeout,
+		Code:       SCRIPT_WORKER_TIMEOUT,
+	}
+	ErrScriptWorkerPoolClosed = &types.MyCustomError{
+		Message:    "Script worker pool is closed",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       SCRIPT_WORKER_POOL_CLOSED,
+	}
 )
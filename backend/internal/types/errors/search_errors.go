@@ -0,0 +1,24 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Search errors
+	SEARCH_QUERY_REQUIRED = "SEARCH_QUERY_REQUIRED"
+	SEARCHING_NOVELS      = "SEARCHING_NOVELS"
+	SEARCHING_CHAPTERS    = "SEARCHING_CHAPTERS"
+
+	// OPDS errors
+	WRITING_OPDS_FEED = "WRITING_OPDS_FEED"
+)
+
+var (
+	ErrSearchQueryRequired = &types.MyCustomError{
+		Message:    "A search query is required",
+		StatusCode: http.StatusBadRequest,
+		Code:       SEARCH_QUERY_REQUIRED,
+	}
+)
@@ -0,0 +1,34 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Shelf errors
+	INVALID_SHELF   = "INVALID_SHELF"
+	SHELF_NOT_FOUND = "SHELF_NOT_FOUND"
+	SHELF_CONFLICT  = "SHELF_CONFLICT"
+	GETTING_SHELVES = "GETTING_SHELVES"
+	SAVING_SHELF    = "SAVING_SHELF"
+	DELETING_SHELF  = "DELETING_SHELF"
+)
+
+var (
+	ErrInvalidShelf = &types.MyCustomError{
+		Message:    "Invalid shelf (it needs a name of at most 100 characters)",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_SHELF,
+	}
+	ErrShelfNotFound = &types.MyCustomError{
+		Message:    "Shelf not found",
+		StatusCode: http.StatusNotFound,
+		Code:       SHELF_NOT_FOUND,
+	}
+	ErrShelfConflict = &types.MyCustomError{
+		Message:    "A shelf with this name already exists",
+		StatusCode: http.StatusConflict,
+		Code:       SHELF_CONFLICT,
+	}
+)
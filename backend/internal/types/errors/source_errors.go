@@ -0,0 +1,45 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Source errors
+	SOURCE_NOT_REGISTERED = "SOURCE_NOT_REGISTERED"
+	SOURCE_UNSUPPORTED    = "SOURCE_UNSUPPORTED"
+	PARSING_SOURCE        = "PARSING_SOURCE"
+	SOURCE_BLOCKED        = "SOURCE_BLOCKED"
+	SOURCE_TIMEOUT        = "SOURCE_TIMEOUT"
+	SOURCE_DOWN           = "SOURCE_DOWN"
+	SOURCE_CIRCUIT_OPEN   = "SOURCE_CIRCUIT_OPEN"
+	INVALID_SOURCE_LIMITS = "INVALID_SOURCE_LIMITS"
+
+	// Source priority errors
+	INVALID_SOURCE_PRIORITY  = "INVALID_SOURCE_PRIORITY"
+	UPDATING_SOURCE_PRIORITY = "UPDATING_SOURCE_PRIORITY"
+)
+
+var (
+	ErrSourceNotRegistered = &types.MyCustomError{
+		Message:    "Source not registered",
+		StatusCode: http.StatusNotFound,
+		Code:       SOURCE_NOT_REGISTERED,
+	}
+	ErrSourceUnsupported = &types.MyCustomError{
+		Message:    "Operation not supported by the source",
+		StatusCode: http.StatusNotImplemented,
+		Code:       SOURCE_UNSUPPORTED,
+	}
+	ErrSourceCircuitOpen = &types.MyCustomError{
+		Message:    "Source paused after too many failed requests",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       SOURCE_CIRCUIT_OPEN,
+	}
+	ErrInvalidSourcePriority = &types.MyCustomError{
+		Message:    "Source priority must list registered sources without repeating them",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_SOURCE_PRIORITY,
+	}
+)
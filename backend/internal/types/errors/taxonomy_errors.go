@@ -0,0 +1,52 @@
+package errors
+
+import (
+	"backend/internal/types"
+	"net/http"
+)
+
+const (
+	// Taxonomy errors
+	INVALID_TAXONOMY_KIND        = "INVALID_TAXONOMY_KIND"
+	TAXONOMY_ENTRY_NOT_FOUND     = "TAXONOMY_ENTRY_NOT_FOUND"
+	TAXONOMY_ENTRY_IN_USE        = "TAXONOMY_ENTRY_IN_USE"
+	INVALID_TAXONOMY_DESCRIPTION = "INVALID_TAXONOMY_DESCRIPTION"
+	INVALID_TAXONOMY_MERGE       = "INVALID_TAXONOMY_MERGE"
+	GETTING_TAXONOMY_ENTRY       = "GETTING_TAXONOMY_ENTRY"
+	UPDATING_TAXONOMY_ENTRY      = "UPDATING_TAXONOMY_ENTRY"
+	MERGING_TAXONOMY_ENTRIES     = "MERGING_TAXONOMY_ENTRIES"
+	DELETING_TAXONOMY_ENTRY      = "DELETING_TAXONOMY_ENTRY"
+)
+
+var (
+	ErrInvalidTaxonomyKind = &types.MyCustomError{
+		Message:    "The taxonomy must be one of genres, tags or authors",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_TAXONOMY_KIND,
+	}
+	ErrTaxonomyEntryNotFound = &types.MyCustomError{
+		Message:    "Taxonomy entry not found",
+		StatusCode: http.StatusNotFound,
+		Code:       TAXONOMY_ENTRY_NOT_FOUND,
+	}
+	ErrTaxonomyEntryInUse = &types.MyCustomError{
+		Message:    "The entry still classifies novels, merge it into another entry instead",
+		StatusCode: http.StatusConflict,
+		Code:       TAXONOMY_ENTRY_IN_USE,
+	}
+	ErrInvalidTaxonomyDescription = &types.MyCustomError{
+		Message:    "The description must be at most 1000 characters long, and authors have none",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_TAXONOMY_DESCRIPTION,
+	}
+	ErrInvalidTaxonomyMerge = &types.MyCustomError{
+		Message:    "The ID of the entry to merge into is required",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_TAXONOMY_MERGE,
+	}
+	ErrMergeTaxonomyEntryIntoItself = &types.MyCustomError{
+		Message:    "An entry cannot be merged into itself",
+		StatusCode: http.StatusBadRequest,
+		Code:       INVALID_TAXONOMY_MERGE,
+	}
+)
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"backend/internal/models"
+	"backend/internal/types/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetAuthenticatedUser returns the user the AuthMiddleware stored in the context of a request.
+//
+// Parameters:
+//   - c *gin.Context (context of the request)
+//
+// Returns:
+//   - *models.User (the authenticated user)
+//   - error (errors.ErrUnauthenticated if the request was not authenticated)
+func GetAuthenticatedUser(c *gin.Context) (*models.User, error) {
+	value, exists := c.Get("user")
+	if !exists {
+		return nil, errors.ErrUnauthenticated
+	}
+
+	user, ok := value.(*models.User)
+	if !ok || user == nil {
+		return nil, errors.ErrUnauthenticated
+	}
+
+	return user, nil
+}
@@ -0,0 +1,48 @@
+package utils
+
+import "backend/internal/models"
+
+// GetMissingChapterRanges computes the chapters of a range that are not stored yet. Missing chapters numbered below
+// the latest stored chapter are gaps, the ones above it are new chapters released since the last import.
+//
+// Parameters:
+//   - existing ([]uint): The numbers of the stored chapters, in any order.
+//   - from (int): The first chapter of the range.
+//   - to (int): The last chapter of the range.
+//
+// Returns:
+//   - []models.ChapterRange: The missing ranges below the latest stored chapter, in ascending order.
+//   - *models.ChapterRange: The missing range above the latest stored chapter, nil if there is none.
+func GetMissingChapterRanges(existing []uint, from, to int) ([]models.ChapterRange, *models.ChapterRange) {
+	stored := make(map[int]struct{}, len(existing))
+	latest := 0
+	for _, chapterNo := range existing {
+		stored[int(chapterNo)] = struct{}{}
+		if int(chapterNo) > latest {
+			latest = int(chapterNo)
+		}
+	}
+
+	var gaps []models.ChapterRange
+	var current *models.ChapterRange
+
+	for chapterNo := from; chapterNo <= to && chapterNo <= latest; chapterNo++ {
+		if _, ok := stored[chapterNo]; ok {
+			current = nil
+			continue
+		}
+
+		if current == nil {
+			gaps = append(gaps, models.ChapterRange{From: chapterNo, To: chapterNo})
+			current = &gaps[len(gaps)-1]
+		} else {
+			current.To = chapterNo
+		}
+	}
+
+	start := max(from, latest+1)
+	if start > to {
+		return gaps, nil
+	}
+	return gaps, &models.ChapterRange{From: start, To: to}
+}
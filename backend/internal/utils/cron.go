@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit is how far ahead the next activation of a schedule is searched for.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// CronSchedule is a parsed five-field cron expression ("minute hour day-of-month month day-of-week"). Each field
+// accepts "*", numbers, ranges ("1-5"), lists ("1,3,5") and steps ("*/15", "0-30/10"). As in cron, when both the day
+// of the month and the day of the week are restricted, a day matching either of them is activated.
+type CronSchedule struct {
+	expression string
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	anyDom     bool
+	anyDow     bool
+}
+
+// ParseCronSchedule parses a five-field cron expression.
+//
+// Parameters:
+//   - expression (string): The cron expression (e.g., "0 */6 * * *").
+//
+// Returns:
+//   - *CronSchedule: A pointer to the parsed schedule.
+//   - error: An error object indicating the type of error encountered, or nil if the operation was successful.
+//
+// Error types:
+//   - INVALID_CRON_EXPRESSION: Returned if the expression doesn't have five valid fields.
+func ParseCronSchedule(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, invalidCronExpression(expression, fmt.Errorf("expected 5 fields, got %d", len(fields)))
+	}
+
+	schedule := &CronSchedule{
+		expression: strings.Join(fields, " "),
+		anyDom:     fields[2] == "*",
+		anyDow:     fields[4] == "*",
+	}
+
+	bounds := []struct {
+		field    *uint64
+		min, max int
+	}{
+		{&schedule.minute, 0, 59},
+		{&schedule.hour, 0, 23},
+		{&schedule.dayOfMonth, 1, 31},
+		{&schedule.month, 1, 12},
+		{&schedule.dayOfWeek, 0, 7},
+	}
+
+	for i, bound := range bounds {
+		bits, err := parseCronField(fields[i], bound.min, bound.max)
+		if err != nil {
+			return nil, invalidCronExpression(expression, err)
+		}
+		*bound.field = bits
+	}
+
+	// Sunday can be written as 0 or 7
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+
+	return schedule, nil
+}
+
+// String returns the normalized expression of the schedule.
+func (c *CronSchedule) String() string {
+	return c.expression
+}
+
+// Next returns the first activation of the schedule strictly after the given time, in the location of the given time.
+//
+// Parameters:
+//   - after (time.Time): The time to search from.
+//
+// Returns:
+//   - time.Time: The next activation, or the zero time if the schedule never activates (e.g., "0 0 31 2 *").
+func (c *CronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchesDay reports whether the day of a time matches the day of the month and day of the week fields.
+func (c *CronSchedule) matchesDay(t time.Time) bool {
+	dom := c.dayOfMonth&(1<<uint(t.Day())) != 0
+	dow := c.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if c.anyDom || c.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// parseCronField parses a comma separated field of a cron expression into a bit set of the values it matches.
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		valueRange, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		start, end := min, max
+		if valueRange != "*" {
+			startPart, endPart, isRange := strings.Cut(valueRange, "-")
+
+			var err error
+			start, err = strconv.Atoi(startPart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+
+			end = start
+			if isRange {
+				end, err = strconv.Atoi(endPart)
+				if err != nil {
+					return 0, fmt.Errorf("invalid value %q", part)
+				}
+			} else if hasStep {
+				// "5/10" means every 10 starting at 5
+				end = max
+			}
+		}
+
+		if start < min || end > max || start > end {
+			return 0, fmt.Errorf("value %q out of range %d-%d", part, min, max)
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// invalidCronExpression wraps the reason a cron expression is invalid.
+func invalidCronExpression(expression string, err error) error {
+	return types.WrapError(errors.INVALID_CRON_EXPRESSION, fmt.Sprintf("Invalid cron expression %q", expression), http.StatusBadRequest, err)
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// EncodeNovelCursor encodes the position of a novel in a listing into the opaque cursor given to the clients.
+//
+// Parameters:
+//   - cursor models.NovelCursor (position of the novel)
+//
+// Returns:
+//   - string (the cursor, safe to use in URLs)
+func EncodeNovelCursor(cursor models.NovelCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeNovelCursor decodes a cursor given by a client into the position of a novel in a listing.
+//
+// Parameters:
+//   - cursor string (cursor returned by EncodeNovelCursor)
+//
+// Returns:
+//   - *models.NovelCursor (position of the novel)
+//   - error (errors.ErrInvalidCursor if the cursor was not returned by EncodeNovelCursor)
+func DecodeNovelCursor(cursor string) (*models.NovelCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	var decoded models.NovelCursor
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID == 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	return &decoded, nil
+}
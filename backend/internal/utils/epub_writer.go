@@ -0,0 +1,291 @@
+package utils
+
+import (
+	"archive/zip"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// epubContentDir is the directory of the archive holding the package document and the content of the book.
+const epubContentDir = "OEBPS"
+
+// epubCoverExtensions maps the image types accepted as covers to the extension of their file.
+var epubCoverExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// EPUBMetadata represents the metadata of an EPUB being written.
+//
+// Fields:
+//   - Identifier (string): The unique identifier of the book.
+//   - Title (string): The title of the book.
+//   - Language (string): The language of the book, "en" if empty.
+//   - Description (string): The description of the book.
+//   - Authors ([]string): The names of the authors of the book.
+//   - Modified (time.Time): When the book was last modified.
+type EPUBMetadata struct {
+	Identifier  string
+	Title       string
+	Language    string
+	Description string
+	Authors     []string
+	Modified    time.Time
+}
+
+// epubEntry represents a content document written to an EPUB.
+type epubEntry struct {
+	id    string
+	href  string
+	title string
+}
+
+// EPUBWriter writes an EPUB 3 to a stream. Each chapter is written to the stream as soon as it is added and only its
+// title is kept, the package document and the table of contents, which list every chapter, are written last.
+//
+// Fields:
+//   - archive (*zip.Writer): The archive the EPUB is written to.
+//   - metadata (EPUBMetadata): The metadata of the book.
+//   - cover (*epubEntry): The cover image, nil if the book has none.
+//   - coverType (string): The media type of the cover image.
+//   - chapters ([]epubEntry): The chapters written so far.
+type EPUBWriter struct {
+	archive   *zip.Writer
+	metadata  EPUBMetadata
+	cover     *epubEntry
+	coverType string
+	chapters  []epubEntry
+}
+
+// NewEPUBWriter starts writing an EPUB to a stream, writing the entries that must come first in the archive.
+//
+// Parameters:
+//   - w (io.Writer): The stream the EPUB is written to.
+//   - metadata (EPUBMetadata): The metadata of the book.
+//
+// Returns:
+//   - *EPUBWriter: A pointer to the EPUBWriter used to add the content of the book.
+//   - error: WRITING_EPUB if the stream could not be written to.
+func NewEPUBWriter(w io.Writer, metadata EPUBMetadata) (*EPUBWriter, error) {
+	if metadata.Language == "" {
+		metadata.Language = "en"
+	}
+	if metadata.Modified.IsZero() {
+		metadata.Modified = time.Now()
+	}
+
+	e := &EPUBWriter{archive: zip.NewWriter(w), metadata: metadata}
+
+	// The mimetype must be the first entry of the archive and must not be compressed
+	mimetype, err := e.archive.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return nil, epubWriteError(err)
+	}
+	if _, err := io.WriteString(mimetype, "application/epub+zip"); err != nil {
+		return nil, epubWriteError(err)
+	}
+
+	if err := e.writeFile("META-INF/container.xml", `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="`+epubContentDir+`/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// AddCover adds the cover image of the book and a cover page showing it. Images of an unsupported type are ignored.
+//
+// Parameters:
+//   - image ([]byte): The content of the image.
+//
+// Returns:
+//   - bool: Whether the image was added.
+//   - error: WRITING_EPUB if the stream could not be written to.
+func (e *EPUBWriter) AddCover(image []byte) (bool, error) {
+	mediaType := http.DetectContentType(image)
+	extension, ok := epubCoverExtensions[mediaType]
+	if !ok || e.cover != nil {
+		return false, nil
+	}
+
+	href := "images/cover" + extension
+	if err := e.writeBytes(href, image); err != nil {
+		return false, err
+	}
+	if err := e.writeFile("text/cover.xhtml", epubDocument("Cover",
+		fmt.Sprintf(`<div class="cover"><img src="../%s" alt="%s"/></div>`, href, escapeEPUBText(e.metadata.Title)))); err != nil {
+		return false, err
+	}
+
+	e.cover = &epubEntry{id: "cover-image", href: href}
+	e.coverType = mediaType
+	return true, nil
+}
+
+// AddChapter writes a chapter of the book. Chapters appear in the reading order and the table of contents in the
+// order they are added.
+//
+// Parameters:
+//   - title (string): The title of the chapter.
+//   - paragraphs ([]string): The paragraphs of the chapter, as plain text.
+//
+// Returns:
+//   - error: WRITING_EPUB if the stream could not be written to.
+func (e *EPUBWriter) AddChapter(title string, paragraphs []string) error {
+	chapter := epubEntry{
+		id:    fmt.Sprintf("chapter-%d", len(e.chapters)+1),
+		href:  fmt.Sprintf("text/chapter-%d.xhtml", len(e.chapters)+1),
+		title: title,
+	}
+
+	var body strings.Builder
+	body.WriteString("<h2>" + escapeEPUBText(title) + "</h2>\n")
+	for _, paragraph := range paragraphs {
+		body.WriteString("<p>" + escapeEPUBText(paragraph) + "</p>\n")
+	}
+
+	if err := e.writeFile(chapter.href, epubDocument(title, body.String())); err != nil {
+		return err
+	}
+
+	e.chapters = append(e.chapters, chapter)
+	return nil
+}
+
+// Close writes the table of contents and the package document of the book and finishes the archive. It doesn't
+// close the underlying stream.
+//
+// Returns:
+//   - error: WRITING_EPUB if the stream could not be written to.
+func (e *EPUBWriter) Close() error {
+	if err := e.writeFile("nav.xhtml", e.navDocument()); err != nil {
+		return err
+	}
+	if err := e.writeFile("content.opf", e.packageDocument()); err != nil {
+		return err
+	}
+	if err := e.archive.Close(); err != nil {
+		return epubWriteError(err)
+	}
+	return nil
+}
+
+// navDocument builds the navigation document of the book, holding its table of contents.
+func (e *EPUBWriter) navDocument() string {
+	var toc strings.Builder
+	toc.WriteString(`<nav epub:type="toc" id="toc">` + "\n<h1>Table of Contents</h1>\n<ol>\n")
+	for _, chapter := range e.chapters {
+		toc.WriteString(fmt.Sprintf(`<li><a href="%s">%s</a></li>`+"\n", chapter.href, escapeEPUBText(chapter.title)))
+	}
+	toc.WriteString("</ol>\n</nav>\n")
+
+	return epubDocument(e.metadata.Title, toc.String())
+}
+
+// packageDocument builds the package document of the book, holding its metadata, manifest and reading order.
+func (e *EPUBWriter) packageDocument() string {
+	var opf strings.Builder
+	opf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+`)
+	opf.WriteString(`<dc:identifier id="book-id">` + escapeEPUBText(e.metadata.Identifier) + "</dc:identifier>\n")
+	opf.WriteString("<dc:title>" + escapeEPUBText(e.metadata.Title) + "</dc:title>\n")
+	opf.WriteString("<dc:language>" + escapeEPUBText(e.metadata.Language) + "</dc:language>\n")
+	for _, author := range e.metadata.Authors {
+		opf.WriteString("<dc:creator>" + escapeEPUBText(author) + "</dc:creator>\n")
+	}
+	if e.metadata.Description != "" {
+		opf.WriteString("<dc:description>" + escapeEPUBText(e.metadata.Description) + "</dc:description>\n")
+	}
+	opf.WriteString(`<meta property="dcterms:modified">` + e.metadata.Modified.UTC().Format("2006-01-02T15:04:05Z") + "</meta>\n")
+	if e.cover != nil {
+		opf.WriteString(`<meta name="cover" content="cover-image"/>` + "\n")
+	}
+	opf.WriteString("</metadata>\n<manifest>\n")
+	opf.WriteString(`<item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>` + "\n")
+	if e.cover != nil {
+		opf.WriteString(fmt.Sprintf(`<item id="%s" href="%s" media-type="%s" properties="cover-image"/>`+"\n", e.cover.id, e.cover.href, e.coverType))
+		opf.WriteString(`<item id="cover" href="text/cover.xhtml" media-type="application/xhtml+xml"/>` + "\n")
+	}
+	for _, chapter := range e.chapters {
+		opf.WriteString(fmt.Sprintf(`<item id="%s" href="%s" media-type="application/xhtml+xml"/>`+"\n", chapter.id, chapter.href))
+	}
+	opf.WriteString("</manifest>\n<spine>\n")
+	if e.cover != nil {
+		opf.WriteString(`<itemref idref="cover" linear="no"/>` + "\n")
+	}
+	opf.WriteString(`<itemref idref="nav"/>` + "\n")
+	for _, chapter := range e.chapters {
+		opf.WriteString(`<itemref idref="` + chapter.id + `"/>` + "\n")
+	}
+	opf.WriteString("</spine>\n</package>\n")
+
+	return opf.String()
+}
+
+// writeFile writes a text file to the content directory of the archive.
+func (e *EPUBWriter) writeFile(name, content string) error {
+	return e.writeBytes(name, []byte(content))
+}
+
+// writeBytes writes a file to the content directory of the archive. Names starting with META-INF are written at the
+// root of the archive instead.
+func (e *EPUBWriter) writeBytes(name string, content []byte) error {
+	if !strings.HasPrefix(name, "META-INF/") {
+		name = epubContentDir + "/" + name
+	}
+
+	file, err := e.archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: e.metadata.Modified})
+	if err != nil {
+		return epubWriteError(err)
+	}
+	if _, err := file.Write(content); err != nil {
+		return epubWriteError(err)
+	}
+	return nil
+}
+
+// epubDocument builds an XHTML content document.
+func epubDocument(title, body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops">
+<head>
+<meta charset="UTF-8"/>
+<title>` + escapeEPUBText(title) + `</title>
+</head>
+<body>
+` + body + `</body>
+</html>
+`
+}
+
+// escapeEPUBText escapes text for XML, dropping the control characters XML doesn't allow.
+func escapeEPUBText(text string) string {
+	text = strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, text)
+	return html.EscapeString(text)
+}
+
+// epubWriteError wraps an error writing an EPUB.
+func epubWriteError(err error) error {
+	return types.WrapError(errors.WRITING_EPUB, "Failed to write the EPUB", http.StatusInternalServerError, err)
+}
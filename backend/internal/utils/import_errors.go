@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	stdErrors "errors"
+	"net"
+)
+
+// GetImportErrorCode classifies an error returned while fetching a chapter into one of the codes of the import error
+// taxonomy: errors.CHAPTER_NOT_FOUND, errors.CHAPTER_EMPTY, errors.SOURCE_BLOCKED, errors.PARSING_SOURCE,
+// errors.SOURCE_TIMEOUT or errors.SOURCE_DOWN. Wrapped errors are inspected, so a script error caused by a worker
+// timeout is a timeout. Custom errors outside the taxonomy keep their own code, and any other error is treated as the
+// source being down.
+//
+// Parameters:
+//   - err (error): The error to classify.
+//
+// Returns:
+//   - string: The code of the error, empty if err is nil.
+func GetImportErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	fallback := ""
+	for err != nil {
+		var customErr *types.MyCustomError
+		if !stdErrors.As(err, &customErr) {
+			var netErr net.Error
+			if stdErrors.As(err, &netErr) && netErr.Timeout() {
+				return errors.SOURCE_TIMEOUT
+			}
+			break
+		}
+
+		switch customErr.Code {
+		case errors.CHAPTER_NOT_FOUND, errors.CHAPTER_EMPTY, errors.SOURCE_BLOCKED, errors.PARSING_SOURCE,
+			errors.SOURCE_TIMEOUT, errors.SOURCE_DOWN:
+			return customErr.Code
+		case errors.SCRIPT_WORKER_TIMEOUT:
+			return errors.SOURCE_TIMEOUT
+		case errors.IMPORTING_CHAPTER:
+			return errors.PARSING_SOURCE
+		case errors.SOURCE_CIRCUIT_OPEN, errors.SCRIPT_WORKER_CRASHED, errors.SCRIPT_WORKER_POOL_CLOSED:
+			return errors.SOURCE_DOWN
+		}
+
+		if fallback == "" {
+			fallback = customErr.Code
+		}
+		err = customErr.Wrapped
+	}
+
+	if fallback == "" || fallback == errors.SCRIPT_ERROR {
+		return errors.SOURCE_DOWN
+	}
+	return fallback
+}
+
+// IsRetryableImportError reports whether fetching a chapter again may succeed after it failed with the given error.
+// Timeouts and unavailable sources are transient, while missing or empty chapters, blocked requests and pages that
+// could not be parsed fail the same way until the source changes.
+//
+// Parameters:
+//   - err (error): The error of the failed attempt.
+//
+// Returns:
+//   - bool: True if the error is transient.
+func IsRetryableImportError(err error) bool {
+	code := GetImportErrorCode(err)
+	return code == errors.SOURCE_TIMEOUT || code == errors.SOURCE_DOWN
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// libraryCSVHeader is the header of the exported libraries.
+var libraryCSVHeader = []string{"novel_id", "novel_updates_id", "title", "status", "score", "current_chapter"}
+
+// libraryCSVColumns maps the names of the columns read from imported libraries, lowercased and without separators, to
+// the columns of the exports. Other trackers name some of them differently.
+var libraryCSVColumns = map[string]string{
+	"novelid":         "novel_id",
+	"novelupdatesid":  "novel_updates_id",
+	"novelupdatesurl": "novel_updates_id",
+	"url":             "novel_updates_id",
+	"title":           "title",
+	"name":            "title",
+	"status":          "status",
+	"score":           "score",
+	"rating":          "score",
+	"currentchapter":  "current_chapter",
+	"progress":        "current_chapter",
+	"chaptersread":    "current_chapter",
+	"lastchapterread": "current_chapter",
+}
+
+// WriteLibraryCSV writes a library as CSV, with a header naming the columns.
+//
+// Parameters:
+//   - w (io.Writer): The writer the CSV is written to.
+//   - rows ([]dtos.LibraryRow): The bookmarked novels of the library.
+//
+// Returns:
+//   - error: An error if the CSV could not be written, or nil if it was.
+func WriteLibraryCSV(w io.Writer, rows []dtos.LibraryRow) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(libraryCSVHeader); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := writer.Write([]string{
+			strconv.FormatUint(uint64(row.NovelID), 10),
+			row.NovelUpdatesID,
+			row.Title,
+			row.Status,
+			strconv.Itoa(row.Score),
+			strconv.Itoa(row.CurrentChapter),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadLibraryCSV reads a library from CSV. The first line names the columns, in any order, and needs a NovelUpdates
+// ID or a title column. Unknown columns are ignored, and empty scores and chapters are 0.
+//
+// Parameters:
+//   - r (io.Reader): The reader the CSV is read from.
+//
+// Returns:
+//   - []dtos.LibraryRow: The bookmarked novels of the library.
+//   - error: An error if the CSV is invalid, or nil if it was read.
+//
+// Error types:
+//   - errors.ErrInvalidLibraryFile: Returned if the header is missing or names neither a NovelUpdates ID nor a title.
+//   - INVALID_LIBRARY_FILE: Returned, with the line at fault, if the CSV is malformed or a number is invalid.
+func ReadLibraryCSV(r io.Reader) ([]dtos.LibraryRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.ErrInvalidLibraryFile
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, strings.ToLower(strings.TrimPrefix(name, "\uFEFF")))
+		if column, ok := libraryCSVColumns[name]; ok {
+			if _, seen := columns[column]; !seen {
+				columns[column] = i
+			}
+		}
+	}
+	_, hasID := columns["novel_updates_id"]
+	_, hasTitle := columns["title"]
+	if !hasID && !hasTitle {
+		return nil, errors.ErrInvalidLibraryFile
+	}
+
+	rows := []dtos.LibraryRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, types.WrapError(errors.INVALID_LIBRARY_FILE, fmt.Sprintf("Invalid library file: %v", err), http.StatusBadRequest, err)
+		}
+
+		field := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		number := func(column string) (int, error) {
+			value := field(column)
+			if value == "" {
+				return 0, nil
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return 0, types.WrapError(errors.INVALID_LIBRARY_FILE, fmt.Sprintf("Invalid library file: line %d has an invalid %s %q", line, column, value), http.StatusBadRequest, err)
+			}
+			return n, nil
+		}
+
+		row := dtos.LibraryRow{
+			NovelUpdatesID: field("novel_updates_id"),
+			Title:          field("title"),
+			Status:         field("status"),
+		}
+		if row.Score, err = number("score"); err != nil {
+			return nil, err
+		}
+		if row.CurrentChapter, err = number("current_chapter"); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
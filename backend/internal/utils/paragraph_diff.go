@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"backend/internal/dtos"
+	"strings"
+)
+
+// SplitParagraphs splits the body of a chapter into its non-empty paragraphs, trimmed of surrounding whitespace.
+//
+// Parameters:
+//   - body (string): The body of the chapter, with one paragraph per line.
+//
+// Returns:
+//   - []string: The paragraphs of the chapter.
+func SplitParagraphs(body string) []string {
+	var paragraphs []string
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			paragraphs = append(paragraphs, line)
+		}
+	}
+	return paragraphs
+}
+
+// DiffParagraphs computes the paragraph level differences between two versions of a chapter, keeping the longest
+// sequence of paragraphs common to both and marking the others as added or removed. Removed paragraphs are listed
+// before the paragraphs added in their place.
+//
+// Parameters:
+//   - from ([]string): The paragraphs of the older version.
+//   - to ([]string): The paragraphs of the newer version.
+//
+// Returns:
+//   - []dtos.ParagraphChange: The paragraphs of both versions, in reading order.
+func DiffParagraphs(from, to []string) []dtos.ParagraphChange {
+	// Common prefix and suffix don't need the quadratic table
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	changes := make([]dtos.ParagraphChange, 0, len(from)+len(to)-prefix-suffix)
+	for i := 0; i < prefix; i++ {
+		changes = append(changes, dtos.ParagraphChange{Change: dtos.ParagraphUnchanged, From: i + 1, To: i + 1, Text: from[i]})
+	}
+
+	oldMiddle, newMiddle := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+
+	// common[i][j] is the length of the longest common sequence of oldMiddle[i:] and newMiddle[j:]
+	common := make([][]int, len(oldMiddle)+1)
+	for i := range common {
+		common[i] = make([]int, len(newMiddle)+1)
+	}
+	for i := len(oldMiddle) - 1; i >= 0; i-- {
+		for j := len(newMiddle) - 1; j >= 0; j-- {
+			if oldMiddle[i] == newMiddle[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(oldMiddle) || j < len(newMiddle) {
+		switch {
+		case i < len(oldMiddle) && j < len(newMiddle) && oldMiddle[i] == newMiddle[j]:
+			changes = append(changes, dtos.ParagraphChange{Change: dtos.ParagraphUnchanged, From: prefix + i + 1, To: prefix + j + 1, Text: oldMiddle[i]})
+			i++
+			j++
+		case j == len(newMiddle) || (i < len(oldMiddle) && common[i+1][j] >= common[i][j+1]):
+			changes = append(changes, dtos.ParagraphChange{Change: dtos.ParagraphRemoved, From: prefix + i + 1, Text: oldMiddle[i]})
+			i++
+		default:
+			changes = append(changes, dtos.ParagraphChange{Change: dtos.ParagraphAdded, To: prefix + j + 1, Text: newMiddle[j]})
+			j++
+		}
+	}
+
+	for k := 0; k < suffix; k++ {
+		changes = append(changes, dtos.ParagraphChange{
+			Change: dtos.ParagraphUnchanged,
+			From:   len(from) - suffix + k + 1,
+			To:     len(to) - suffix + k + 1,
+			Text:   from[len(from)-suffix+k],
+		})
+	}
+
+	return changes
+}
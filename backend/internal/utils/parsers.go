@@ -97,3 +97,60 @@ func (n *NovelUpdatesIDParser) Parse(novelUpdatesID string) (string, error) {
 
 	return noSpacesNovelUpdatesID, nil
 }
+
+// slugSeparatorRegex matches the runs of characters that are not allowed in a NovelUpdates ID.
+var slugSeparatorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// SlugifyNovelUpdatesID derives a NovelUpdates style ID from the title of a novel (e.g., "Lord of the Mysteries!"
+// becomes "lord-of-the-mysteries"), for novels that are not imported from NovelUpdates.
+//
+// Parameters:
+//   - title (string): The title of the novel.
+//
+// Returns:
+//   - string: The ID, empty if the title has no ASCII letters or digits.
+func SlugifyNovelUpdatesID(title string) string {
+	return strings.Trim(slugSeparatorRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+}
+
+// releaseFrequencyRegex matches the release frequencies of NovelUpdates (e.g., "Every 2.5 Day(s)").
+var releaseFrequencyRegex = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(day|week|month)`)
+
+// ParseReleaseFrequency parses the release frequency of a novel into the average number of days between chapters.
+//
+// Parameters:
+//   - releaseFrequency (string): The release frequency (e.g., "Every 2.5 Day(s)", "Daily", "Weekly").
+//
+// Returns:
+//   - float64: The number of days between chapters.
+//   - bool: False if the release frequency is unknown (e.g., "N/A").
+func ParseReleaseFrequency(releaseFrequency string) (float64, bool) {
+	releaseFrequency = strings.ToLower(strings.TrimSpace(releaseFrequency))
+
+	switch releaseFrequency {
+	case "daily":
+		return 1, true
+	case "weekly":
+		return 7, true
+	case "monthly":
+		return 30, true
+	}
+
+	matches := releaseFrequencyRegex.FindStringSubmatch(releaseFrequency)
+	if matches == nil {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil || value <= 0 {
+		return 0, false
+	}
+
+	switch matches[2] {
+	case "week":
+		value *= 7
+	case "month":
+		value *= 30
+	}
+	return value, true
+}
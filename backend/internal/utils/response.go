@@ -28,6 +28,22 @@ func BuildPaginatedResponse(c *gin.Context, data interface{}, total int64, page
 	c.JSON(200, paginatedResponse)
 }
 
+// BuildCursorPaginatedResponse constructs a JSON response with a page of a listing paginated by cursor and sends it to
+// the client using the given context.
+//
+// Parameters:
+//   - c *gin.Context (context of the response)
+//   - data interface{} (paginated data to be sent)
+//   - nextCursor string (cursor of the next page, empty on the last page)
+//   - limit int (limit of entries)
+func BuildCursorPaginatedResponse(c *gin.Context, data interface{}, nextCursor string, limit int) {
+	c.JSON(200, dtos.CursorPaginatedResponse{
+		Data:       data,
+		Limit:      limit,
+		NextCursor: nextCursor,
+	})
+}
+
 // CalculateTotalPages computes the total number of pages based on the total items and items per page (limit).
 //
 // Parameters:
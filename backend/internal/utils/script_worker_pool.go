@@ -0,0 +1,355 @@
+package utils
+
+import (
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"bufio"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ScriptWorkerPoolConfig holds the configuration of a ScriptWorkerPool.
+type ScriptWorkerPoolConfig struct {
+	// Command is the command that starts a worker, e.g. "python"
+	Command string
+	// Args are the arguments of the command, e.g. ["-m", "novel_updates_scraper.client", "worker"]
+	Args []string
+	// Size is the number of worker processes kept alive
+	Size int
+	// RequestTimeout is the maximum time a worker can take to answer a request before it is killed and restarted
+	RequestTimeout time.Duration
+}
+
+// scriptWorkerRequest is a request sent to a worker as a single JSON line on its stdin.
+type scriptWorkerRequest struct {
+	ID     uint64   `json:"id"`
+	Action string   `json:"action"`
+	Args   []string `json:"args"`
+}
+
+// scriptWorkerResponse is a response read from a single JSON line on the stdout of a worker.
+type scriptWorkerResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+}
+
+// scriptWorker is a single long-lived worker process.
+type scriptWorker struct {
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	responses chan scriptWorkerResponse
+	exited    chan struct{}
+}
+
+// ScriptWorkerPool keeps a fixed number of long-lived script processes alive and sends them requests as JSON lines
+// over stdin/stdout, which avoids starting a new interpreter for every request. Crashed or unresponsive workers are
+// replaced on the next request.
+//
+// It implements ScriptExecutor so it can be used wherever a RealScriptExecutor is used.
+type ScriptWorkerPool struct {
+	config ScriptWorkerPoolConfig
+	// idle holds the workers that are free to take a request, a nil entry is a slot whose worker must be (re)started
+	idle   chan *scriptWorker
+	done   chan struct{}
+	nextID atomic.Uint64
+
+	mu      sync.Mutex
+	closed  bool
+	workers map[*scriptWorker]struct{}
+}
+
+// NewScriptWorkerPool creates a new ScriptWorkerPool instance. Workers are started lazily on the first requests.
+//
+// Parameters:
+//   - config ScriptWorkerPoolConfig (The configuration of the pool)
+//
+// Returns:
+//   - *ScriptWorkerPool (pointer to the ScriptWorkerPool instance)
+func NewScriptWorkerPool(config ScriptWorkerPoolConfig) *ScriptWorkerPool {
+	if config.Size <= 0 {
+		config.Size = 1
+	}
+
+	pool := &ScriptWorkerPool{
+		config:  config,
+		idle:    make(chan *scriptWorker, config.Size),
+		done:    make(chan struct{}),
+		workers: make(map[*scriptWorker]struct{}),
+	}
+	for i := 0; i < config.Size; i++ {
+		pool.idle <- nil
+	}
+
+	return pool
+}
+
+// ExecuteScript sends a request to one of the workers and waits for its response. The script and any leading
+// "-m <module>" arguments are ignored since the workers are already running the script, the first remaining
+// argument is the action and the rest are its arguments.
+//
+// Parameters:
+//   - script string (ignored, kept for compatibility with ScriptExecutor)
+//   - args ...string (the action followed by its arguments, optionally prefixed by "-m <module>")
+//
+// Returns:
+//   - []byte (the result of the request as JSON)
+//   - error (SCRIPT_ERROR if the worker could not be started or the request could not be sent,
+//     SCRIPT_WORKER_CRASHED if the worker died, SCRIPT_WORKER_TIMEOUT if it didn't answer in time,
+//     SCRIPT_WORKER_POOL_CLOSED if the pool is closed)
+func (p *ScriptWorkerPool) ExecuteScript(_ string, args ...string) ([]byte, error) {
+	if len(args) >= 2 && args[0] == "-m" {
+		args = args[2:]
+	}
+	if len(args) == 0 {
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "No action provided", http.StatusBadRequest, nil)
+	}
+
+	return p.Call(args[0], args[1:]...)
+}
+
+// Call sends an action to one of the workers and waits for its response.
+//
+// Parameters:
+//   - action string (The action to run, e.g. "import-chapter")
+//   - args ...string (The arguments of the action)
+//
+// Returns:
+//   - []byte (the result of the request as JSON)
+//   - error (SCRIPT_ERROR if the worker could not be started or the request could not be sent,
+//     SCRIPT_WORKER_CRASHED if the worker died, SCRIPT_WORKER_TIMEOUT if it didn't answer in time,
+//     SCRIPT_WORKER_POOL_CLOSED if the pool is closed)
+func (p *ScriptWorkerPool) Call(action string, args ...string) ([]byte, error) {
+	var worker *scriptWorker
+	select {
+	case worker = <-p.idle:
+	case <-p.done:
+		return nil, errors.ErrScriptWorkerPoolClosed
+	}
+
+	if p.isClosed() {
+		p.release(worker)
+		return nil, errors.ErrScriptWorkerPoolClosed
+	}
+
+	if worker == nil || worker.hasExited() {
+		if worker != nil {
+			p.kill(worker)
+		}
+		var err error
+		worker, err = p.start()
+		if err != nil {
+			p.release(nil)
+			return nil, err
+		}
+	}
+
+	request := scriptWorkerRequest{ID: p.nextID.Add(1), Action: action, Args: args}
+	if args == nil {
+		request.Args = []string{}
+	}
+
+	line, err := json.Marshal(request)
+	if err != nil {
+		p.release(worker)
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to encode the worker request", http.StatusInternalServerError, err)
+	}
+
+	if _, err := worker.stdin.Write(append(line, '\n')); err != nil {
+		p.kill(worker)
+		p.release(nil)
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to send the request to the worker: "+err.Error(), http.StatusServiceUnavailable, err)
+	}
+
+	var timeout <-chan time.Time
+	if p.config.RequestTimeout > 0 {
+		timer := time.NewTimer(p.config.RequestTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for {
+		select {
+		case response := <-worker.responses:
+			// Responses to earlier requests that timed out are discarded
+			if response.ID != request.ID {
+				continue
+			}
+			p.release(worker)
+			return response.Result, nil
+		case <-worker.exited:
+			// The worker may have answered right before exiting
+			select {
+			case response := <-worker.responses:
+				if response.ID == request.ID {
+					p.kill(worker)
+					p.release(nil)
+					return response.Result, nil
+				}
+			default:
+			}
+			log.Printf("Script worker %d crashed while handling request %d (%s)", worker.cmd.Process.Pid, request.ID, action)
+			p.kill(worker)
+			p.release(nil)
+			return nil, errors.ErrScriptWorkerCrashed
+		case <-timeout:
+			log.Printf("Script worker %d timed out on request %d (%s), restarting it", worker.cmd.Process.Pid, request.ID, action)
+			p.kill(worker)
+			p.release(nil)
+			return nil, errors.ErrScriptWorkerTimeout
+		}
+	}
+}
+
+// Close stops all the workers. Requests made after Close return errors.ErrScriptWorkerPoolClosed.
+func (p *ScriptWorkerPool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	workers := make([]*scriptWorker, 0, len(p.workers))
+	for worker := range p.workers {
+		workers = append(workers, worker)
+	}
+	p.mu.Unlock()
+
+	for _, worker := range workers {
+		p.kill(worker)
+	}
+}
+
+// start starts a new worker process and the goroutine that reads its responses.
+//
+// Returns:
+//   - *scriptWorker (The started worker)
+//   - error (SCRIPT_ERROR if the process could not be started, SCRIPT_WORKER_POOL_CLOSED if the pool is closed)
+func (p *ScriptWorkerPool) start() (*scriptWorker, error) {
+	cmd := exec.Command(p.config.Command, p.config.Args...)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to open the worker stdin", http.StatusServiceUnavailable, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to open the worker stdout", http.StatusServiceUnavailable, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, errors.ErrScriptWorkerPoolClosed
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, types.WrapError(errors.SCRIPT_ERROR, "Failed to start the worker: "+err.Error(), http.StatusServiceUnavailable, err)
+	}
+
+	worker := &scriptWorker{
+		cmd:       cmd,
+		stdin:     stdin,
+		responses: make(chan scriptWorkerResponse, 1),
+		exited:    make(chan struct{}),
+	}
+	p.workers[worker] = struct{}{}
+
+	// Wait closes stdout, so it is only called once every response has been read
+	go func() {
+		worker.readResponses(stdout)
+		_ = cmd.Wait()
+		close(worker.exited)
+	}()
+
+	log.Printf("Started script worker %d", cmd.Process.Pid)
+	return worker, nil
+}
+
+// release puts a worker (or an empty slot) back in the idle queue. If the pool is closed the worker is stopped and
+// only its slot is put back.
+//
+// Parameters:
+//   - worker *scriptWorker (The worker to release, nil for an empty slot)
+func (p *ScriptWorkerPool) release(worker *scriptWorker) {
+	if worker != nil && p.isClosed() {
+		p.kill(worker)
+		worker = nil
+	}
+
+	p.idle <- worker
+}
+
+// isClosed reports whether the pool has been closed.
+func (p *ScriptWorkerPool) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.closed
+}
+
+// kill stops a worker process and forgets it.
+//
+// Parameters:
+//   - worker *scriptWorker (The worker to stop)
+func (p *ScriptWorkerPool) kill(worker *scriptWorker) {
+	p.mu.Lock()
+	delete(p.workers, worker)
+	p.mu.Unlock()
+
+	_ = worker.stdin.Close()
+	if !worker.hasExited() {
+		_ = worker.cmd.Process.Kill()
+	}
+	<-worker.exited
+}
+
+// readResponses reads the JSON lines written by the worker until its stdout is closed. Lines that are not valid
+// responses are logged and ignored. Once its stdout can't be read, e.g. because a line is too long, the worker is
+// killed so that the request it is handling fails instead of waiting for a response that will never be read.
+//
+// Parameters:
+//   - stdout io.Reader (The stdout of the worker)
+func (w *scriptWorker) readResponses(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		var response scriptWorkerResponse
+		if err := json.Unmarshal(scanner.Bytes(), &response); err != nil || response.ID == 0 {
+			log.Printf("Script worker %d wrote an invalid response: %s", w.cmd.Process.Pid, scanner.Text())
+			continue
+		}
+
+		// Drop any unread stale response so the reader never blocks on a worker that is being reused
+		select {
+		case <-w.responses:
+		default:
+		}
+		w.responses <- response
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Printf("Script worker %d responses could not be read, killing it: %v", w.cmd.Process.Pid, err)
+	}
+	_ = w.cmd.Process.Kill()
+}
+
+// hasExited reports whether the worker process has exited.
+func (w *scriptWorker) hasExited() bool {
+	select {
+	case <-w.exited:
+		return true
+	default:
+		return false
+	}
+}
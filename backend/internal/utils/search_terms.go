@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaxSearchTerms is the maximum number of words of a search query used to search.
+const MaxSearchTerms = 16
+
+// SearchTerms splits a search query into the lowercase words it searches for, dropping the punctuation so that the
+// words can be safely used in the full-text search syntax of the database.
+//
+// Parameters:
+//   - query string (search query)
+//
+// Returns:
+//   - []string (the words of the query, at most MaxSearchTerms)
+func SearchTerms(query string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	if len(terms) > MaxSearchTerms {
+		terms = terms[:MaxSearchTerms]
+	}
+
+	return terms
+}
@@ -0,0 +1,74 @@
+package validators
+
+import (
+	"backend/internal/models"
+	"backend/internal/types/errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxShelfNameLength is the longest name a shelf can have, in characters.
+const maxShelfNameLength = 100
+
+// ValidateBookmarkStatus validates the status of a bookmarked novel.
+//
+// Parameters:
+//   - status (string): The status to validate.
+//
+// Returns:
+//   - error: nil if the status is valid, otherwise an error indicating the issue.
+//
+// Error types:
+//   - errors.ErrInvalidBookmarkStatus: if the status isn't one of models.BookmarkStatuses.
+func ValidateBookmarkStatus(status string) error {
+	if !slices.Contains(models.BookmarkStatuses, status) {
+		return errors.ErrInvalidBookmarkStatus
+	}
+
+	return nil
+}
+
+// ValidateBookmark validates a bookmarked novel before it's stored.
+//
+// Parameters:
+//   - bookmark (models.BookmarkedNovel): The bookmarked novel to validate.
+//
+// Returns:
+//   - error: nil if the bookmarked novel is valid, otherwise an error indicating the issue.
+//
+// Error types:
+//   - errors.ErrInvalidBookmark: if the novel is missing or the current chapter is negative.
+//   - errors.ErrInvalidBookmarkStatus: if the status isn't one of models.BookmarkStatuses.
+//   - errors.ErrInvalidBookmarkScore: if the score isn't between 0 and models.MaxBookmarkScore.
+func ValidateBookmark(bookmark models.BookmarkedNovel) error {
+	if bookmark.NovelID <= 0 || bookmark.CurrentChapter < 0 {
+		return errors.ErrInvalidBookmark
+	}
+	if err := ValidateBookmarkStatus(bookmark.Status); err != nil {
+		return err
+	}
+	if bookmark.Score < 0 || bookmark.Score > models.MaxBookmarkScore {
+		return errors.ErrInvalidBookmarkScore
+	}
+
+	return nil
+}
+
+// ValidateShelfName validates the name of a shelf.
+//
+// Parameters:
+//   - name (string): The name to validate, already trimmed.
+//
+// Returns:
+//   - error: nil if the name is valid, otherwise an error indicating the issue.
+//
+// Error types:
+//   - errors.ErrInvalidShelf: if the name is blank or longer than 100 characters.
+func ValidateShelfName(name string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > maxShelfNameLength {
+		return errors.ErrInvalidShelf
+	}
+
+	return nil
+}
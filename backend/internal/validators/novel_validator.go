@@ -1,7 +1,11 @@
 package validators
 
 import (
+	"backend/internal/models"
 	"backend/internal/types/errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
 )
 
 // ValidateAuthor validates the author string.
@@ -90,3 +94,135 @@ func ValidateGenre(genre string) error {
 
 	return nil
 }
+
+// ValidateNovelQuery validates the filters and the order of a listing of novels.
+//
+// Parameters:
+//   - query (models.NovelQuery): The query to validate.
+//
+// Returns:
+//   - error: nil if the query is valid, otherwise an error indicating the issue.
+//
+// Error types:
+//   - errors.ErrTagRequired, errors.ErrTagTooLong: if a tag name is invalid.
+//   - errors.ErrGenreRequired, errors.ErrGenreTooLong: if a genre name is invalid.
+//   - errors.ErrAuthorRequired, errors.ErrAuthorTooLong: if an author name is invalid.
+//   - errors.ErrInvalidNovelMatch: if the match mode is neither all nor any.
+//   - errors.ErrInvalidNovelSort: if the sort is unknown.
+//   - errors.ErrInvalidYearRange: if the first year is after the last year.
+//   - errors.ErrInvalidMinChapters: if the minimum number of chapters is negative.
+func ValidateNovelQuery(query models.NovelQuery) error {
+	for _, tag := range slices.Concat(query.IncludeTags, query.ExcludeTags) {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+	}
+	for _, genre := range slices.Concat(query.IncludeGenres, query.ExcludeGenres) {
+		if err := ValidateGenre(genre); err != nil {
+			return err
+		}
+	}
+	for _, author := range slices.Concat(query.IncludeAuthors, query.ExcludeAuthors) {
+		if err := ValidateAuthor(author); err != nil {
+			return err
+		}
+	}
+
+	switch query.Match {
+	case "", models.NovelMatchAll, models.NovelMatchAny:
+	default:
+		return errors.ErrInvalidNovelMatch
+	}
+
+	switch query.Sort {
+	case "", models.NovelSortTitle, models.NovelSortCreated, models.NovelSortUpdated, models.NovelSortLatestChapter, models.NovelSortYear, models.NovelSortPopularity:
+	default:
+		return errors.ErrInvalidNovelSort
+	}
+
+	if query.YearFrom != 0 && query.YearTo != 0 && query.YearFrom > query.YearTo {
+		return errors.ErrInvalidYearRange
+	}
+
+	if query.MinChapters < 0 {
+		return errors.ErrInvalidMinChapters
+	}
+
+	return nil
+}
+
+// ValidateNovelUpdate validates the fields of a novel edited by an administrator.
+//
+// Parameters:
+//   - novel (models.Novel): The novel with the edited fields.
+//
+// Returns:
+//   - error: nil if the novel is valid, otherwise an error indicating the issue.
+//
+// Error types:
+//   - errors.ErrNovelTitleRequired: if the title is empty or contains only spaces.
+//   - errors.ErrNovelFieldTooLong: if a field is longer than its column.
+//   - errors.ErrTagRequired, errors.ErrTagTooLong: if the name of a tag is invalid.
+//   - errors.ErrGenreRequired, errors.ErrGenreTooLong: if the name of a genre is invalid.
+//   - errors.ErrAuthorRequired, errors.ErrAuthorTooLong: if the name of an author is invalid.
+func ValidateNovelUpdate(novel models.Novel) error {
+	if strings.TrimSpace(novel.Title) == "" {
+		return errors.ErrNovelTitleRequired
+	}
+
+	maxLengths := []struct {
+		value string
+		max   int
+	}{
+		{novel.Title, 200},
+		{novel.Synopsis, 25000},
+		{novel.CoverUrl, 255},
+		{novel.Language, 255},
+		{novel.Status, 500},
+		{novel.Year, 255},
+		{novel.ReleaseFrequency, 255},
+	}
+	for _, field := range maxLengths {
+		if utf8.RuneCountInString(field.value) > field.max {
+			return errors.ErrNovelFieldTooLong
+		}
+	}
+
+	for _, tag := range novel.Tags {
+		if err := ValidateTag(strings.TrimSpace(tag.Name)); err != nil {
+			return err
+		}
+	}
+	for _, genre := range novel.Genres {
+		if err := ValidateGenre(strings.TrimSpace(genre.Name)); err != nil {
+			return err
+		}
+	}
+	for _, author := range novel.Authors {
+		if err := ValidateAuthor(strings.TrimSpace(author.Name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateLockedFields validates the names of the fields of a novel locked against the updates of the imports.
+//
+// Parameters:
+//   - fields ([]string): The names of the fields.
+//
+// Returns:
+//   - error: nil if every name is a field that can be locked, otherwise an error indicating the issue.
+//
+// Error types:
+//   - errors.ErrInvalidLockedField: if a name is not one of models.LockableNovelFields.
+func ValidateLockedFields(fields []string) error {
+	for _, field := range fields {
+		if !slices.Contains(models.LockableNovelFields, field) {
+			return errors.ErrInvalidLockedField
+		}
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 import json
+import random
 import sys
+import time
 
 import requests
 
@@ -8,6 +10,70 @@ from .novel import NovelMetadata
 from .request import PlaywrightScraper
 from .sources import NovelSource, ChapterSource
 
+RETRYABLE_STATUSES = {429, 500, 502, 503, 504}
+# The snippets of the Cloudflare challenge and access denied pages, the same as blockedPageMarkers in
+# internal/scrapers/http_fetcher.go. A chapter mentioning a captcha isn't blocked.
+BLOCKED_MARKERS = (
+    "<title>Just a moment...</title>",
+    "<title>Attention Required! | Cloudflare</title>",
+    "cf-challenge-",
+    "cf-chl-",
+    "_cf_chl_opt",
+    "/cdn-cgi/challenge-platform/",
+)
+REQUEST_TIMEOUT = 20
+
+
+def is_blocked(req):
+    """Checks if a response is an access denied or a Cloudflare challenge page instead of the requested page.
+
+    Parameters
+    ----------
+    req : :class:`requests.Response`
+        The response to check.
+
+    Returns
+    -------
+    :class:`bool`
+        True if the source blocked the request.
+    """
+    if req.status_code == 403:
+        return True
+
+    return any(marker in req.text for marker in BLOCKED_MARKERS)
+
+
+def get_with_backoff(url, tries=5, base_delay=0.5, max_delay=30.0):
+    """Gets a page, retrying 429 and 5xx responses with an exponential backoff with jitter.
+
+    Parameters
+    ----------
+    url : :class:`string`
+        The URL of the page.
+    tries : :class:`int`
+        The maximum number of requests.
+    base_delay : :class:`float`
+        The delay in seconds before the first retry, doubled on each following retry.
+    max_delay : :class:`float`
+        The longest delay in seconds between two retries.
+
+    Returns
+    -------
+    :class:`requests.Response`
+        The last response.
+    """
+    req = requests.get(url, timeout=REQUEST_TIMEOUT)
+
+    for attempt in range(tries - 1):
+        if req.status_code not in RETRYABLE_STATUSES:
+            break
+
+        delay = min(base_delay * 2 ** attempt, max_delay)
+        time.sleep(random.uniform(delay / 2, delay))
+        req = requests.get(url, timeout=REQUEST_TIMEOUT)
+
+    return req
+
 
 class Client:
     def __init__(self):
@@ -81,7 +147,10 @@ class Client:
         Returns
         -------
         :class:`dict`
-            A dictionary containing chapter information, or an error indicator.
+            A dictionary containing chapter information, including the url and the name of the website it was fetched
+            from, or an error indicator. The status of an error tells why the
+            chapter could not be imported: 404 not found, 204 empty chapter, 403 blocked or captcha, 502 unexpected
+            page structure, 504 timeout and 503 source unavailable.
         """
         urls = {
             ChapterSource.WUXIABOX: f"https://www.wuxiabox.com/novel/{novel_id}_{chapter_no}.html",
@@ -92,36 +161,50 @@ class Client:
 
         for urlKey in urls.keys():
             url = urls[urlKey]
-            req = requests.get(url)
-            tries = 5
+            try:
+                req = get_with_backoff(url)
+            except requests.exceptions.Timeout:
+                error = {"status": 504, "chapter_no": chapter_no, "error": "Timed out fetching the chapter"}
+                continue
+            except requests.exceptions.RequestException as e:
+                error = {"status": 503, "chapter_no": chapter_no, "error": f"Failed to fetch the chapter: {e}"}
+                continue
 
             if not isinstance(req, requests.Response):
                 error = {"status": 400, "chapter_no": chapter_no, "error": "Invalid response object"}
                 continue
 
-            while tries > 0 and req.status_code != 200:
-                if req.status_code == 404:
-                    error = {"status": 404, "chapter_no": chapter_no, "error": "Chapter not found"}
-                    break
-                else:
-                    tries -= 1
-                    req = requests.get(url)  # Retry request
+            if req.status_code == 404:
+                error = {"status": 404, "chapter_no": chapter_no, "error": "Chapter not found"}
+                continue
+
+            if is_blocked(req):
+                error = {"status": 403, "chapter_no": chapter_no, "error": "Blocked by the source"}
+                continue
 
             if req.status_code != 200:
                 error = {
-                    "status": 500,
+                    "status": 503,
                     "chapter_no": chapter_no,
-                    "error": "Failed to retrieve chapter",
+                    "error": f"Failed to retrieve chapter (status {req.status_code})",
                 }
                 continue
 
             chapter_data = {}
 
             # Parse the chapter content
-            if urlKey == ChapterSource.WUXIABOX:
-                chapter_data = parsers.parse_chapters_wuxiabox(req)
-            elif urlKey == ChapterSource.NOVTALES:
-                chapter_data = parsers.parse_chapters_novtales(req)
+            try:
+                if urlKey == ChapterSource.WUXIABOX:
+                    chapter_data = parsers.parse_chapters_wuxiabox(req)
+                elif urlKey == ChapterSource.NOVTALES:
+                    chapter_data = parsers.parse_chapters_novtales(req)
+            except (AttributeError, KeyError, TypeError) as e:
+                error = {"status": 502, "chapter_no": chapter_no, "error": f"Failed to parse chapter: {e}"}
+                continue
+
+            if chapter_data is None:
+                error = {"status": 502, "chapter_no": chapter_no, "error": "Failed to find the chapter in the page"}
+                continue
 
             if not chapter_data or not chapter_data["body"].strip():
                 error = {"status": 204, "chapter_no": chapter_no, "error": "Empty chapter"}
@@ -132,12 +215,70 @@ class Client:
                 "chapter_no": chapter_no,
                 "title": chapter_data["title"],
                 "url": url,
+                "source": urlKey.name.lower(),
                 "body": chapter_data["body"],
             }
 
         return error
 
 
+def handle(client, action, args):
+    """Runs an action of the client.
+
+    Parameters
+    ----------
+    client : :class:`Client`
+        The client used to run the action.
+    action : :class:`string`
+        The action to run ("import-novel" or "import-chapter").
+    args : :class:`list`
+        The arguments of the action.
+
+    Returns
+    -------
+    :class:`dict`
+        The result of the action, or an error indicator.
+    """
+    if action == "import-novel":
+        if len(args) > 0:
+            return client.series_info(args[0])
+        return {"status": 400, "error": "No series ID provided"}
+    elif action == "import-chapter":
+        if len(args) > 1:
+            return client.chapters(args[0], args[1])
+        return {"status": 400, "error": "Invalid arguments for import-chapter"}
+
+    return {"status": 400, "error": "Invalid action"}
+
+
+def serve(client):
+    """Serves requests sent as JSON lines on stdin until it is closed.
+
+    Each request is a line like ``{"id": 1, "action": "import-chapter", "args": ["novel-id", "1"]}`` and is answered
+    with a single line ``{"id": 1, "result": {...}}`` on stdout.
+
+    Parameters
+    ----------
+    client : :class:`Client`
+        The client kept alive between requests.
+    """
+    for line in sys.stdin:
+        line = line.strip()
+        if not line:
+            continue
+
+        request_id = 0
+        try:
+            request = json.loads(line)
+            request_id = request.get("id", 0)
+            result = handle(client, request.get("action"), request.get("args") or [])
+        except Exception as e:
+            result = {"status": 500, "error": f"Unexpected error: {e}"}
+
+        sys.stdout.write(json.dumps({"id": request_id, "result": result}) + "\n")
+        sys.stdout.flush()
+
+
 if __name__ == "__main__":
     client = Client()
 
@@ -145,25 +286,14 @@ if __name__ == "__main__":
     if len(sys.argv) > 1:
         action = sys.argv[1]
 
-        if action == "import-novel":
-            if len(sys.argv) > 2:
-                series_id = sys.argv[2]
-                result = client.series_info(series_id)
-                print(json.dumps(result))
-            else:
-                print(json.dumps({"status": 400, "error": "No series ID provided"}))
-                sys.exit(1)
-        elif action == "import-chapter":
-            if len(sys.argv) > 3:
-                series_id = sys.argv[2]
-                chapterNo = sys.argv[3]
-                result = client.chapters(series_id, chapterNo)
-                print(json.dumps(result))
-            else:
-                print(json.dumps({"status": 400, "error": "Invalid arguments for import-chapter"}))
-                sys.exit(1)
-        else:
-            print(json.dumps({"status": 400, "error": "Invalid action"}))
+        if action == "worker":
+            serve(client)
+            sys.exit(0)
+
+        result = handle(client, action, sys.argv[2:])
+        print(json.dumps(result))
+
+        if isinstance(result, dict) and result.get("status") == 400:
             sys.exit(1)
     else:
         print(json.dumps({"status": 400, "error": "No action provided"}))
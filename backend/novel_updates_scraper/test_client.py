@@ -0,0 +1,29 @@
+import unittest
+from types import SimpleNamespace
+
+from .client import is_blocked
+
+
+def response(text, status_code=200):
+    return SimpleNamespace(status_code=status_code, text=text)
+
+
+class IsBlockedTest(unittest.TestCase):
+    def test_cloudflare_challenge_pages_are_blocked(self):
+        self.assertTrue(is_blocked(response("<html><head><title>Just a moment...</title></head></html>")))
+        self.assertTrue(is_blocked(response('<script src="/cdn-cgi/challenge-platform/h/g/orchestrate/chl_page/v1"></script>')))
+        self.assertTrue(is_blocked(response('<div id="cf-chl-widget-1a2b3"></div>')))
+
+    def test_forbidden_responses_are_blocked(self):
+        self.assertTrue(is_blocked(response("", status_code=403)))
+
+    def test_chapters_mentioning_a_captcha_are_not_blocked(self):
+        chapter = """<html><head><title>Chapter 6: The Captcha Gu</title></head><body>
+        <p>The captcha Gu tested every visitor of the clan. "Just a moment..." said Fang Yuan.</p>
+        <div class="g-recaptcha" data-sitekey="key"></div>
+        </body></html>"""
+        self.assertFalse(is_blocked(response(chapter)))
+
+
+if __name__ == "__main__":
+    unittest.main()
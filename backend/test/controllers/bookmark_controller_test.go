@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupBookmarkLifecycle cleans the database, creates a user and three novels, the first and the last completed, and
+// returns a router serving the bookmarks and the reading positions with requests authenticated as that user.
+func setupBookmarkLifecycle(t *testing.T) (*gin.Engine, models.User, []models.Novel) {
+	utils.TruncateTables(t, db)
+
+	user := models.User{Username: "shelver", Email: "shelver@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	novels := []models.Novel{
+		{Title: "Release That Witch", NovelUpdatesID: "release-that-witch", Status: "1498 Chapters (Completed)", LatestChapter: 10},
+		{Title: "The Wandering Inn", NovelUpdatesID: "the-wandering-inn", Status: "Ongoing", LatestChapter: 50},
+		{Title: "Coiling Dragon", NovelUpdatesID: "coiling-dragon", Status: "Completed", LatestChapter: 5},
+	}
+	for i := range novels {
+		if err := db.Create(&novels[i]).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	bookmarkRepo := repositories.NewBookmarkRepository(db)
+	novelRepo := repositories.NewNovelRepository(db)
+	bookmarkController := controllers.NewBookmarkController(services.NewBookmarkService(bookmarkRepo, novelRepo))
+	readingPositionController := controllers.NewReadingPositionController(
+		services.NewReadingPositionService(repositories.NewReadingPositionRepository(db), novelRepo, bookmarkRepo),
+	)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authenticated := func(c *gin.Context) {
+		c.Set("user", &user)
+		c.Next()
+	}
+	router.POST("/novels/bookmarked/", authenticated, bookmarkController.CreateBookmark)
+	router.PUT("/novels/bookmarked/", authenticated, bookmarkController.UpdateBookmark)
+	router.GET("/user/continue-reading", authenticated, bookmarkController.GetContinueReading)
+	router.PUT("/novels/:novel_id/reading-position", authenticated, readingPositionController.SyncReadingPosition)
+
+	return router, user, novels
+}
+
+// saveBookmark creates or updates a bookmark and decodes the bookmark stored.
+func saveBookmark(t *testing.T, router *gin.Engine, method string, body string) models.BookmarkedNovel {
+	w := doRequest(router, method, "/novels/bookmarked/", body)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var bookmark models.BookmarkedNovel
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &bookmark))
+	return bookmark
+}
+
+// bookmarkStatus returns the stored status of the bookmark of a novel.
+func bookmarkStatus(t *testing.T, userID, novelID uint) string {
+	var bookmark models.BookmarkedNovel
+	if err := db.Where("user_id = ? AND novel_id = ?", userID, novelID).First(&bookmark).Error; err != nil {
+		t.Fatalf("Failed to get bookmark: %v", err)
+	}
+	return bookmark.Status
+}
+
+func TestBookmarkLifecycle(t *testing.T) {
+	router, user, novels := setupBookmarkLifecycle(t)
+	t.Cleanup(cleanDB)
+	bookmarkBody := func(novel models.Novel, status string, score, currentChapter int) string {
+		return fmt.Sprintf(`{"novelId":%d,"userId":%d,"status":"%s","score":%d,"currentChapter":%d}`, novel.ID, user.ID, status, score, currentChapter)
+	}
+
+	t.Run("#BMK_01->Invalid bookmarks are rejected", func(t *testing.T) {
+		for body, code := range map[string]string{
+			bookmarkBody(novels[0], "Reading Now", 0, 0):  errors.INVALID_BOOKMARK_STATUS,
+			bookmarkBody(novels[0], "finished", 0, 0):     errors.INVALID_BOOKMARK_STATUS,
+			bookmarkBody(novels[0], "reading", 6, 0):      errors.INVALID_BOOKMARK_SCORE,
+			bookmarkBody(novels[0], "reading", -1, 0):     errors.INVALID_BOOKMARK_SCORE,
+			bookmarkBody(novels[0], "reading", 0, -1):     errors.INVALID_BOOKMARK,
+			`{"novelId":"one","status":"reading"}`:        errors.INVALID_BOOKMARK,
+			bookmarkBody(models.Novel{}, "reading", 0, 0): errors.INVALID_BOOKMARK,
+		} {
+			w := doRequest(router, http.MethodPost, "/novels/bookmarked/", body)
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+			assert.Contains(t, w.Body.String(), code, body)
+		}
+
+		w := doRequest(router, http.MethodPost, "/novels/bookmarked/", `{"novelId":99999,"status":"reading"}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = doRequest(router, http.MethodPut, "/novels/bookmarked/", bookmarkBody(novels[1], "reading", 0, 1))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.BOOKMARK_NOT_FOUND)
+
+		w = doRequest(router, http.MethodGet, "/user/continue-reading?status=finished", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_BOOKMARK_STATUS)
+	})
+
+	t.Run("#BMK_02->Reading further moves the status on", func(t *testing.T) {
+		bookmark := saveBookmark(t, router, http.MethodPost, bookmarkBody(novels[0], "plan-to-read", 0, 0))
+		assert.Equal(t, models.BookmarkStatusPlanToRead, bookmark.Status)
+
+		bookmark = saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[0], "plan-to-read", 0, 3))
+		assert.Equal(t, models.BookmarkStatusReading, bookmark.Status)
+		assert.Equal(t, models.BookmarkStatusReading, bookmarkStatus(t, user.ID, novels[0].ID))
+
+		bookmark = saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[0], "reading", 5, 10))
+		assert.Equal(t, models.BookmarkStatusCompleted, bookmark.Status)
+		assert.Equal(t, 5, bookmark.Score)
+		assert.Equal(t, models.BookmarkStatusCompleted, bookmarkStatus(t, user.ID, novels[0].ID))
+
+		// Setting a status without reading further keeps it
+		bookmark = saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[0], "on-hold", 5, 10))
+		assert.Equal(t, models.BookmarkStatusOnHold, bookmark.Status)
+	})
+
+	t.Run("#BMK_03->Ongoing and dropped novels are not completed", func(t *testing.T) {
+		bookmark := saveBookmark(t, router, http.MethodPost, bookmarkBody(novels[1], "reading", 0, 50))
+		assert.Equal(t, models.BookmarkStatusReading, bookmark.Status)
+
+		bookmark = saveBookmark(t, router, http.MethodPost, bookmarkBody(novels[2], "dropped", 0, 1))
+		assert.Equal(t, models.BookmarkStatusDropped, bookmark.Status)
+		bookmark = saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[2], "dropped", 0, 5))
+		assert.Equal(t, models.BookmarkStatusDropped, bookmark.Status)
+	})
+
+	t.Run("#BMK_04->Synced reading positions move the status on", func(t *testing.T) {
+		saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[1], "on-hold", 0, 50))
+		w := doRequest(router, http.MethodPut, fmt.Sprintf("/novels/%d/reading-position", novels[1].ID), `{"chapterNo":51,"deviceId":"phone"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, models.BookmarkStatusReading, bookmarkStatus(t, user.ID, novels[1].ID))
+
+		saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[2], "reading", 0, 2))
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("/novels/%d/reading-position", novels[2].ID), `{"chapterNo":5,"deviceId":"phone"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, models.BookmarkStatusCompleted, bookmarkStatus(t, user.ID, novels[2].ID))
+	})
+
+	t.Run("#BMK_05->Statuses written for people are normalized", func(t *testing.T) {
+		if err := db.Unscoped().Where("user_id = ? AND novel_id = ?", user.ID, novels[1].ID).Delete(&models.BookmarkedNovel{}).Error; err != nil {
+			t.Fatalf("Failed to delete bookmark: %v", err)
+		}
+
+		bookmark := saveBookmark(t, router, http.MethodPost, bookmarkBody(novels[1], "Plan to Read", 0, 0))
+		assert.Equal(t, models.BookmarkStatusPlanToRead, bookmark.Status)
+		assert.Equal(t, models.BookmarkStatusPlanToRead, bookmarkStatus(t, user.ID, novels[1].ID))
+
+		bookmark = saveBookmark(t, router, http.MethodPut, bookmarkBody(novels[1], "On_Hold", 0, 0))
+		assert.Equal(t, models.BookmarkStatusOnHold, bookmark.Status)
+
+		w := doRequest(router, http.MethodGet, "/user/continue-reading?status=On-Hold", "")
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), novels[1].Title)
+	})
+}
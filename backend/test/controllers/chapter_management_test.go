@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupChapterManagement cleans the database, creates a novel with chapters 1 to 5 and returns a router serving the
+// chapter management endpoints.
+func setupChapterManagement(t *testing.T) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	novel := models.Novel{
+		Title:          "Lord of the Mysteries",
+		Synopsis:       "Test",
+		CoverUrl:       "https://example.com/cover.jpg",
+		Language:       "en",
+		Status:         "Completed",
+		NovelUpdatesID: "lord-of-the-mysteries",
+		LatestChapter:  5,
+	}
+	if err := db.Create(&novel).Error; err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	for i := uint(1); i <= 5; i++ {
+		chapter := models.Chapter{
+			ChapterNo:  i,
+			NovelID:    &novel.ID,
+			Title:      fmt.Sprintf("Chapter %d", i),
+			ChapterUrl: fmt.Sprintf("https://example.com/lord-of-the-mysteries/%d", i),
+			Body:       fmt.Sprintf("Klein woke up for the %d time.", i),
+		}
+		if err := db.Create(&chapter).Error; err != nil {
+			t.Fatalf("Failed to create chapter: %v", err)
+		}
+	}
+
+	chapterRepo := repositories.NewChapterRepository(db)
+	chapterService := services.NewChapterService(chapterRepo, repositories.NewNovelRepository(db), &mocks.MockSource{SourceName: "mock"})
+	chapterController := controllers.NewChapterController(chapterService, services.NewReadingHistoryService(repositories.NewReadingEventRepository(db)))
+	chapterRevisionController := controllers.NewChapterRevisionController(
+		services.NewChapterRevisionService(repositories.NewChapterRevisionRepository(db), chapterRepo, chapterService),
+	)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	chapters := router.Group("/novels/chapters/novel/:novel_title")
+	chapters.PUT("/chapter/:chapter_no", chapterRevisionController.EditChapter)
+	chapters.GET("/chapter/:chapter_no/revisions", chapterRevisionController.GetChapterRevisions)
+	chapters.DELETE("/chapter/:chapter_no", chapterController.DeleteChapter)
+	chapters.POST("/chapters", chapterController.InsertChapter)
+	chapters.DELETE("/chapters", chapterController.DeleteChapters)
+	chapters.POST("/chapters/renumber", chapterController.RenumberChapters)
+
+	return router
+}
+
+// chapterTitles returns the titles of the stored chapters of the novel by chapter number.
+func chapterTitles(t *testing.T) map[uint]string {
+	var chapters []models.Chapter
+	if err := db.Order("chapter_no ASC").Find(&chapters).Error; err != nil {
+		t.Fatalf("Failed to get chapters: %v", err)
+	}
+
+	titles := make(map[uint]string, len(chapters))
+	for _, chapter := range chapters {
+		titles[chapter.ChapterNo] = chapter.Title
+	}
+	return titles
+}
+
+// latestChapter returns the latest chapter of the novel.
+func latestChapter(t *testing.T) int {
+	var novel models.Novel
+	if err := db.Where("novel_updates_id = ?", "lord-of-the-mysteries").First(&novel).Error; err != nil {
+		t.Fatalf("Failed to get novel: %v", err)
+	}
+	return novel.LatestChapter
+}
+
+func TestChapterManagement(t *testing.T) {
+	path := "/novels/chapters/novel/lord-of-the-mysteries"
+
+	t.Run("#CHM_01->Edit stores a manual revision served to readers", func(t *testing.T) {
+		router := setupChapterManagement(t)
+
+		w := doRequest(router, http.MethodPut, path+"/chapter/2", `{"body":"Klein woke up, fixed."}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var revision models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revision))
+		assert.Equal(t, 2, revision.Revision)
+		assert.Equal(t, models.RevisionOriginManual, revision.Origin)
+		assert.Equal(t, "Chapter 2", revision.Title)
+		assert.True(t, revision.Current)
+
+		var chapter models.Chapter
+		db.Where("chapter_no = ?", 2).First(&chapter)
+		assert.Equal(t, "Klein woke up, fixed.", chapter.Body)
+		assert.Equal(t, models.ManualChapterSource, chapter.Source)
+
+		w = doRequest(router, http.MethodGet, path+"/chapter/2/revisions", "")
+		var revisions []models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revisions))
+		assert.Len(t, revisions, 2)
+		assert.False(t, revisions[0].Current)
+
+		w = doRequest(router, http.MethodPut, path+"/chapter/2", `{"body":"Klein woke up, fixed."}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.CHAPTER_REVISION_UNCHANGED)
+
+		w = doRequest(router, http.MethodPut, path+"/chapter/2", `{"title":"  "}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CHAPTER)
+
+		w = doRequest(router, http.MethodPut, path+"/chapter/9", `{"title":"Chapter 9"}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#CHM_02->Delete a chapter and a range of chapters", func(t *testing.T) {
+		router := setupChapterManagement(t)
+
+		w := doRequest(router, http.MethodDelete, path+"/chapter/1", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(router, http.MethodDelete, path+"/chapter/1", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = doRequest(router, http.MethodDelete, path+"/chapters?from=3&to=4", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.ChapterCountResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int64(2), response.Count)
+		assert.Equal(t, map[uint]string{2: "Chapter 2", 5: "Chapter 5"}, chapterTitles(t))
+
+		w = doRequest(router, http.MethodDelete, path+"/chapters?from=4&to=3", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = doRequest(router, http.MethodDelete, "/novels/chapters/novel/unknown/chapters?from=1&to=2", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#CHM_03->Renumber a range of chapters", func(t *testing.T) {
+		router := setupChapterManagement(t)
+
+		w := doRequest(router, http.MethodPost, path+"/chapters/renumber", `{"from":3,"offset":2}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.ChapterCountResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int64(3), response.Count)
+		assert.Equal(t, map[uint]string{1: "Chapter 1", 2: "Chapter 2", 5: "Chapter 3", 6: "Chapter 4", 7: "Chapter 5"}, chapterTitles(t))
+		assert.Equal(t, 7, latestChapter(t))
+
+		w = doRequest(router, http.MethodPost, path+"/chapters/renumber", `{"from":5,"to":6,"offset":-3}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.CHAPTER_CONFLICT)
+
+		w = doRequest(router, http.MethodPost, path+"/chapters/renumber", `{"from":1,"offset":-1}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CHAPTER_RENUMBERING)
+
+		w = doRequest(router, http.MethodPost, path+"/chapters/renumber", `{"from":3,"to":4,"offset":10}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#CHM_04->Insert a chapter written by hand", func(t *testing.T) {
+		router := setupChapterManagement(t)
+
+		w := doRequest(router, http.MethodPost, path+"/chapters", `{"chapterNo":3,"title":"Side Story","body":"Audrey wrote a letter."}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = doRequest(router, http.MethodPost, path+"/chapters", `{"chapterNo":3,"title":"Side Story","body":"Audrey wrote a letter.","shift":true}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var chapter models.Chapter
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &chapter))
+		assert.Equal(t, uint(3), chapter.ChapterNo)
+		assert.Equal(t, models.ManualChapterSource, chapter.Source)
+		assert.True(t, strings.HasPrefix(chapter.ChapterUrl, "manual://lord-of-the-mysteries/"), chapter.ChapterUrl)
+		assert.Equal(t, map[uint]string{1: "Chapter 1", 2: "Chapter 2", 3: "Side Story", 4: "Chapter 3", 5: "Chapter 4", 6: "Chapter 5"}, chapterTitles(t))
+		assert.Equal(t, 6, latestChapter(t))
+
+		w = doRequest(router, http.MethodPost, path+"/chapters", `{"chapterNo":10,"title":"Epilogue","body":"The end."}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 10, latestChapter(t))
+
+		var epilogue models.Chapter
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &epilogue))
+		assert.NotEqual(t, chapter.ChapterUrl, epilogue.ChapterUrl)
+
+		w = doRequest(router, http.MethodPost, path+"/chapters", `{"chapterNo":11,"title":"Empty"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CHAPTER)
+	})
+}
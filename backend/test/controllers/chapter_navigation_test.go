@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupChapterNavigation cleans the database, creates a novel with chapters 1 to 25 but 3 and 10, whose bodies have as
+// many words as their number, and returns a router serving the chapter reading endpoints.
+func setupChapterNavigation(t *testing.T) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	novel := models.Novel{
+		Title:          "Shadow Slave",
+		Synopsis:       "Test",
+		CoverUrl:       "https://example.com/cover.jpg",
+		Language:       "en",
+		Status:         "Ongoing",
+		NovelUpdatesID: "shadow-slave",
+		LatestChapter:  25,
+	}
+	if err := db.Create(&novel).Error; err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	chapterRepo := repositories.NewChapterRepository(db)
+	for i := uint(1); i <= 25; i++ {
+		if i == 3 || i == 10 {
+			continue
+		}
+		if _, err := chapterRepo.CreateChapter(models.Chapter{
+			ChapterNo:  i,
+			NovelID:    &novel.ID,
+			Title:      fmt.Sprintf("Chapter %d", i),
+			ChapterUrl: fmt.Sprintf("https://example.com/shadow-slave/%d", i),
+			Body:       strings.TrimSpace(strings.Repeat("word\n", int(i))),
+		}); err != nil {
+			t.Fatalf("Failed to create chapter: %v", err)
+		}
+	}
+
+	chapterService := services.NewChapterService(chapterRepo, repositories.NewNovelRepository(db), &mocks.MockSource{SourceName: "mock"})
+	chapterController := controllers.NewChapterController(chapterService, services.NewReadingHistoryService(repositories.NewReadingEventRepository(db)))
+	chapterRevisionController := controllers.NewChapterRevisionController(
+		services.NewChapterRevisionService(repositories.NewChapterRevisionRepository(db), chapterRepo, chapterService),
+	)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	chapters := router.Group("/novels/chapters/novel/:novel_title")
+	chapters.GET("/chapter/:chapter_no", chapterController.GetChapterByNovelUpdatesIDAndChapterNo)
+	chapters.PUT("/chapter/:chapter_no", chapterRevisionController.EditChapter)
+	chapters.GET("/toc", chapterController.GetTableOfContents)
+
+	return router
+}
+
+// getTableOfContents requests a page of the table of contents and decodes it.
+func getTableOfContents(t *testing.T, router *gin.Engine, path string) ([]dtos.ChapterTOCEntry, string) {
+	w := doRequest(router, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data       []dtos.ChapterTOCEntry `json:"data"`
+		NextCursor string                 `json:"nextCursor"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data, response.NextCursor
+}
+
+// getChapterResponse requests a chapter and decodes it.
+func getChapterResponse(t *testing.T, router *gin.Engine, chapterNo uint) dtos.ChapterResponse {
+	w := doRequest(router, http.MethodGet, fmt.Sprintf("/novels/chapters/novel/shadow-slave/chapter/%d", chapterNo), "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var chapter dtos.ChapterResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &chapter))
+	return chapter
+}
+
+func TestChapterNavigation(t *testing.T) {
+	router := setupChapterNavigation(t)
+	path := "/novels/chapters/novel/shadow-slave/toc"
+
+	t.Run("#NAV_01->Table of contents lists the chapters without their bodies", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "body")
+		assert.NotContains(t, w.Body.String(), "nextCursor")
+
+		entries, _ := getTableOfContents(t, router, path)
+		assert.Len(t, entries, 23)
+		assert.Equal(t, uint(4), entries[2].ChapterNo)
+		assert.Equal(t, "Chapter 4", entries[2].Title)
+		assert.Equal(t, 4, entries[2].WordCount)
+		assert.False(t, entries[2].CreatedAt.IsZero())
+	})
+
+	t.Run("#NAV_02->Table of contents is paginated by cursor", func(t *testing.T) {
+		var chapterNumbers []uint
+		cursor := ""
+		for pages := 0; pages < 5; pages++ {
+			entries, next := getTableOfContents(t, router, path+"?limit=10&cursor="+cursor)
+			for _, entry := range entries {
+				chapterNumbers = append(chapterNumbers, entry.ChapterNo)
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		assert.Len(t, chapterNumbers, 23)
+		assert.Equal(t, "22", cursor)
+		assert.NotContains(t, chapterNumbers, uint(10))
+		assert.Equal(t, uint(25), chapterNumbers[22])
+	})
+
+	t.Run("#NAV_03->Chapters link to the adjacent stored chapters", func(t *testing.T) {
+		chapter := getChapterResponse(t, router, 2)
+		assert.Equal(t, "Chapter 2", chapter.Title)
+		assert.Equal(t, 2, chapter.WordCount)
+		assert.Equal(t, uint(1), *chapter.PreviousChapterNo)
+		assert.Equal(t, uint(4), *chapter.NextChapterNo)
+
+		chapter = getChapterResponse(t, router, 1)
+		assert.Nil(t, chapter.PreviousChapterNo)
+		assert.Equal(t, uint(2), *chapter.NextChapterNo)
+
+		chapter = getChapterResponse(t, router, 25)
+		assert.Equal(t, uint(24), *chapter.PreviousChapterNo)
+		assert.Nil(t, chapter.NextChapterNo)
+	})
+
+	t.Run("#NAV_04->Word count follows edits of the chapter", func(t *testing.T) {
+		w := doRequest(router, http.MethodPut, "/novels/chapters/novel/shadow-slave/chapter/1", `{"body":"Sunny woke up in the dark."}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		entries, _ := getTableOfContents(t, router, path+"?limit=10")
+		assert.Equal(t, 6, entries[0].WordCount)
+	})
+
+	t.Run("#NAV_05->Invalid table of contents requests", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path+"?cursor=abc", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CURSOR)
+
+		w = doRequest(router, http.MethodGet, path+"?limit=1000", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = doRequest(router, http.MethodGet, "/novels/chapters/novel/unknown/toc", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		entries, next := getTableOfContents(t, router, path+"?cursor=25")
+		assert.Empty(t, entries)
+		assert.Empty(t, next)
+	})
+}
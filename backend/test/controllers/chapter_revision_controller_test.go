@@ -0,0 +1,248 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	internalInterfaces "backend/internal/interfaces"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/scrapers"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupChapterRevisions cleans the database, creates a novel with a truncated chapter 1 and returns a router serving
+// the chapter revision and novel source priority endpoints backed by the given source.
+func setupChapterRevisions(t *testing.T, source internalInterfaces.Source) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	novel := models.Novel{
+		Title:          "Reverend Insanity",
+		Synopsis:       "Test",
+		CoverUrl:       "https://example.com/cover.jpg",
+		Language:       "en",
+		Status:         "Completed",
+		NovelUpdatesID: "reverend-insanity",
+		LatestChapter:  1,
+	}
+	if err := db.Create(&novel).Error; err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	chapter := models.Chapter{
+		ChapterNo:  1,
+		NovelID:    &novel.ID,
+		Title:      "Chapter 1",
+		ChapterUrl: "https://example.com/reverend-insanity/1",
+		Body:       "Fang Yuan stood on the mountain.\nRead more at example.com",
+	}
+	if err := db.Create(&chapter).Error; err != nil {
+		t.Fatalf("Failed to create chapter: %v", err)
+	}
+
+	chapterRepo := repositories.NewChapterRepository(db)
+	chapterService := services.NewChapterService(chapterRepo, repositories.NewNovelRepository(db), source)
+	chapterRevisionService := services.NewChapterRevisionService(repositories.NewChapterRevisionRepository(db), chapterRepo, chapterService)
+	chapterRevisionController := controllers.NewChapterRevisionController(chapterRevisionService)
+	novelController := controllers.NewNovelController(services.NewNovelService(repositories.NewNovelRepository(db), source))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/:novel_id/sources", novelController.GetNovelSourcePriority)
+	router.PUT("/novels/:novel_id/sources", novelController.UpdateNovelSourcePriority)
+	chapters := router.Group("/novels/chapters/novel/:novel_title/chapter/:chapter_no")
+	chapters.POST("/rescrape", chapterRevisionController.RescrapeChapter)
+	chapters.GET("/revisions", chapterRevisionController.GetChapterRevisions)
+	chapters.GET("/revisions/diff", chapterRevisionController.DiffChapterRevisions)
+	chapters.PUT("/revisions/:revision/current", chapterRevisionController.SetCurrentChapterRevision)
+
+	return router
+}
+
+func TestChapterRevisions(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchChapter", "reverend-insanity", 1).Return(&models.ImportedChapterMetadata{
+		ID:         1,
+		Title:      "Chapter 1",
+		Body:       "<p>Fang Yuan stood on the mountain.</p><p>The wind howled.</p>",
+		ChapterUrl: "https://example.com/reverend-insanity/1",
+	}, nil)
+
+	router := setupChapterRevisions(t, source)
+	path := "/novels/chapters/novel/reverend-insanity/chapter/1"
+
+	t.Run("#CRV_01->Chapter that was never scraped again has a single revision", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path+"/revisions", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var revisions []models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revisions))
+		assert.Len(t, revisions, 1)
+		assert.Equal(t, 1, revisions[0].Revision)
+		assert.True(t, revisions[0].Current)
+	})
+
+	t.Run("#CRV_02->Re-scrape stores a new revision without changing the chapter", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, path+"/rescrape", "")
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var revision models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revision))
+		assert.Equal(t, 2, revision.Revision)
+		assert.Equal(t, models.RevisionOriginRescrape, revision.Origin)
+		assert.False(t, revision.Current)
+
+		var chapter models.Chapter
+		db.Where("chapter_no = ?", 1).First(&chapter)
+		assert.Contains(t, chapter.Body, "Read more at example.com")
+
+		w = doRequest(router, http.MethodGet, path+"/revisions", "")
+		var revisions []models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revisions))
+		assert.Len(t, revisions, 2)
+		assert.Equal(t, models.RevisionOriginImport, revisions[0].Origin)
+		assert.True(t, revisions[0].Current)
+	})
+
+	t.Run("#CRV_03->Identical re-scrape is rejected", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, path+"/rescrape", "")
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.CHAPTER_REVISION_UNCHANGED)
+	})
+
+	t.Run("#CRV_04->Diff compares the current revision with the latest one", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path+"/revisions/diff", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var diff dtos.ChapterRevisionDiff
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &diff))
+		assert.Equal(t, 1, diff.From)
+		assert.Equal(t, 2, diff.To)
+		assert.Equal(t, 1, diff.Unchanged)
+		assert.Equal(t, 1, diff.Removed)
+		assert.Equal(t, 1, diff.Added)
+		assert.Equal(t, dtos.ParagraphChange{Change: dtos.ParagraphRemoved, From: 2, Text: "Read more at example.com"}, diff.Paragraphs[1])
+		assert.Equal(t, dtos.ParagraphChange{Change: dtos.ParagraphAdded, To: 2, Text: "The wind howled."}, diff.Paragraphs[2])
+	})
+
+	t.Run("#CRV_05->Current revision is served to readers", func(t *testing.T) {
+		w := doRequest(router, http.MethodPut, path+"/revisions/2/current", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var chapter models.Chapter
+		db.Where("chapter_no = ?", 1).First(&chapter)
+		assert.NotContains(t, chapter.Body, "Read more at example.com")
+		assert.Contains(t, chapter.Body, "The wind howled.")
+
+		w = doRequest(router, http.MethodGet, path+"/revisions", "")
+		var revisions []models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revisions))
+		assert.False(t, revisions[0].Current)
+		assert.True(t, revisions[1].Current)
+	})
+
+	t.Run("#CRV_06->Invalid requests", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, doRequest(router, http.MethodPut, path+"/revisions/9/current", "").Code)
+		assert.Equal(t, http.StatusBadRequest, doRequest(router, http.MethodPut, path+"/revisions/abc/current", "").Code)
+		assert.Equal(t, http.StatusBadRequest, doRequest(router, http.MethodGet, path+"/revisions/diff?from=0", "").Code)
+		assert.Equal(t, http.StatusNotFound, doRequest(router, http.MethodGet, path+"/revisions/diff?to=7", "").Code)
+		assert.Equal(t, http.StatusNotFound, doRequest(router, http.MethodGet, "/novels/chapters/novel/reverend-insanity/chapter/5/revisions", "").Code)
+	})
+}
+
+func TestChapterRevisions_Sources(t *testing.T) {
+	primary := &mocks.MockSource{SourceName: "primary"}
+	secondary := &mocks.MockSource{SourceName: "secondary"}
+	tertiary := &mocks.MockSource{SourceName: "tertiary"}
+	for _, source := range []*mocks.MockSource{primary, secondary, tertiary} {
+		source.On("FetchChapter", "reverend-insanity", 1).Return(&models.ImportedChapterMetadata{
+			ID:         1,
+			Title:      "Chapter 1",
+			Body:       fmt.Sprintf("<p>Fang Yuan stood on the mountain.</p><p>Translated by %s.</p>", source.SourceName),
+			ChapterUrl: "https://" + source.SourceName + ".com/reverend-insanity/1",
+		}, nil)
+	}
+
+	router := setupChapterRevisions(t, scrapers.NewRegistry(primary, secondary, tertiary))
+	path := "/novels/chapters/novel/reverend-insanity/chapter/1"
+
+	var novel models.Novel
+	db.Where("novel_updates_id = ?", "reverend-insanity").First(&novel)
+	sourcesPath := fmt.Sprintf("/novels/%d/sources", novel.ID)
+
+	t.Run("#CRV_07->Re-scrape records the source of the revision", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, path+"/rescrape", "")
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var revision models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revision))
+		assert.Equal(t, "primary", revision.Source)
+		assert.Equal(t, "https://primary.com/reverend-insanity/1", revision.ChapterUrl)
+	})
+
+	t.Run("#CRV_08->Source priority of the novel is tried first", func(t *testing.T) {
+		w := doRequest(router, http.MethodPut, sourcesPath, `{"sources":["secondary"]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var priority dtos.SourcePriorityResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &priority))
+		assert.Equal(t, []string{"secondary"}, priority.Sources)
+		assert.Equal(t, []string{"secondary", "primary", "tertiary"}, priority.Order)
+		assert.Equal(t, []string{"primary", "secondary", "tertiary"}, priority.Available)
+
+		w = doRequest(router, http.MethodPost, path+"/rescrape", "")
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var revision models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revision))
+		assert.Equal(t, "secondary", revision.Source)
+	})
+
+	t.Run("#CRV_09->Forced re-import from a source replaces the chapter", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, path+"/rescrape?source=tertiary&current=true", "")
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var revision models.ChapterRevision
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &revision))
+		assert.Equal(t, "tertiary", revision.Source)
+		assert.True(t, revision.Current)
+
+		var chapter models.Chapter
+		db.Where("chapter_no = ?", 1).First(&chapter)
+		assert.Equal(t, "tertiary", chapter.Source)
+		assert.Equal(t, "https://tertiary.com/reverend-insanity/1", chapter.ChapterUrl)
+		assert.Contains(t, chapter.Body, "Translated by tertiary.")
+	})
+
+	t.Run("#CRV_10->Invalid sources", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, path+"/rescrape?source=unknown", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.SOURCE_NOT_REGISTERED)
+
+		assert.Equal(t, http.StatusBadRequest, doRequest(router, http.MethodPost, path+"/rescrape?current=maybe", "").Code)
+		assert.Equal(t, http.StatusBadRequest, doRequest(router, http.MethodPut, sourcesPath, `{"sources":["unknown"]}`).Code)
+		assert.Equal(t, http.StatusBadRequest, doRequest(router, http.MethodPut, sourcesPath, `{"sources":["primary","primary"]}`).Code)
+	})
+
+	t.Run("#CRV_11->Empty source priority restores the default order", func(t *testing.T) {
+		w := doRequest(router, http.MethodPut, sourcesPath, `{"sources":[]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(router, http.MethodGet, sourcesPath, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var priority dtos.SourcePriorityResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &priority))
+		assert.Empty(t, priority.Sources)
+		assert.Equal(t, []string{"primary", "secondary", "tertiary"}, priority.Order)
+	})
+}
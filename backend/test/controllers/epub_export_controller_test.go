@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/scrapers"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupEPUBExport cleans the database, creates a novel with the given number of chapters and a cover saved in coverDir,
+// and returns a router serving the EPUB export endpoint.
+func setupEPUBExport(t *testing.T, coverDir string, chapters int) (*gin.Engine, models.Novel) {
+	utils.TruncateTables(t, db)
+
+	var cover bytes.Buffer
+	if err := png.Encode(&cover, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatalf("Failed to encode cover: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(coverDir, "reverend-insanity.png"), cover.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to save cover: %v", err)
+	}
+
+	novel := models.Novel{
+		Title:          "Reverend Insanity",
+		Synopsis:       "A demon's schemes.",
+		CoverUrl:       "http://localhost/covers/reverend-insanity.png",
+		Language:       "en",
+		Status:         "Completed",
+		NovelUpdatesID: "reverend-insanity",
+		LatestChapter:  chapters,
+		Authors:        []models.Author{{Name: "Gu Zhen Ren"}},
+	}
+	if err := db.Create(&novel).Error; err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	for i := 1; i <= chapters; i++ {
+		chapter := models.Chapter{
+			ChapterNo:  uint(i),
+			NovelID:    &novel.ID,
+			Title:      fmt.Sprintf("Chapter %d", i),
+			ChapterUrl: fmt.Sprintf("https://example.com/reverend-insanity/%d", i),
+			Body:       fmt.Sprintf("Paragraph one of chapter %d.\nParagraph two.", i),
+		}
+		if err := db.Create(&chapter).Error; err != nil {
+			t.Fatalf("Failed to create chapter: %v", err)
+		}
+	}
+
+	epubExportService := services.NewEPUBExportService(repositories.NewNovelRepository(db), repositories.NewChapterRepository(db), coverDir, "http://localhost/covers")
+	epubExportController := controllers.NewEPUBExportController(epubExportService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/:novel_id/export.epub", epubExportController.ExportNovelToEPUB)
+
+	return router, novel
+}
+
+func TestExportNovelToEPUB(t *testing.T) {
+	router, novel := setupEPUBExport(t, t.TempDir(), 120)
+	path := fmt.Sprintf("/novels/%d/export.epub", novel.ID)
+
+	t.Run("#EPE_01->Whole novel is exported in order", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/epub+zip", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `filename="reverend-insanity.epub"`)
+
+		book, err := scrapers.ParseEPUB(w.Body.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, "Reverend Insanity", book.Title)
+		assert.Equal(t, "A demon's schemes.", book.Synopsis)
+		assert.Equal(t, "Gu Zhen Ren", book.Authors[0].Name)
+		assert.Equal(t, ".png", book.CoverExtension)
+
+		var titles []string
+		for _, chapter := range book.Chapters {
+			if chapter.Err == nil {
+				titles = append(titles, chapter.Title)
+			}
+		}
+		assert.Len(t, titles, 120)
+		assert.Equal(t, "Chapter 1", titles[0])
+		assert.Equal(t, "Chapter 51", titles[50])
+		assert.Equal(t, "Chapter 120", titles[119])
+	})
+
+	t.Run("#EPE_02->Range of chapters is exported", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path+"?from=99&to=101", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `filename="reverend-insanity-99-101.epub"`)
+
+		book, err := scrapers.ParseEPUB(w.Body.Bytes())
+		assert.NoError(t, err)
+
+		var chapters []string
+		for _, chapter := range book.Chapters {
+			if chapter.Err == nil {
+				chapters = append(chapters, chapter.Body)
+			}
+		}
+		assert.Equal(t, []string{
+			"Paragraph one of chapter 99.\n\nParagraph two.",
+			"Paragraph one of chapter 100.\n\nParagraph two.",
+			"Paragraph one of chapter 101.\n\nParagraph two.",
+		}, chapters)
+	})
+
+	t.Run("#EPE_03->Invalid exports", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, path+"?from=5&to=2", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CHAPTER_RANGE)
+
+		w = doRequest(router, http.MethodGet, path+"?from=500", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.NO_CHAPTERS)
+
+		w = doRequest(router, http.MethodGet, path+"?to=abc", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = doRequest(router, http.MethodGet, "/novels/9999/export.epub", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupEPUBImport cleans the database and returns a router serving the EPUB import endpoint, saving the covers in
+// coverDir.
+func setupEPUBImport(t *testing.T, coverDir string) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	epubImportService := services.NewEPUBImportService(repositories.NewNovelRepository(db), repositories.NewChapterRepository(db), coverDir, "http://localhost/covers")
+	epubImportController := controllers.NewEPUBImportController(epubImportService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/novels/epub", epubImportController.ImportNovelFromEPUB)
+
+	return router
+}
+
+// uploadEPUB sends a multipart request with the given file and form fields. A nil file sends the fields only.
+func uploadEPUB(router *gin.Engine, file []byte, fields map[string]string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if file != nil {
+		part, _ := writer.CreateFormFile("file", "book.epub")
+		part.Write(file)
+	}
+	for name, value := range fields {
+		writer.WriteField(name, value)
+	}
+	writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, "/novels/epub", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestImportNovelFromEPUB(t *testing.T) {
+	coverDir := t.TempDir()
+	router := setupEPUBImport(t, coverDir)
+	epub := mocks.NewMockEPUB(mocks.MockEPUB3())
+
+	t.Run("#EPI_01->EPUB creates the novel and its chapters", func(t *testing.T) {
+		w := uploadEPUB(router, epub, nil)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var report dtos.EPUBImportReport
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.Equal(t, 2, report.Imported)
+		assert.Equal(t, 2, report.Skipped)
+		assert.Equal(t, 1, report.Failed)
+		assert.Len(t, report.Chapters, 5)
+		assert.Equal(t, "the-wandering-inn", report.Novel.NovelUpdatesID)
+		assert.Equal(t, "http://localhost/covers/the-wandering-inn.jpg", report.Novel.CoverUrl)
+		assert.Equal(t, 2, report.Novel.LatestChapter)
+
+		cover, err := os.ReadFile(filepath.Join(coverDir, "the-wandering-inn.jpg"))
+		assert.NoError(t, err)
+		assert.Equal(t, "cover image", string(cover))
+
+		var chapters []models.Chapter
+		db.Order("chapter_no").Find(&chapters)
+		assert.Len(t, chapters, 2)
+		assert.Equal(t, "Prologue", chapters[0].Title)
+		assert.Equal(t, "1.00 The Inn", chapters[1].Title)
+		for _, chapter := range chapters {
+			assert.Equal(t, services.EPUBSource, chapter.Source)
+			assert.Equal(t, report.Novel.ID, *chapter.NovelID)
+		}
+	})
+
+	t.Run("#EPI_02->Uploading the same EPUB again keeps the stored chapters", func(t *testing.T) {
+		w := uploadEPUB(router, epub, nil)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var report dtos.EPUBImportReport
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.Equal(t, 0, report.Imported)
+		assert.Equal(t, 4, report.Skipped)
+		assert.Equal(t, errors.CHAPTER_CONFLICT, report.Chapters[2].ErrorCode)
+		assert.Equal(t, errors.CHAPTER_CONFLICT, report.Chapters[3].ErrorCode)
+
+		var count int64
+		db.Model(&models.Novel{}).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("#EPI_03->Given ID is used instead of the title", func(t *testing.T) {
+		router := setupEPUBImport(t, coverDir)
+		w := uploadEPUB(router, epub, map[string]string{"novel_updates_id": "wandering-inn"})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var report dtos.EPUBImportReport
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.Equal(t, "wandering-inn", report.Novel.NovelUpdatesID)
+		assert.Equal(t, 2, report.Imported)
+	})
+
+	t.Run("#EPI_04->Invalid uploads", func(t *testing.T) {
+		w := uploadEPUB(router, nil, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.EPUB_REQUIRED)
+
+		w = uploadEPUB(router, []byte("not a zip"), nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_EPUB)
+
+		w = uploadEPUB(router, epub, map[string]string{"novel_updates_id": "Invalid ID!"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("#EPI_05->Novels imported from their source are not replaced", func(t *testing.T) {
+		scraped := models.Novel{Title: "Shadow Slave", NovelUpdatesID: "shadow-slave", Status: "Ongoing",
+			NovelUpdatesUrl: "https://www.novelupdates.com/series/shadow-slave/"}
+		if err := db.Create(&scraped).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+
+		w := uploadEPUB(router, epub, map[string]string{"novel_updates_id": "shadow-slave"})
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.EPUB_NOVEL_CONFLICT)
+
+		var stored models.Novel
+		assert.NoError(t, db.First(&stored, scraped.ID).Error)
+		assert.Equal(t, "Shadow Slave", stored.Title)
+		assert.Equal(t, "Ongoing", stored.Status)
+	})
+}
@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupFeeds cleans the database, creates two novels with chapters, one bookmarked by a user, and returns a router
+// serving the feeds with requests authenticated as that user.
+func setupFeeds(t *testing.T) (*gin.Engine, models.User, []models.Novel) {
+	utils.TruncateTables(t, db)
+
+	user := models.User{Username: "reader", Email: "reader@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	novels := []models.Novel{
+		{Title: "Lord of the Mysteries", NovelUpdatesID: "lord-of-the-mysteries", Authors: []models.Author{{Name: "Cuttlefish"}}},
+		{Title: "Shadow Slave", NovelUpdatesID: "shadow-slave", Authors: []models.Author{{Name: "Guiltythree"}}},
+	}
+	for i := range novels {
+		if err := db.Create(&novels[i]).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+		for chapterNo := 1; chapterNo <= 15; chapterNo++ {
+			chapter := models.Chapter{
+				ChapterNo:  uint(chapterNo),
+				NovelID:    &novels[i].ID,
+				Title:      fmt.Sprintf("Chapter %d", chapterNo),
+				ChapterUrl: fmt.Sprintf("https://example.com/%s/%d", novels[i].NovelUpdatesID, chapterNo),
+				Body:       fmt.Sprintf("The opening of chapter %d.\nThe rest.", chapterNo),
+			}
+			chapter.CreatedAt = published.Add(time.Duration(chapterNo*10+i) * time.Hour)
+			if err := db.Create(&chapter).Error; err != nil {
+				t.Fatalf("Failed to create chapter: %v", err)
+			}
+		}
+	}
+
+	bookmark := models.BookmarkedNovel{NovelID: int(novels[0].ID), UserID: int(user.ID), Status: "reading"}
+	if err := db.Create(&bookmark).Error; err != nil {
+		t.Fatalf("Failed to create bookmark: %v", err)
+	}
+
+	feedService := services.NewFeedService(repositories.NewNovelRepository(db), repositories.NewChapterRepository(db), repositories.NewBookmarkRepository(db), repositories.NewFeedTokenRepository(db), "http://localhost", "http://frontend")
+	feedController := controllers.NewFeedController(feedService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authenticated := func(c *gin.Context) {
+		c.Set("user", &user)
+		c.Next()
+	}
+	router.GET("/novels/:novel_id/feed.atom", feedController.GetNovelFeed)
+	router.GET("/novels/bookmarked/feed.atom", feedController.GetLibraryFeed)
+	router.GET("/user/feed-token", authenticated, feedController.GetFeedToken)
+	router.POST("/user/feed-token", authenticated, feedController.RegenerateFeedToken)
+	router.GET("/anonymous/feed-token", feedController.GetFeedToken)
+
+	return router, user, novels
+}
+
+// getAtomFeed requests an Atom feed and decodes it.
+func getAtomFeed(t *testing.T, router *gin.Engine, path string) dtos.AtomFeed {
+	w := doRequest(router, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), dtos.AtomFeedType)
+
+	var feed dtos.AtomFeed
+	assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), &feed))
+	return feed
+}
+
+// getFeedToken requests a feed token and decodes it.
+func getFeedToken(t *testing.T, router *gin.Engine, method string) dtos.FeedTokenResponse {
+	w := doRequest(router, method, "/user/feed-token", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dtos.FeedTokenResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+func TestAtomFeeds(t *testing.T) {
+	router, _, novels := setupFeeds(t)
+
+	t.Run("#FEED_01->Novel feed lists the latest chapters first", func(t *testing.T) {
+		feed := getAtomFeed(t, router, fmt.Sprintf("/novels/%d/feed.atom", novels[0].ID))
+		assert.Equal(t, "Lord of the Mysteries: New Chapters", feed.Title)
+		assert.Equal(t, "2024-01-07T06:00:00Z", feed.Updated)
+		assert.Len(t, feed.Entries, 10)
+
+		entry := feed.Entries[0]
+		assert.Equal(t, "Chapter 15", entry.Title)
+		assert.Equal(t, "urn:peaknovelgo:novel:lord-of-the-mysteries:chapter:15", entry.ID)
+		assert.Equal(t, "http://frontend/novels/lord-of-the-mysteries/15", entry.Links[0].Href)
+		assert.Equal(t, "Cuttlefish", entry.Authors[0].Name)
+		assert.Equal(t, "The opening of chapter 15.", entry.Summary.Text)
+		assert.Equal(t, "Chapter 6", feed.Entries[9].Title)
+
+		feed = getAtomFeed(t, router, fmt.Sprintf("/novels/%d/feed.atom?limit=20", novels[0].ID))
+		assert.Len(t, feed.Entries, 15)
+	})
+
+	t.Run("#FEED_02->Library feed lists the chapters of the bookmarked novels", func(t *testing.T) {
+		token := getFeedToken(t, router, http.MethodGet)
+		assert.NotEmpty(t, token.Token)
+		assert.Equal(t, "http://localhost/novels/bookmarked/feed.atom?token="+url.QueryEscape(token.Token), token.FeedURL)
+		assert.Equal(t, token, getFeedToken(t, router, http.MethodGet))
+
+		feed := getAtomFeed(t, router, "/novels/bookmarked/feed.atom?limit=20&token="+url.QueryEscape(token.Token))
+		assert.Len(t, feed.Entries, 15)
+		assert.Equal(t, "Lord of the Mysteries: Chapter 15", feed.Entries[0].Title)
+		for _, entry := range feed.Entries {
+			assert.Contains(t, entry.ID, "lord-of-the-mysteries")
+		}
+	})
+
+	t.Run("#FEED_03->Regenerating the token revokes the previous one", func(t *testing.T) {
+		previous := getFeedToken(t, router, http.MethodGet)
+		regenerated := getFeedToken(t, router, http.MethodPost)
+		assert.NotEqual(t, previous.Token, regenerated.Token)
+
+		w := doRequest(router, http.MethodGet, "/novels/bookmarked/feed.atom?token="+url.QueryEscape(previous.Token), "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_FEED_TOKEN)
+
+		getAtomFeed(t, router, "/novels/bookmarked/feed.atom?token="+url.QueryEscape(regenerated.Token))
+	})
+
+	t.Run("#FEED_04->Invalid requests", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/bookmarked/feed.atom", "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_FEED_TOKEN)
+
+		w = doRequest(router, http.MethodGet, "/anonymous/feed-token", "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), errors.UNAUTHENTICATED)
+
+		w = doRequest(router, http.MethodGet, "/novels/999999/feed.atom", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = doRequest(router, http.MethodGet, fmt.Sprintf("/novels/%d/feed.atom?limit=1000", novels[0].ID), "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
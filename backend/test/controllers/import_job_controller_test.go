@@ -0,0 +1,361 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/scrapers"
+	"backend/internal/services"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupImportJobs cleans the database, creates a novel with 3 chapters and returns a router serving the import job
+// endpoints backed by the given source.
+func setupImportJobs(t *testing.T, source *mocks.MockSource) (*gin.Engine, *services.ImportJobService) {
+	utils.TruncateTables(t, db)
+
+	novel := models.Novel{
+		Title:          "Reverend Insanity",
+		Synopsis:       "Test",
+		CoverUrl:       "https://example.com/cover.jpg",
+		Language:       "en",
+		Status:         "Completed",
+		NovelUpdatesID: "reverend-insanity",
+		LatestChapter:  3,
+	}
+	if err := db.Create(&novel).Error; err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	chapterService := services.NewChapterService(repositories.NewChapterRepository(db), repositories.NewNovelRepository(db), source)
+	throttle := scrapers.NewThrottle(&mocks.MockFetcher{}, nil)
+	throttle.Fetcher("mock")
+	importJobService := services.NewImportJobService(repositories.NewImportJobRepository(db), repositories.NewNovelRepository(db), chapterService, throttle)
+	t.Cleanup(importJobService.Close)
+
+	importJobController := controllers.NewImportJobController(importJobService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/chapters/:novel_id/scrape", importJobController.HandleImportChapters)
+	router.GET("/novels/chapters/:novel_id/missing", importJobController.GetMissingChapters)
+	router.POST("/novels/chapters/:novel_id/import-jobs", importJobController.StartImportJob)
+	router.GET("/novels/import-jobs/", importJobController.GetImportJobs)
+	router.GET("/novels/import-jobs/:job_id", importJobController.GetImportJob)
+	router.GET("/novels/import-jobs/:job_id/events", importJobController.StreamImportJob)
+	router.POST("/novels/import-jobs/:job_id/cancel", importJobController.CancelImportJob)
+	router.POST("/novels/import-jobs/:job_id/resume", importJobController.ResumeImportJob)
+
+	return router, importJobService
+}
+
+func chapterResult(chapterNo int) *models.ImportedChapterMetadata {
+	return &models.ImportedChapterMetadata{
+		ID:         uint(chapterNo),
+		Title:      fmt.Sprintf("Chapter %d", chapterNo),
+		Body:       "Body",
+		ChapterUrl: fmt.Sprintf("https://example.com/reverend-insanity/%d", chapterNo),
+	}
+}
+
+func doRequest(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// waitForJob polls a job until it is in the expected state.
+func waitForJob(t *testing.T, router *gin.Engine, id uint, state string) models.ImportJob {
+	var job models.ImportJob
+	deadline := time.Now().Add(5 * time.Second)
+
+	for time.Now().Before(deadline) {
+		w := doRequest(router, http.MethodGet, fmt.Sprintf("/novels/import-jobs/%d", id), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Decode into a new job so fields omitted from the response don't keep the values of the previous poll
+		job = models.ImportJob{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+
+		if job.State == state {
+			return job
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	t.Fatalf("Import job %d is %s, expected %s", id, job.State, state)
+	return job
+}
+
+func TestImportJob_StartAndResume(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchChapter", "reverend-insanity", 1).Return(chapterResult(1), nil)
+	source.On("FetchChapter", "reverend-insanity", 2).Return(nil, errors.ErrChapterNotFound).Once()
+	source.On("FetchChapter", "reverend-insanity", 2).Return(chapterResult(2), nil)
+	source.On("FetchChapter", "reverend-insanity", 3).Return(chapterResult(3), nil)
+
+	router, _ := setupImportJobs(t, source)
+
+	var created models.ImportJob
+
+	t.Run("#IJ_01->Job imports the range in the background", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", `{"from": 1, "to": 3}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.Len(t, created.Chapters, 3)
+
+		job := waitForJob(t, router, created.ID, models.ImportJobCompleted)
+
+		assert.Equal(t, models.ChapterDownloaded, job.Chapters[0].Status)
+		assert.Equal(t, models.ChapterError, job.Chapters[1].Status)
+		assert.Equal(t, errors.ErrChapterNotFound.Error(), job.Chapters[1].Message)
+		assert.Equal(t, errors.CHAPTER_NOT_FOUND, job.Chapters[1].ErrorCode)
+		assert.False(t, job.Chapters[1].Retryable)
+		assert.Equal(t, 1, job.Chapters[1].Attempts)
+		assert.Equal(t, models.ChapterDownloaded, job.Chapters[2].Status)
+
+		assert.Len(t, job.Sources, 1)
+		assert.Equal(t, "mock", job.Sources[0].Name)
+		assert.Equal(t, models.CircuitClosed, job.Sources[0].State)
+	})
+
+	t.Run("#IJ_02->Resumed job only retries the chapters that are not done", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, fmt.Sprintf("/novels/import-jobs/%d/resume", created.ID), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		job := waitForJob(t, router, created.ID, models.ImportJobCompleted)
+
+		for _, chapter := range job.Chapters {
+			assert.Equal(t, models.ChapterDownloaded, chapter.Status)
+		}
+		assert.Equal(t, 1, job.Chapters[0].Attempts)
+		assert.Equal(t, 2, job.Chapters[1].Attempts)
+
+		var count int64
+		db.Model(&models.Chapter{}).Count(&count)
+		assert.Equal(t, int64(3), count)
+		source.AssertNumberOfCalls(t, "FetchChapter", 4)
+	})
+
+	t.Run("#IJ_03->Jobs are listed", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/import-jobs/", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"total":1`)
+	})
+}
+
+func TestImportJob_Cancel(t *testing.T) {
+	release := make(chan struct{})
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchChapter", "reverend-insanity", mock.AnythingOfType("int")).
+		Run(func(mock.Arguments) { <-release }).
+		Return(nil, errors.ErrChapterNotFound)
+
+	router, _ := setupImportJobs(t, source)
+
+	w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", "")
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created models.ImportJob
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	jobPath := fmt.Sprintf("/novels/import-jobs/%d", created.ID)
+
+	t.Run("#IJ_04->Only one active job per novel", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", "")
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.IMPORT_JOB_ALREADY_RUNNING)
+	})
+
+	t.Run("#IJ_05->Running job can't be resumed", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, jobPath+"/resume", "")
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("#IJ_06->Running job is cancelled", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, jobPath+"/cancel", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		close(release)
+
+		job := waitForJob(t, router, created.ID, models.ImportJobCancelled)
+		assert.NotNil(t, job.FinishedAt)
+	})
+
+	t.Run("#IJ_07->Finished job can't be cancelled", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, jobPath+"/cancel", "")
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestImportJob_Delta(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	for chapterNo := 1; chapterNo <= 6; chapterNo++ {
+		source.On("FetchChapter", "reverend-insanity", chapterNo).Return(chapterResult(chapterNo), nil)
+	}
+
+	router, _ := setupImportJobs(t, source)
+
+	var novel models.Novel
+	db.First(&novel)
+	db.Model(&novel).Update("latest_chapter", 6)
+	for _, chapterNo := range []uint{1, 3, 4} {
+		db.Create(&models.Chapter{ChapterNo: chapterNo, NovelID: &novel.ID, Title: fmt.Sprintf("Chapter %d", chapterNo), Body: "Body"})
+	}
+
+	t.Run("#IJ_15->Missing chapters are split into gaps and new chapters", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/chapters/reverend-insanity/missing", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"latestStoredChapter":4,"gaps":[{"from":2,"to":2}],"newChapters":{"from":5,"to":6},"gapCount":1,"newCount":2}`, w.Body.String())
+	})
+
+	t.Run("#IJ_16->Delta job only imports the missing chapters", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", `{"delta": true}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var created models.ImportJob
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.True(t, created.Delta)
+
+		job := waitForJob(t, router, created.ID, models.ImportJobCompleted)
+
+		kinds := map[int]string{}
+		for _, chapter := range job.Chapters {
+			assert.Equal(t, models.ChapterDownloaded, chapter.Status)
+			kinds[chapter.ChapterNo] = chapter.Kind
+		}
+		assert.Equal(t, map[int]string{2: models.ChapterKindGap, 5: models.ChapterKindNew, 6: models.ChapterKindNew}, kinds)
+		source.AssertNumberOfCalls(t, "FetchChapter", 3)
+	})
+
+	t.Run("#IJ_17->Delta job without missing chapters is rejected", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", `{"delta": true}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.NO_MISSING_CHAPTERS)
+	})
+
+	t.Run("#IJ_18->Full job skips the stored chapters", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", "")
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var created models.ImportJob
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+		job := waitForJob(t, router, created.ID, models.ImportJobCompleted)
+		for _, chapter := range job.Chapters {
+			assert.Equal(t, models.ChapterSkipped, chapter.Status)
+		}
+		source.AssertNumberOfCalls(t, "FetchChapter", 3)
+	})
+}
+
+func TestImportJob_InvalidRequests(t *testing.T) {
+	router, _ := setupImportJobs(t, &mocks.MockSource{SourceName: "mock"})
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode int
+	}{
+		{"#IJ_08->Reversed range", http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", `{"from": 3, "to": 1}`, http.StatusBadRequest},
+		{"#IJ_09->Malformed range", http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", `{"from": "a"}`, http.StatusBadRequest},
+		{"#IJ_10->Unknown novel", http.MethodPost, "/novels/chapters/unknown/import-jobs", "", http.StatusNotFound},
+		{"#IJ_11->Unknown job", http.MethodGet, "/novels/import-jobs/42", "", http.StatusNotFound},
+		{"#IJ_12->Invalid job ID", http.MethodPost, "/novels/import-jobs/abc/cancel", "", http.StatusBadRequest},
+		{"#IJ_19->Invalid missing chapters range", http.MethodGet, "/novels/chapters/reverend-insanity/missing?from=a", "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestImportJob_Stream(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	for chapterNo := 1; chapterNo <= 3; chapterNo++ {
+		source.On("FetchChapter", "reverend-insanity", chapterNo).Return(chapterResult(chapterNo), nil)
+	}
+
+	router, _ := setupImportJobs(t, source)
+
+	t.Run("#IJ_13->Scrape endpoint starts a job and streams it until it completes", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/chapters/reverend-insanity/scrape", "")
+
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "event: status")
+		assert.Contains(t, w.Body.String(), `{"1":"downloaded","2":"downloaded","3":"downloaded"}`)
+		assert.Contains(t, w.Body.String(), "event: complete\ndata: All 3 chapters processed")
+	})
+
+	t.Run("#IJ_14->Scrape endpoint reports unknown novels", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/chapters/unknown/scrape", "")
+
+		assert.Contains(t, w.Body.String(), "event: error\ndata: Novel not found")
+	})
+}
+
+func TestImportJob_ErrorClasses(t *testing.T) {
+	timeout := types.WrapError(errors.SOURCE_TIMEOUT, "Timed out", http.StatusGatewayTimeout, nil)
+	down := types.WrapError(errors.SOURCE_DOWN, "Source responded with status 503", http.StatusServiceUnavailable, nil)
+	blocked := types.WrapError(errors.SOURCE_BLOCKED, "Source blocked the request", http.StatusBadGateway, nil)
+
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchChapter", "reverend-insanity", 1).Return(nil, timeout).Once()
+	source.On("FetchChapter", "reverend-insanity", 1).Return(chapterResult(1), nil)
+	source.On("FetchChapter", "reverend-insanity", 2).Return(nil, down)
+	source.On("FetchChapter", "reverend-insanity", 3).Return(nil, blocked)
+
+	router, _ := setupImportJobs(t, source)
+
+	w := doRequest(router, http.MethodPost, "/novels/chapters/reverend-insanity/import-jobs", "")
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created models.ImportJob
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	t.Run("#IJ_20->Only transient errors are retried", func(t *testing.T) {
+		job := waitForJob(t, router, created.ID, models.ImportJobCompleted)
+
+		assert.Equal(t, models.ChapterDownloaded, job.Chapters[0].Status)
+		assert.Equal(t, 2, job.Chapters[0].Attempts)
+		assert.Empty(t, job.Chapters[0].ErrorCode)
+
+		assert.Equal(t, models.ChapterError, job.Chapters[1].Status)
+		assert.Equal(t, 3, job.Chapters[1].Attempts)
+		assert.Equal(t, errors.SOURCE_DOWN, job.Chapters[1].ErrorCode)
+		assert.True(t, job.Chapters[1].Retryable)
+
+		assert.Equal(t, models.ChapterError, job.Chapters[2].Status)
+		assert.Equal(t, 1, job.Chapters[2].Attempts)
+		assert.Equal(t, errors.SOURCE_BLOCKED, job.Chapters[2].ErrorCode)
+		assert.False(t, job.Chapters[2].Retryable)
+	})
+
+	t.Run("#IJ_21->Stream reports the error class of failed chapters", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, fmt.Sprintf("/novels/import-jobs/%d/events", created.ID), "")
+
+		assert.Contains(t, w.Body.String(), `event: chapter`)
+		assert.Contains(t, w.Body.String(), `"chapterNo":2,"status":"error","message":"Source responded with status 503","errorCode":"SOURCE_DOWN","retryable":true`)
+		assert.Contains(t, w.Body.String(), `"chapterNo":3,"status":"error","message":"Source blocked the request","errorCode":"SOURCE_BLOCKED","retryable":false`)
+	})
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupLibrary cleans the database, creates a user who bookmarked three novels and unbookmarked a fourth, and returns a
+// router serving the library with requests authenticated as that user.
+func setupLibrary(t *testing.T) (*gin.Engine, models.User, []models.Novel) {
+	utils.TruncateTables(t, db)
+
+	user := models.User{Username: "librarian", Email: "librarian@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	novels := []models.Novel{
+		{Title: "Overgeared", NovelUpdatesID: "overgeared", LatestChapter: 10},
+		{Title: "Solo Leveling", NovelUpdatesID: "solo-leveling", LatestChapter: 20},
+		{Title: "Omniscient Reader", NovelUpdatesID: "omniscient-reader", LatestChapter: 5},
+		{Title: "Second Life Ranker", NovelUpdatesID: "second-life-ranker", LatestChapter: 8},
+	}
+	for i := range novels {
+		if err := db.Create(&novels[i]).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	bookmarks := []models.BookmarkedNovel{
+		{NovelID: int(novels[0].ID), UserID: int(user.ID), Status: "reading", Score: 4, CurrentChapter: 3},
+		{NovelID: int(novels[1].ID), UserID: int(user.ID), Status: "on-hold", CurrentChapter: 20},
+		{NovelID: int(novels[2].ID), UserID: int(user.ID), Status: "reading", CurrentChapter: 1},
+		{NovelID: int(novels[3].ID), UserID: int(user.ID), Status: "reading", CurrentChapter: 2},
+	}
+	for i := range bookmarks {
+		if err := db.Create(&bookmarks[i]).Error; err != nil {
+			t.Fatalf("Failed to create bookmark: %v", err)
+		}
+	}
+	db.Delete(&bookmarks[3])
+
+	bookmarkController := controllers.NewBookmarkController(services.NewBookmarkService(repositories.NewBookmarkRepository(db), repositories.NewNovelRepository(db)))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authenticated := func(c *gin.Context) {
+		c.Set("user", &user)
+		c.Next()
+	}
+	router.GET("/novels/bookmarked/:user_id", authenticated, bookmarkController.GetBookmarkedNovelsByUserID)
+	router.GET("/user/continue-reading", authenticated, bookmarkController.GetContinueReading)
+
+	return router, user, novels
+}
+
+// getContinueReading requests the continue reading list and decodes it.
+func getContinueReading(t *testing.T, router *gin.Engine, query string) []models.LibraryEntry {
+	w := doRequest(router, http.MethodGet, "/user/continue-reading"+query, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var entries []models.LibraryEntry
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+	return entries
+}
+
+func TestLibrary(t *testing.T) {
+	router, user, novels := setupLibrary(t)
+	t.Cleanup(cleanDB)
+
+	t.Run("#LIB_01->Library lists the bookmarks with their novels and unread chapters", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, fmt.Sprintf("/novels/bookmarked/%d", user.ID), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Data  []models.LibraryEntry `json:"data"`
+			Total int64                 `json:"total"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int64(3), response.Total)
+		if assert.Len(t, response.Data, 3) {
+			assert.Equal(t, "Overgeared", response.Data[0].Novel.Title)
+			assert.Equal(t, "reading", response.Data[0].Status)
+			assert.Equal(t, 4, response.Data[0].Score)
+			assert.Equal(t, 3, response.Data[0].CurrentChapter)
+			assert.Equal(t, 7, response.Data[0].UnreadChapters)
+			assert.Equal(t, 0, response.Data[1].UnreadChapters)
+			assert.Equal(t, 4, response.Data[2].UnreadChapters)
+			assert.Nil(t, response.Data[0].LastReadAt)
+		}
+	})
+
+	t.Run("#LIB_02->Continue reading lists the most recent activity first", func(t *testing.T) {
+		readAt := time.Now().Add(time.Minute).UTC().Truncate(time.Microsecond)
+		db.Create(&models.ReadingPosition{UserID: user.ID, NovelID: novels[2].ID, ChapterNo: 1, DeviceID: "phone", ReadAt: readAt})
+
+		entries := getContinueReading(t, router, "")
+		if assert.Len(t, entries, 2) {
+			assert.Equal(t, "Omniscient Reader", entries[0].Novel.Title)
+			assert.True(t, readAt.Equal(entries[0].LastActivityAt))
+			assert.Equal(t, "Overgeared", entries[1].Novel.Title)
+			assert.Equal(t, 7, entries[1].UnreadChapters)
+		}
+
+		db.Model(&models.BookmarkedNovel{}).Where("novel_id = ?", novels[1].ID).Update("current_chapter", 15)
+		entries = getContinueReading(t, router, "?status=on-hold&status=reading")
+		if assert.Len(t, entries, 3) {
+			assert.Equal(t, "Omniscient Reader", entries[0].Novel.Title)
+			assert.Equal(t, "Solo Leveling", entries[1].Novel.Title)
+			assert.Equal(t, 5, entries[1].UnreadChapters)
+		}
+
+		assert.Len(t, getContinueReading(t, router, "?status=on-hold&limit=10"), 1)
+		assert.Empty(t, getContinueReading(t, router, "?status=dropped"))
+
+		w := doRequest(router, http.MethodGet, "/user/continue-reading?limit=1000", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupLibraryTransfer cleans the database, creates a user who bookmarked the first of three novels, and returns a
+// router exporting and importing the library of that user. The user is returned as a pointer so tests can change the
+// roles of the authenticated user.
+func setupLibraryTransfer(t *testing.T, source *mocks.MockSource) (*gin.Engine, *models.User, []models.Novel) {
+	cleanDB()
+	utils.TruncateTables(t, db)
+
+	user := models.User{Username: "librarian", Email: "librarian@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	novels := []models.Novel{
+		{Title: "Lord of the Mysteries", NovelUpdatesID: "lord-of-the-mysteries", Status: "Completed", LatestChapter: 20},
+		{Title: "Mother of Learning", NovelUpdatesID: "mother-of-learning", Status: "Ongoing", LatestChapter: 30},
+		{Title: "Shadow Slave", NovelUpdatesID: "shadow-slave", Status: "Ongoing", LatestChapter: 40},
+	}
+	for i := range novels {
+		if err := db.Create(&novels[i]).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	bookmark := models.BookmarkedNovel{NovelID: int(novels[0].ID), UserID: int(user.ID), Status: "reading", Score: 4, CurrentChapter: 7}
+	if err := db.Create(&bookmark).Error; err != nil {
+		t.Fatalf("Failed to create bookmark: %v", err)
+	}
+
+	novelRepo := repositories.NewNovelRepository(db)
+	libraryController := controllers.NewLibraryController(
+		services.NewLibraryService(repositories.NewBookmarkRepository(db), novelRepo, services.NewNovelService(novelRepo, source)),
+	)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authenticated := func(c *gin.Context) {
+		c.Set("user", &user)
+		c.Next()
+	}
+	router.GET("/user/library/export", authenticated, libraryController.ExportLibrary)
+	router.POST("/user/library/import", authenticated, libraryController.ImportLibrary)
+
+	return router, &user, novels
+}
+
+// importLibrary imports a library and decodes the report.
+func importLibrary(t *testing.T, router *gin.Engine, query, body string) dtos.LibraryImportReport {
+	w := doRequest(router, http.MethodPost, "/user/library/import"+query, body)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var report dtos.LibraryImportReport
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	return report
+}
+
+func TestLibraryTransfer(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchNovelMetadata", "the-beginning-after-the-end").Return(importedNovel("The Beginning After the End", 12), nil)
+
+	router, user, novels := setupLibraryTransfer(t, source)
+	t.Cleanup(cleanDB)
+
+	t.Run("#LT_01->The library is exported as JSON and CSV", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/user/library/export", "")
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, `attachment; filename="library.json"`, w.Header().Get("Content-Disposition"))
+
+		var rows []dtos.LibraryRow
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &rows))
+		assert.Equal(t, []dtos.LibraryRow{{
+			NovelID:        novels[0].ID,
+			NovelUpdatesID: "lord-of-the-mysteries",
+			Title:          "Lord of the Mysteries",
+			Status:         "reading",
+			Score:          4,
+			CurrentChapter: 7,
+		}}, rows)
+
+		w = doRequest(router, http.MethodGet, "/user/library/export?format=csv", "")
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, fmt.Sprintf("novel_id,novel_updates_id,title,status,score,current_chapter\n"+
+			"%d,lord-of-the-mysteries,Lord of the Mysteries,reading,4,7\n", novels[0].ID), w.Body.String())
+
+		w = doRequest(router, http.MethodGet, "/user/library/export?format=xml", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_LIBRARY_FORMAT)
+	})
+
+	t.Run("#LT_02->A CSV from another tracker is matched by URL or title and reported per row", func(t *testing.T) {
+		report := importLibrary(t, router, "?format=csv", "\uFEFFName,URL,Status,Rating,Chapters Read,Notes\n"+
+			"Lord of the Mysteries,https://www.novelupdates.com/series/lord-of-the-mysteries/,Completed,5,20,finally\n"+
+			"mother of learning,,Plan to Read,,0,\n"+
+			"Unknown Novel,,Reading,3,2,\n"+
+			"Shadow Slave,,Binging,3,2,\n")
+
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 1, report.Updated)
+		assert.Equal(t, 2, report.Failed)
+		if assert.Len(t, report.Rows, 4) {
+			assert.Equal(t, dtos.LibraryImportUpdated, report.Rows[0].Result)
+			assert.Equal(t, novels[0].ID, report.Rows[0].NovelID)
+			assert.Equal(t, dtos.LibraryImportCreated, report.Rows[1].Result)
+			assert.Equal(t, novels[1].ID, report.Rows[1].NovelID)
+			assert.Equal(t, dtos.LibraryImportFailed, report.Rows[2].Result)
+			assert.Equal(t, errors.NOVEL_NOT_FOUND, report.Rows[2].Code)
+			assert.Equal(t, 3, report.Rows[2].Row)
+			assert.Equal(t, dtos.LibraryImportFailed, report.Rows[3].Result)
+			assert.Equal(t, errors.INVALID_BOOKMARK_STATUS, report.Rows[3].Code)
+		}
+
+		var bookmark models.BookmarkedNovel
+		assert.NoError(t, db.Where("user_id = ? AND novel_id = ?", user.ID, novels[0].ID).First(&bookmark).Error)
+		assert.Equal(t, "completed", bookmark.Status)
+		assert.Equal(t, 5, bookmark.Score)
+		assert.Equal(t, 20, bookmark.CurrentChapter)
+		assert.Equal(t, "plan-to-read", bookmarkStatus(t, user.ID, novels[1].ID))
+	})
+
+	t.Run("#LT_03->Unknown novels are imported from NovelUpdates when asked to", func(t *testing.T) {
+		body := `[{"novelUpdatesId":"the-beginning-after-the-end","title":"The Beginning After the End","currentChapter":3}]`
+
+		report := importLibrary(t, router, "", body)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, errors.NOVEL_NOT_FOUND, report.Rows[0].Code)
+
+		w := doRequest(router, http.MethodPost, "/user/library/import?create=true", body)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), errors.LIBRARY_CREATE_FORBIDDEN)
+		source.AssertNotCalled(t, "FetchNovelMetadata", "the-beginning-after-the-end")
+
+		user.Roles = "admin"
+		t.Cleanup(func() { user.Roles = "" })
+		report = importLibrary(t, router, "?create=true", body)
+		assert.Equal(t, 1, report.Created)
+		assert.True(t, report.Rows[0].NovelCreated)
+		assert.NotZero(t, report.Rows[0].NovelID)
+		assert.Equal(t, "reading", bookmarkStatus(t, user.ID, report.Rows[0].NovelID))
+
+		report = importLibrary(t, router, "?create=true", body)
+		assert.Equal(t, 1, report.Updated)
+		assert.False(t, report.Rows[0].NovelCreated)
+		source.AssertNumberOfCalls(t, "FetchNovelMetadata", 1)
+	})
+
+	t.Run("#LT_04->At most 20 unknown novels are imported from NovelUpdates at once", func(t *testing.T) {
+		user.Roles = "admin"
+		t.Cleanup(func() { user.Roles = "" })
+
+		var rows []dtos.LibraryRow
+		for i := 1; i <= 21; i++ {
+			novelUpdatesID := fmt.Sprintf("unknown-novel-%d", i)
+			source.On("FetchNovelMetadata", novelUpdatesID).Return(importedNovel(fmt.Sprintf("Unknown Novel %d", i), 1), nil)
+			rows = append(rows, dtos.LibraryRow{NovelUpdatesID: novelUpdatesID})
+		}
+		body, err := json.Marshal(rows)
+		assert.NoError(t, err)
+
+		report := importLibrary(t, router, "?create=true", string(body))
+		assert.Equal(t, 20, report.Created)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, errors.LIBRARY_CREATE_LIMIT, report.Rows[20].Code)
+		source.AssertNotCalled(t, "FetchNovelMetadata", "unknown-novel-21")
+	})
+
+	t.Run("#LT_05->Invalid libraries are rejected", func(t *testing.T) {
+		for _, library := range []struct{ format, body string }{
+			{"csv", "status,score\nreading,3\n"},
+			{"json", `{"title":"Shadow Slave"}`},
+			{"json", "[]"},
+		} {
+			w := doRequest(router, http.MethodPost, "/user/library/import?format="+library.format, library.body)
+			assert.Equal(t, http.StatusBadRequest, w.Code, library.body)
+			assert.Contains(t, w.Body.String(), errors.INVALID_LIBRARY_FILE, library.body)
+		}
+
+		w := doRequest(router, http.MethodPost, "/user/library/import?format=csv", "title,score\nShadow Slave,three\n")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "line 2")
+	})
+}
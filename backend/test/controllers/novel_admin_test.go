@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/scrapers"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// sourceNovel is the novel as its source describes it, every time it's imported.
+func sourceNovel() models.Novel {
+	return models.Novel{
+		Title:          "Lord of the Mysteries",
+		Synopsis:       "Klein wakes up in a new world.",
+		CoverUrl:       "https://example.com/lotm.jpg",
+		Language:       "Chinese",
+		Status:         "Ongoing",
+		NovelUpdatesID: "lord-of-the-mysteries",
+		Year:           "2018",
+		LatestChapter:  1394,
+		Tags:           []models.Tag{{Name: "Mystery"}},
+		Genres:         []models.Genre{{Name: "Fantasy"}},
+		Authors:        []models.Author{{Name: "Cuttlefish"}},
+	}
+}
+
+// setupNovelAdmin cleans the database, imports a novel and returns a router serving the edition of the novels, the
+// repository the novels are imported with and the ID of the novel.
+func setupNovelAdmin(t *testing.T) (*gin.Engine, *repositories.NovelRepository, uint) {
+	utils.TruncateTables(t, db)
+
+	novelRepository := repositories.NewNovelRepository(db)
+	novel, err := novelRepository.CreateNovel(sourceNovel())
+	if err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+	other := models.Novel{Title: "Shadow Slave", NovelUpdatesID: "shadow-slave", Status: "Ongoing"}
+	if _, err := novelRepository.CreateNovel(other); err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	novelController := controllers.NewNovelController(services.NewNovelService(novelRepository, scrapers.NewPythonSource(scriptExecutor)))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/:novel_id", novelController.GetNovelByID)
+	router.PUT("/novels/:novel_id", novelController.UpdateNovel)
+	router.PATCH("/novels/:novel_id", novelController.PatchNovel)
+	router.DELETE("/novels/:novel_id", novelController.DeleteNovel)
+
+	return router, novelRepository, novel.ID
+}
+
+// reimportNovel imports the novel again from its source and returns it as stored.
+func reimportNovel(t *testing.T, novelRepository *repositories.NovelRepository) *models.Novel {
+	if _, err := novelRepository.CreateNovel(sourceNovel()); err != nil {
+		t.Fatalf("Failed to import novel: %v", err)
+	}
+
+	novel, err := novelRepository.GetNovelByUpdatesID("lord-of-the-mysteries")
+	if err != nil {
+		t.Fatalf("Failed to get novel: %v", err)
+	}
+	return novel
+}
+
+func TestNovelAdmin(t *testing.T) {
+	router, novelRepository, id := setupNovelAdmin(t)
+	path := fmt.Sprintf("/novels/%d", id)
+
+	t.Run("#ADM_01->Edited fields are locked against the imports", func(t *testing.T) {
+		w := doRequest(router, http.MethodPatch, path, `{"title":" Lord of Mysteries ","tags":["Mystery","Steampunk"]}`)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var novel models.Novel
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &novel))
+		assert.Equal(t, "Lord of Mysteries", novel.Title)
+		assert.Equal(t, "title,tags", novel.LockedFields)
+		assert.Len(t, novel.Tags, 2)
+
+		reimported := reimportNovel(t, novelRepository)
+		assert.Equal(t, "Lord of Mysteries", reimported.Title)
+		assert.Len(t, reimported.Tags, 2)
+		assert.Equal(t, "Klein wakes up in a new world.", reimported.Synopsis)
+		assert.Equal(t, "title,tags", reimported.LockedFields)
+	})
+
+	t.Run("#ADM_02->Unlocked fields are overwritten by the imports", func(t *testing.T) {
+		w := doRequest(router, http.MethodPatch, path, `{"synopsis":"A curated synopsis.","lockedFields":["synopsis"]}`)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		reimported := reimportNovel(t, novelRepository)
+		assert.Equal(t, "Lord of the Mysteries", reimported.Title)
+		assert.Len(t, reimported.Tags, 1)
+		assert.Equal(t, "A curated synopsis.", reimported.Synopsis)
+	})
+
+	t.Run("#ADM_03->Novels are replaced", func(t *testing.T) {
+		w := doRequest(router, http.MethodPut, path, `{"title":"Lord of the Mysteries"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_NOVEL_UPDATE)
+
+		w = doRequest(router, http.MethodPut, path, `{"title":"LOTM","synopsis":"","coverUrl":"https://example.com/lotm-2.jpg","language":"Chinese","status":"Completed","year":"2018","releaseFrequency":"Daily","tags":[],"genres":["Fantasy","Mystery"],"authors":["Cuttlefish That Loves Diving"]}`)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var novel models.Novel
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &novel))
+		assert.Equal(t, models.LockableNovelFields, novel.GetLockedFields())
+		assert.Empty(t, novel.Tags)
+		assert.Len(t, novel.Genres, 2)
+
+		reimported := reimportNovel(t, novelRepository)
+		assert.Equal(t, "LOTM", reimported.Title)
+		assert.Equal(t, "", reimported.Synopsis)
+		assert.Equal(t, "Completed", reimported.Status)
+		assert.Empty(t, reimported.Tags)
+		assert.Equal(t, "Cuttlefish That Loves Diving", reimported.Authors[0].Name)
+	})
+
+	t.Run("#ADM_04->Invalid edits are rejected", func(t *testing.T) {
+		w := doRequest(router, http.MethodPatch, path, `{"lockedFields":["latestChapter"]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_LOCKED_FIELD)
+
+		w = doRequest(router, http.MethodPatch, path, `{"title":"  "}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = doRequest(router, http.MethodPatch, path, `{"tags":[""]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_TAG)
+
+		w = doRequest(router, http.MethodPatch, path, `{"title":"Shadow Slave"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = doRequest(router, http.MethodPatch, "/novels/999999", `{"title":"Missing"}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#ADM_05->Deleted novels are restored when imported again", func(t *testing.T) {
+		chapter := models.Chapter{ChapterNo: 1, NovelID: &id, Title: "Chapter 1", Body: "Klein opened his eyes."}
+		if err := db.Create(&chapter).Error; err != nil {
+			t.Fatalf("Failed to create chapter: %v", err)
+		}
+		chapterRepository := repositories.NewChapterRepository(db)
+
+		w := doRequest(router, http.MethodDelete, path, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(router, http.MethodGet, path, "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// The chapters are hidden with their novel
+		_, err := chapterRepository.GetChapterByNovelUpdatesIDAndChapterNo("lord-of-the-mysteries", 1)
+		assert.Equal(t, errors.ErrChapterNotFound, err)
+		chapters, total, err := chapterRepository.GetChaptersByNovelUpdatesID("lord-of-the-mysteries", 1, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, chapters)
+		assert.Zero(t, total)
+
+		w = doRequest(router, http.MethodDelete, path, "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		reimported := reimportNovel(t, novelRepository)
+		assert.Equal(t, id, reimported.ID)
+		assert.Equal(t, "LOTM", reimported.Title)
+
+		restored, err := chapterRepository.GetChapterByNovelUpdatesIDAndChapterNo("lord-of-the-mysteries", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, chapter.ID, restored.ID)
+	})
+}
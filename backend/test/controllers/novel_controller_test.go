@@ -3,6 +3,7 @@ package controllers
 import (
 	"backend/internal/controllers"
 	"backend/internal/models"
+	"backend/internal/scrapers"
 	"backend/internal/services"
 	"backend/internal/types/errors"
 	"backend/test/mocks"
@@ -301,7 +302,7 @@ func TestHandleImportNovel(t *testing.T) {
 				scriptExecutor = &mocks.MockScriptExecutorNetworkDown{}
 			}
 
-			novelService = services.NewNovelService(novelRepo, scriptExecutor)
+			novelService = services.NewNovelService(novelRepo, scrapers.NewPythonSource(scriptExecutor))
 
 			if !tt.isDatabaseOnline {
 				// Create a mock repository
@@ -310,7 +311,7 @@ func TestHandleImportNovel(t *testing.T) {
 				// Simulate database offline
 				mockRepo.On("IsDown").Return(true)
 
-				novelService = services.NewNovelService(mockRepo, scriptExecutor)
+				novelService = services.NewNovelService(mockRepo, scrapers.NewPythonSource(scriptExecutor))
 				mockRepo.On("CreateNovel", mock.AnythingOfType("models.Novel")).Return((*models.Novel)(nil), errors.ErrDatabaseOffline)
 			}
 
@@ -504,7 +505,7 @@ func TestHandleGetNovelByNovelUpdatesID(t *testing.T) {
 				// Simulate database offline
 				mockRepo.On("IsDown").Return(true)
 
-				novelService = services.NewNovelService(mockRepo, scriptExecutor)
+				novelService = services.NewNovelService(mockRepo, scrapers.NewPythonSource(scriptExecutor))
 				mockRepo.On("GetNovelByUpdatesID", mock.AnythingOfType("string")).Return((*models.Novel)(nil), errors.ErrDatabaseOffline)
 			}
 
@@ -811,7 +812,7 @@ func TestHandleGetNovelsByAuthor(t *testing.T) {
 				mockRepo := new(mocks.MockNovelRepository)
 				mockRepo.On("IsDown").Return(true)
 				mockRepo.On("GetNovelsByAuthorName", mock.Anything, mock.Anything, mock.Anything).Return(([]models.Novel)(nil), (int64)(0), errors.ErrDatabaseOffline)
-				novelService = services.NewNovelService(mockRepo, scriptExecutor)
+				novelService = services.NewNovelService(mockRepo, scrapers.NewPythonSource(scriptExecutor))
 			}
 
 			novelController = *controllers.NewNovelController(novelService)
@@ -1153,7 +1154,7 @@ func TestHandleGetNovelsByGenre(t *testing.T) {
 				mockRepo := new(mocks.MockNovelRepository)
 				mockRepo.On("IsDown").Return(true)
 				mockRepo.On("GetNovelsByGenreName", mock.Anything, mock.Anything, mock.Anything).Return(([]models.Novel)(nil), (int64)(0), errors.ErrDatabaseOffline)
-				novelService = services.NewNovelService(mockRepo, scriptExecutor)
+				novelService = services.NewNovelService(mockRepo, scrapers.NewPythonSource(scriptExecutor))
 			}
 
 			novelController = *controllers.NewNovelController(novelService)
@@ -1495,7 +1496,7 @@ func TestHandleGetNovelsByTag(t *testing.T) {
 				mockRepo := new(mocks.MockNovelRepository)
 				mockRepo.On("IsDown").Return(true)
 				mockRepo.On("GetNovelsByTagName", mock.Anything, mock.Anything, mock.Anything).Return(([]models.Novel)(nil), (int64)(0), errors.ErrDatabaseOffline)
-				novelService = services.NewNovelService(mockRepo, scriptExecutor)
+				novelService = services.NewNovelService(mockRepo, scrapers.NewPythonSource(scriptExecutor))
 			}
 
 			novelController = *controllers.NewNovelController(novelService)
@@ -1767,7 +1768,7 @@ func TestHandleGetNovels(t *testing.T) {
 				mockRepo := new(mocks.MockNovelRepository)
 				mockRepo.On("IsDown").Return(true)
 				mockRepo.On("GetNovels", mock.Anything, mock.Anything).Return(([]models.Novel)(nil), (int64)(0), errors.ErrDatabaseOffline)
-				novelService = services.NewNovelService(mockRepo, scriptExecutor)
+				novelService = services.NewNovelService(mockRepo, scrapers.NewPythonSource(scriptExecutor))
 			}
 
 			novelController = *controllers.NewNovelController(novelService)
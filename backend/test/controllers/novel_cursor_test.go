@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/scrapers"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupNovelCursor cleans the database, creates 25 novels, some bookmarked, and returns a router serving the listing of
+// the novels.
+func setupNovelCursor(t *testing.T) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	for i := 1; i <= 25; i++ {
+		novel := models.Novel{
+			Title:          fmt.Sprintf("Novel %02d", 26-i),
+			NovelUpdatesID: fmt.Sprintf("novel-%d", i),
+			Status:         "Ongoing",
+			LatestChapter:  i % 5,
+		}
+		if err := db.Create(&novel).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+
+		// The novels added last are bookmarked by the most users
+		for user := 0; user < i/5; user++ {
+			bookmark := models.BookmarkedNovel{NovelID: int(novel.ID), UserID: user + 1, Status: "reading"}
+			if err := db.Create(&bookmark).Error; err != nil {
+				t.Fatalf("Failed to create bookmark: %v", err)
+			}
+		}
+	}
+
+	novelController := controllers.NewNovelController(services.NewNovelService(repositories.NewNovelRepository(db), scrapers.NewPythonSource(scriptExecutor)))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/", novelController.GetNovels)
+
+	return router
+}
+
+// walkNovels follows the cursors of a listing from its first page and returns the novels of every page.
+func walkNovels(t *testing.T, router *gin.Engine, query string) []models.Novel {
+	var novels []models.Novel
+	cursor := ""
+
+	for pages := 0; pages < 10; pages++ {
+		w := doRequest(router, http.MethodGet, "/novels/?"+query+"&cursor="+url.QueryEscape(cursor), "")
+		if !assert.Equal(t, http.StatusOK, w.Code, w.Body.String()) {
+			return novels
+		}
+
+		var response struct {
+			dtos.CursorPaginatedResponse
+			Data []models.Novel `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		novels = append(novels, response.Data...)
+		if response.NextCursor == "" {
+			return novels
+		}
+		assert.Len(t, response.Data, 10)
+		cursor = response.NextCursor
+	}
+
+	t.Fatalf("The listing didn't end")
+	return nil
+}
+
+// novelTitles returns the titles of novels.
+func novelTitles(novels []models.Novel) []string {
+	titles := make([]string, len(novels))
+	for i, novel := range novels {
+		titles[i] = novel.Title
+	}
+	return titles
+}
+
+func TestGetNovelsByCursor(t *testing.T) {
+	router := setupNovelCursor(t)
+
+	t.Run("#NC_01->Pages follow each other in the order they were added", func(t *testing.T) {
+		novels := walkNovels(t, router, "limit=10")
+		assert.Len(t, novels, 25)
+		assert.Equal(t, "Novel 25", novels[0].Title)
+		assert.Equal(t, "Novel 01", novels[24].Title)
+	})
+
+	t.Run("#NC_02->Pages follow each other in the order of a sort", func(t *testing.T) {
+		titles := novelTitles(walkNovels(t, router, "sort=title"))
+		assert.Len(t, titles, 25)
+		assert.Equal(t, "Novel 01", titles[0])
+		assert.Equal(t, "Novel 25", titles[24])
+
+		titles = novelTitles(walkNovels(t, router, "sort=created&order=desc"))
+		assert.Equal(t, "Novel 01", titles[0])
+		assert.Equal(t, "Novel 25", titles[24])
+	})
+
+	t.Run("#NC_03->Novels with the same value are neither skipped nor repeated", func(t *testing.T) {
+		novels := walkNovels(t, router, "sort=latest_chapter&order=desc")
+		assert.Len(t, novels, 25)
+
+		seen := make(map[uint]bool)
+		for i, novel := range novels {
+			assert.False(t, seen[novel.ID], "novel %s is repeated", novel.Title)
+			seen[novel.ID] = true
+			if i > 0 {
+				assert.LessOrEqual(t, novel.LatestChapter, novels[i-1].LatestChapter)
+			}
+		}
+	})
+
+	t.Run("#NC_04->Novels are sorted by popularity", func(t *testing.T) {
+		titles := novelTitles(walkNovels(t, router, "sort=popularity&order=desc"))
+		assert.Len(t, titles, 25)
+		assert.Equal(t, "Novel 01", titles[0])
+		assert.Equal(t, []string{"Novel 06", "Novel 05", "Novel 04", "Novel 03", "Novel 02"}, titles[1:6])
+		assert.Equal(t, "Novel 22", titles[24])
+	})
+
+	t.Run("#NC_05->The cursor keeps the sort of the listing", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/?sort=title&cursor=", "")
+		var response dtos.CursorPaginatedResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		w = doRequest(router, http.MethodGet, "/novels/?cursor="+response.NextCursor, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Novel 11"`)
+
+		w = doRequest(router, http.MethodGet, "/novels/?sort=year&cursor="+response.NextCursor, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CURSOR)
+
+		w = doRequest(router, http.MethodGet, "/novels/?cursor=not-a-cursor", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_CURSOR)
+	})
+
+	t.Run("#NC_06->Pages by number are still available", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/?page=3&sort=title", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dtos.PaginatedResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int64(25), response.Total)
+		assert.Equal(t, int64(3), response.TotalPages)
+	})
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/scrapers"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupNovelQuery cleans the database, creates novels with different tags, genres, statuses, years and chapters, and
+// returns a router serving the listing of the novels.
+func setupNovelQuery(t *testing.T) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	novelRepository := repositories.NewNovelRepository(db)
+	novels := []models.Novel{
+		{Title: "Martial Peak", Status: "Completed", Language: "Chinese", Year: "2013", LatestChapter: 6009, NovelUpdatesID: "martial-peak",
+			Tags: []models.Tag{{Name: "Cultivation"}, {Name: "System"}, {Name: "Harem"}}, Genres: []models.Genre{{Name: "Action"}}, Authors: []models.Author{{Name: "Momo"}}},
+		{Title: "Against the Gods", Status: "Ongoing", Language: "Chinese", Year: "2014", LatestChapter: 1900, NovelUpdatesID: "against-the-gods",
+			Tags: []models.Tag{{Name: "Cultivation"}, {Name: "Harem"}}, Genres: []models.Genre{{Name: "Action"}}, Authors: []models.Author{{Name: "Mars Gravity"}}},
+		{Title: "Library of Heaven's Path", Status: "Completed", Language: "Chinese", Year: "2017", LatestChapter: 2200, NovelUpdatesID: "library-of-heavens-path",
+			Tags: []models.Tag{{Name: "Cultivation"}, {Name: "System"}}, Genres: []models.Genre{{Name: "Comedy"}}, Authors: []models.Author{{Name: "Heng Sao Tian Ya"}}},
+		{Title: "Solo Leveling", Status: "Completed", Language: "Korean", Year: "2016", LatestChapter: 270, NovelUpdatesID: "solo-leveling",
+			Tags: []models.Tag{{Name: "System"}}, Genres: []models.Genre{{Name: "Action"}}, Authors: []models.Author{{Name: "Chugong"}}},
+		{Title: "Omniscient Reader", Status: "Completed", Language: "Korean", Year: "2018", LatestChapter: 551, NovelUpdatesID: "omniscient-reader",
+			Tags: []models.Tag{{Name: "Apocalypse"}}, Genres: []models.Genre{{Name: "Fantasy"}}, Authors: []models.Author{{Name: "Sing Shong"}}},
+	}
+	for _, novel := range novels {
+		if _, err := novelRepository.CreateNovel(novel); err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	novelController := controllers.NewNovelController(services.NewNovelService(novelRepository, scrapers.NewPythonSource(scriptExecutor)))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/", novelController.GetNovels)
+
+	return router
+}
+
+// queryNovels lists the novels and returns their titles.
+func queryNovels(t *testing.T, router *gin.Engine, query string) []string {
+	w := doRequest(router, http.MethodGet, "/novels/?"+query, "")
+	if !assert.Equal(t, http.StatusOK, w.Code, w.Body.String()) {
+		return nil
+	}
+
+	var response struct {
+		Data []models.Novel `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	titles := make([]string, len(response.Data))
+	for i, novel := range response.Data {
+		titles[i] = novel.Title
+	}
+	return titles
+}
+
+func TestGetNovelsByQuery(t *testing.T) {
+	router := setupNovelQuery(t)
+
+	t.Run("#NQ_01->Included tags must all match, excluded tags must not", func(t *testing.T) {
+		assert.Equal(t, []string{"Martial Peak", "Library of Heaven's Path"}, queryNovels(t, router, "tags=cultivation,system"))
+		assert.Equal(t, []string{"Library of Heaven's Path"}, queryNovels(t, router, "tags=Cultivation&tags=System&exclude_tags=Harem"))
+		assert.Equal(t, []string{"Library of Heaven's Path"}, queryNovels(t, router, "tags=Cultivation,System&exclude_tags=Harem&status=completed&min_chapters=500"))
+	})
+
+	t.Run("#NQ_02->Match any of the included entries", func(t *testing.T) {
+		assert.Equal(t, []string{"Martial Peak", "Against the Gods", "Solo Leveling", "Omniscient Reader"}, queryNovels(t, router, "genres=Action,Fantasy&match=any"))
+		assert.Equal(t, []string{"Solo Leveling", "Omniscient Reader"}, queryNovels(t, router, "authors=Chugong,sing%20shong&match=any"))
+		assert.Equal(t, []string{"Martial Peak", "Against the Gods", "Library of Heaven's Path"}, queryNovels(t, router, "exclude_genres=Fantasy&exclude_authors=Chugong"))
+	})
+
+	t.Run("#NQ_03->Language, year range and sort", func(t *testing.T) {
+		assert.Equal(t, []string{"Omniscient Reader", "Solo Leveling"}, queryNovels(t, router, "language=korean&sort=latest_chapter&order=desc"))
+		assert.Equal(t, []string{"Against the Gods", "Solo Leveling", "Library of Heaven's Path"}, queryNovels(t, router, "year_from=2014&year_to=2017&sort=year"))
+		assert.Equal(t, []string{"Against the Gods", "Library of Heaven's Path", "Martial Peak", "Omniscient Reader", "Solo Leveling"}, queryNovels(t, router, "sort=title"))
+	})
+
+	t.Run("#NQ_04->Invalid queries", func(t *testing.T) {
+		for _, query := range []string{"sort=rating", "order=up", "match=some", "year_from=2018&year_to=2014", "min_chapters=-1"} {
+			w := doRequest(router, http.MethodGet, "/novels/?"+query, "")
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+
+		w := doRequest(router, http.MethodGet, "/novels/?sort=rating", "")
+		assert.Contains(t, w.Body.String(), errors.INVALID_NOVEL_QUERY)
+
+		w = doRequest(router, http.MethodGet, "/novels/?tags=Romance", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#NQ_05->Novels whose year is unknown are left out of the year range", func(t *testing.T) {
+		unknown := models.Novel{Title: "Shadow Slave", Status: "Ongoing", Year: "N/A", NovelUpdatesID: "shadow-slave"}
+		if err := db.Create(&unknown).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+		t.Cleanup(func() { db.Unscoped().Delete(&unknown) })
+
+		assert.Equal(t, []string{"Omniscient Reader"}, queryNovels(t, router, "year_from=2018"))
+		assert.Equal(t, []string{"Martial Peak"}, queryNovels(t, router, "year_to=2013"))
+	})
+}
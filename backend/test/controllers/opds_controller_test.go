@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupOPDS cleans the database, creates a library of novels and returns a router serving the OPDS catalog.
+func setupOPDS(t *testing.T) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	novelRepository := repositories.NewNovelRepository(db)
+	for i := 1; i <= 12; i++ {
+		novel := models.Novel{
+			Title:          fmt.Sprintf("Cultivation Story %02d", i),
+			Synopsis:       "A story & more.",
+			CoverUrl:       fmt.Sprintf("https://example.com/cover-%d.jpg", i),
+			Language:       "en",
+			Status:         "Ongoing",
+			NovelUpdatesID: fmt.Sprintf("cultivation-story-%d", i),
+			Year:           "2020",
+			Authors:        []models.Author{{Name: "Er Gen"}},
+			Genres:         []models.Genre{{Name: "Xianxia", Description: "Immortal heroes."}},
+			Tags:           []models.Tag{{Name: "Cultivation"}},
+		}
+		if i > 10 {
+			novel.Title = fmt.Sprintf("Slice of Life %02d", i)
+			novel.Genres = []models.Genre{{Name: "Slice of Life", Description: "Everyday life."}}
+			novel.Authors = []models.Author{{Name: "Someone Else"}}
+		}
+		if _, err := novelRepository.CreateNovel(novel); err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	opdsService := services.NewOPDSService(novelRepository, repositories.NewTaxonomyRepository(db), "http://localhost")
+	opdsController := controllers.NewOPDSController(opdsService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	opds := router.Group("/opds")
+	opds.GET("", opdsController.GetRootFeed)
+	opds.GET("/opensearch.xml", opdsController.GetOpenSearchDescription)
+	opds.GET("/search", opdsController.SearchFeed)
+	opds.GET("/novels", opdsController.GetNovelsFeed)
+	opds.GET("/genres", opdsController.GetGenresFeed)
+	opds.GET("/genres/:genre_name", opdsController.GetGenreFeed)
+	opds.GET("/tags", opdsController.GetTagsFeed)
+	opds.GET("/tags/:tag_name", opdsController.GetTagFeed)
+	opds.GET("/authors", opdsController.GetAuthorsFeed)
+	opds.GET("/authors/:author_name", opdsController.GetAuthorFeed)
+
+	return router
+}
+
+// getFeed requests an OPDS feed and decodes it.
+func getFeed(t *testing.T, router *gin.Engine, path string) dtos.OPDSFeed {
+	w := doRequest(router, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var feed dtos.OPDSFeed
+	assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), &feed))
+	return feed
+}
+
+// feedLink returns the href of the first link of a feed with the given relation, or an empty string.
+func feedLink(feed dtos.OPDSFeed, rel string) string {
+	for _, link := range feed.Links {
+		if link.Rel == rel {
+			return link.Href
+		}
+	}
+	return ""
+}
+
+func TestOPDSCatalog(t *testing.T) {
+	router := setupOPDS(t)
+
+	t.Run("#OPDS_01->Root feed links to the sections of the catalog", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/opds", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), dtos.OPDSNavigationType)
+
+		feed := getFeed(t, router, "/opds")
+		assert.Len(t, feed.Entries, 4)
+		assert.Equal(t, "http://localhost/opds/novels", feed.Entries[0].Links[0].Href)
+		assert.Equal(t, dtos.OPDSAcquisitionType, feed.Entries[0].Links[0].Type)
+		assert.Equal(t, "http://localhost/opds/opensearch.xml", feedLink(feed, "search"))
+	})
+
+	t.Run("#OPDS_02->Novels feed is paginated with acquisition links", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/opds/novels", "")
+		assert.Contains(t, w.Header().Get("Content-Type"), dtos.OPDSAcquisitionType)
+		assert.Contains(t, w.Body.String(), "<opensearch:totalResults>12</opensearch:totalResults>")
+
+		feed := getFeed(t, router, "/opds/novels")
+		assert.Len(t, feed.Entries, 10)
+		assert.Equal(t, "http://localhost/opds/novels?limit=10&page=2", feedLink(feed, "next"))
+		assert.Equal(t, "http://localhost/opds/novels?limit=10&page=2", feedLink(feed, "last"))
+		assert.Empty(t, feedLink(feed, "previous"))
+
+		entry := feed.Entries[0]
+		assert.Equal(t, "A story & more.", entry.Summary.Text)
+		assert.Equal(t, "Er Gen", entry.Authors[0].Name)
+		assert.Contains(t, entry.Links, dtos.OPDSLink{
+			Rel:  "http://opds-spec.org/acquisition/open-access",
+			Href: fmt.Sprintf("http://localhost/novels/%d/export.epub", novelIDOf(t, "cultivation-story-1")),
+			Type: "application/epub+zip",
+		})
+		assert.Contains(t, entry.Links, dtos.OPDSLink{Rel: "http://opds-spec.org/image", Href: "https://example.com/cover-1.jpg"})
+
+		feed = getFeed(t, router, "/opds/novels?page=2")
+		assert.Len(t, feed.Entries, 2)
+		assert.Equal(t, "http://localhost/opds/novels?limit=10&page=1", feedLink(feed, "previous"))
+		assert.Empty(t, feedLink(feed, "next"))
+	})
+
+	t.Run("#OPDS_03->Genre feeds list the genres and their novels", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/opds/genres", "")
+		assert.Contains(t, w.Body.String(), `thr:count="10"`)
+
+		feed := getFeed(t, router, "/opds/genres")
+		assert.Len(t, feed.Entries, 2)
+		assert.Equal(t, "Slice of Life", feed.Entries[0].Title)
+		assert.Equal(t, "Everyday life.", feed.Entries[0].Content.Text)
+		assert.Equal(t, "http://localhost/opds/genres/Slice%20of%20Life", feed.Entries[0].Links[0].Href)
+
+		feed = getFeed(t, router, "/opds/genres/Slice%20of%20Life")
+		assert.Len(t, feed.Entries, 2)
+		assert.Equal(t, "Slice of Life 11", feed.Entries[0].Title)
+	})
+
+	t.Run("#OPDS_04->Tag and author feeds list their novels", func(t *testing.T) {
+		feed := getFeed(t, router, "/opds/tags")
+		assert.Len(t, feed.Entries, 1)
+		assert.Equal(t, "Cultivation", feed.Entries[0].Title)
+
+		feed = getFeed(t, router, "/opds/tags/Cultivation?limit=20")
+		assert.Len(t, feed.Entries, 12)
+
+		feed = getFeed(t, router, "/opds/authors")
+		assert.Len(t, feed.Entries, 2)
+		assert.Equal(t, "2 novels", feed.Entries[1].Content.Text)
+
+		feed = getFeed(t, router, "/opds/authors/Someone%20Else")
+		assert.Len(t, feed.Entries, 2)
+	})
+
+	t.Run("#OPDS_05->Search follows the OpenSearch description", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/opds/opensearch.xml", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var description dtos.OpenSearchDescription
+		assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), &description))
+		assert.Equal(t, "http://localhost/opds/search?q={searchTerms}", description.URLs[0].Template)
+
+		feed := getFeed(t, router, "/opds/search?q=slice")
+		assert.Len(t, feed.Entries, 2)
+		assert.Equal(t, "http://localhost/opds/search?limit=10&page=1&q=slice", feedLink(feed, "self"))
+	})
+
+	t.Run("#OPDS_06->Invalid requests", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/opds/search", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.SEARCH_QUERY_REQUIRED)
+
+		w = doRequest(router, http.MethodGet, "/opds/novels?page=3", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.PAGE_OUT_OF_RANGE)
+
+		w = doRequest(router, http.MethodGet, "/opds/novels?limit=5", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		feed := getFeed(t, router, "/opds/genres/Horror")
+		assert.Empty(t, feed.Entries)
+	})
+}
+
+// novelIDOf returns the ID of a stored novel.
+func novelIDOf(t *testing.T, novelUpdatesID string) uint {
+	var novel models.Novel
+	if err := db.Where("novel_updates_id = ?", novelUpdatesID).First(&novel).Error; err != nil {
+		t.Fatalf("Failed to get novel: %v", err)
+	}
+	return novel.ID
+}
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupReadingHistory cleans the database, creates a user and a fantasy novel with chapters 1 to 3, whose bodies have
+// ten times as many words as their number, and returns a router serving the chapters and the reading statistics with
+// requests authenticated as that user.
+func setupReadingHistory(t *testing.T) (*gin.Engine, models.User, models.Novel) {
+	utils.TruncateTables(t, db)
+
+	user := models.User{Username: "reader", Email: "reader@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	var genre models.Genre
+	var tag models.Tag
+	db.Where(models.Genre{Name: "Fantasy"}).FirstOrCreate(&genre)
+	db.Where(models.Tag{Name: "Magic"}).FirstOrCreate(&tag)
+
+	novel := models.Novel{
+		Title:          "Mother of Learning",
+		Synopsis:       "Test",
+		NovelUpdatesID: "mother-of-learning",
+		LatestChapter:  3,
+		Genres:         []models.Genre{genre},
+		Tags:           []models.Tag{tag},
+	}
+	if err := db.Create(&novel).Error; err != nil {
+		t.Fatalf("Failed to create novel: %v", err)
+	}
+
+	chapterRepo := repositories.NewChapterRepository(db)
+	for i := uint(1); i <= 3; i++ {
+		if _, err := chapterRepo.CreateChapter(models.Chapter{
+			ChapterNo:  i,
+			NovelID:    &novel.ID,
+			Title:      fmt.Sprintf("Chapter %d", i),
+			ChapterUrl: fmt.Sprintf("https://example.com/mother-of-learning/%d", i),
+			Body:       strings.TrimSpace(strings.Repeat("word ", int(i)*10)),
+		}); err != nil {
+			t.Fatalf("Failed to create chapter: %v", err)
+		}
+	}
+
+	readingHistoryService := services.NewReadingHistoryService(repositories.NewReadingEventRepository(db))
+	chapterService := services.NewChapterService(chapterRepo, repositories.NewNovelRepository(db), &mocks.MockSource{SourceName: "mock"})
+	chapterController := controllers.NewChapterController(chapterService, readingHistoryService)
+	readingHistoryController := controllers.NewReadingHistoryController(readingHistoryService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authenticated := func(c *gin.Context) {
+		c.Set("user", &user)
+		c.Next()
+	}
+	router.GET("/novels/chapters/novel/:novel_title/chapter/:chapter_no", authenticated, chapterController.GetChapterByNovelUpdatesIDAndChapterNo)
+	router.GET("/anonymous/:novel_title/chapter/:chapter_no", chapterController.GetChapterByNovelUpdatesIDAndChapterNo)
+	router.GET("/optional/:novel_title/chapter/:chapter_no", myMiddleware.OptionalAuthMiddleware(), chapterController.GetChapterByNovelUpdatesIDAndChapterNo)
+	router.GET("/user/reading-stats", authenticated, readingHistoryController.GetReadingStats)
+
+	return router, user, novel
+}
+
+// readingEvents returns the reading history of a user, oldest first.
+func readingEvents(t *testing.T, userID uint) []models.ReadingEvent {
+	var events []models.ReadingEvent
+	if err := db.Where("user_id = ?", userID).Order("id ASC").Find(&events).Error; err != nil {
+		t.Fatalf("Failed to get reading events: %v", err)
+	}
+	return events
+}
+
+// getReadingStats requests the reading statistics and decodes them.
+func getReadingStats(t *testing.T, router *gin.Engine, query string) dtos.ReadingStats {
+	w := doRequest(router, http.MethodGet, "/user/reading-stats"+query, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var stats dtos.ReadingStats
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+	return stats
+}
+
+func TestReadingHistory(t *testing.T) {
+	router, user, novel := setupReadingHistory(t)
+	t.Cleanup(cleanDB)
+	path := "/novels/chapters/novel/mother-of-learning/chapter/"
+
+	t.Run("#RH_01->Fetching chapters records the reading history", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/anonymous/mother-of-learning/chapter/1", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, readingEvents(t, user.ID))
+
+		doRequest(router, http.MethodGet, path+"1", "")
+		doRequest(router, http.MethodGet, path+"1", "")
+		events := readingEvents(t, user.ID)
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, models.ReadingEventOpened, events[0].Event)
+			assert.Equal(t, uint(1), events[0].ChapterNo)
+			assert.Equal(t, 10, events[0].WordCount)
+		}
+
+		doRequest(router, http.MethodGet, path+"2", "")
+		events = readingEvents(t, user.ID)
+		if assert.Len(t, events, 3) {
+			assert.Equal(t, models.ReadingEventFinished, events[1].Event)
+			assert.Equal(t, uint(1), events[1].ChapterNo)
+			assert.Equal(t, 10, events[1].WordCount)
+			assert.Equal(t, models.ReadingEventOpened, events[2].Event)
+			assert.Equal(t, uint(2), events[2].ChapterNo)
+		}
+
+		// Going back to an earlier chapter doesn't finish the current one
+		doRequest(router, http.MethodGet, path+"1", "")
+		assert.Len(t, readingEvents(t, user.ID), 4)
+	})
+
+	t.Run("#RH_02->Time spent on a chapter is capped", func(t *testing.T) {
+		db.Model(&models.ReadingEvent{}).Where("user_id = ? AND event = ?", user.ID, models.ReadingEventOpened).
+			Update("created_at", time.Now().Add(-3*time.Hour))
+
+		doRequest(router, http.MethodGet, path+"3", "")
+		events := readingEvents(t, user.ID)
+		if assert.Len(t, events, 6) {
+			assert.Equal(t, models.ReadingEventFinished, events[4].Event)
+			assert.Equal(t, uint(1), events[4].ChapterNo)
+			assert.Equal(t, 30*60, events[4].Seconds)
+		}
+	})
+
+	t.Run("#RH_03->Statistics count chapters, words, time and streaks", func(t *testing.T) {
+		db.Exec("DELETE FROM reading_events")
+		now := time.Now().UTC()
+		for _, daysAgo := range []int{0, 1, 1, 5, 6, 7, 40, 800, 801, 802, 803} {
+			db.Create(&models.ReadingEvent{
+				UserID:    user.ID,
+				NovelID:   novel.ID,
+				ChapterNo: 1,
+				Event:     models.ReadingEventFinished,
+				WordCount: 100,
+				Seconds:   60,
+				CreatedAt: now.AddDate(0, 0, -daysAgo),
+			})
+		}
+
+		stats := getReadingStats(t, router, "?days=7")
+		assert.Equal(t, now.Format("2006-01-02"), stats.To)
+		assert.Equal(t, now.AddDate(0, 0, -6).Format("2006-01-02"), stats.From)
+		assert.Equal(t, "UTC", stats.Timezone)
+		assert.Equal(t, int64(5), stats.ChaptersRead)
+		assert.Equal(t, int64(500), stats.WordsRead)
+		assert.Equal(t, int64(300), stats.SecondsRead)
+		assert.Equal(t, 2, stats.CurrentStreak)
+		// The streak of 4 days is older than the days the streaks are looked for in
+		assert.Equal(t, 3, stats.LongestStreak)
+
+		if assert.Len(t, stats.Days, 7) {
+			assert.Equal(t, int64(1), stats.Days[6].Chapters)
+			assert.Equal(t, int64(2), stats.Days[5].Chapters)
+			assert.Equal(t, int64(0), stats.Days[2].Chapters)
+		}
+		var weekly int64
+		for _, week := range stats.Weeks {
+			weekly += week.Chapters
+		}
+		assert.Equal(t, int64(5), weekly)
+
+		assert.Equal(t, []models.ReadingShare{{Name: "Fantasy", Chapters: 5}}, stats.FavouriteGenres)
+		assert.Equal(t, []models.ReadingShare{{Name: "Magic", Chapters: 5}}, stats.FavouriteTags)
+
+		stats = getReadingStats(t, router, "?tz=Asia/Tokyo")
+		assert.Len(t, stats.Days, 30)
+		assert.Equal(t, "Asia/Tokyo", stats.Timezone)
+		assert.Equal(t, int64(6), stats.ChaptersRead)
+	})
+
+	t.Run("#RH_04->Invalid statistics requests", func(t *testing.T) {
+		for _, query := range []string{"?days=0", "?days=366", "?days=week", "?tz=Mars/Olympus"} {
+			w := doRequest(router, http.MethodGet, "/user/reading-stats"+query, "")
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			assert.Contains(t, w.Body.String(), errors.INVALID_READING_STATS)
+		}
+	})
+
+	t.Run("#RH_05->Chapters are read anonymously with an invalid token", func(t *testing.T) {
+		accessToken, _, err := authService.GenerateToken(&user)
+		if err != nil {
+			t.Fatalf("Failed to generate token: %v", err)
+		}
+		readWithToken := func(token string) int {
+			req := httptest.NewRequest(http.MethodGet, "/optional/mother-of-learning/chapter/3", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w.Code
+		}
+		before := len(readingEvents(t, user.ID))
+
+		assert.Equal(t, http.StatusOK, readWithToken("expired.or.invalid"))
+		assert.Len(t, readingEvents(t, user.ID), before)
+
+		assert.Equal(t, http.StatusOK, readWithToken(accessToken))
+		assert.Greater(t, len(readingEvents(t, user.ID)), before)
+	})
+}
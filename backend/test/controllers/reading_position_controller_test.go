@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupReadingPositions cleans the database, creates two novels, the first bookmarked by a user, and returns a router
+// serving the reading positions with requests authenticated as that user.
+func setupReadingPositions(t *testing.T) (*gin.Engine, models.User, []models.Novel) {
+	utils.TruncateTables(t, db)
+
+	user := models.User{Username: "syncer", Email: "syncer@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	novels := []models.Novel{
+		{Title: "Lord of the Mysteries", NovelUpdatesID: "lord-of-the-mysteries"},
+		{Title: "Shadow Slave", NovelUpdatesID: "shadow-slave"},
+	}
+	for i := range novels {
+		if err := db.Create(&novels[i]).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	bookmark := models.BookmarkedNovel{NovelID: int(novels[0].ID), UserID: int(user.ID), Status: "reading", CurrentChapter: 1}
+	if err := db.Create(&bookmark).Error; err != nil {
+		t.Fatalf("Failed to create bookmark: %v", err)
+	}
+
+	readingPositionService := services.NewReadingPositionService(repositories.NewReadingPositionRepository(db), repositories.NewNovelRepository(db), repositories.NewBookmarkRepository(db))
+	readingPositionController := controllers.NewReadingPositionController(readingPositionService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authenticated := func(c *gin.Context) {
+		c.Set("user", &user)
+		c.Next()
+	}
+	router.GET("/novels/:novel_id/reading-position", authenticated, readingPositionController.GetReadingPosition)
+	router.PUT("/novels/:novel_id/reading-position", authenticated, readingPositionController.SyncReadingPosition)
+	router.GET("/user/reading-positions", authenticated, readingPositionController.GetReadingPositions)
+	router.PUT("/anonymous/:novel_id/reading-position", readingPositionController.SyncReadingPosition)
+
+	return router, user, novels
+}
+
+// syncReadingPosition sends a reading position and decodes the result.
+func syncReadingPosition(t *testing.T, router *gin.Engine, novelID uint, body string) dtos.ReadingPositionSyncResponse {
+	w := doRequest(router, http.MethodPut, fmt.Sprintf("/novels/%d/reading-position", novelID), body)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dtos.ReadingPositionSyncResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+// positionAt builds the body of a reading position reached at a time.
+func positionAt(chapterNo uint, percent float64, deviceID string, readAt time.Time) string {
+	return fmt.Sprintf(`{"chapterNo":%d,"paragraph":%d,"percent":%g,"deviceId":"%s","readAt":"%s"}`,
+		chapterNo, int(percent)/10, percent, deviceID, readAt.Format(time.RFC3339Nano))
+}
+
+func TestReadingPositions(t *testing.T) {
+	router, user, novels := setupReadingPositions(t)
+	t.Cleanup(cleanDB)
+	novelID := novels[0].ID
+	start := time.Now().Add(-time.Hour)
+
+	t.Run("#RP_01->First position of a novel is stored", func(t *testing.T) {
+		response := syncReadingPosition(t, router, novelID, positionAt(3, 40, "phone", start))
+		assert.True(t, response.Applied)
+		assert.Nil(t, response.Superseded)
+		assert.Equal(t, uint(3), response.Position.ChapterNo)
+		assert.Equal(t, 4, response.Position.Paragraph)
+		assert.Equal(t, 40.0, response.Position.Percent)
+		assert.Equal(t, user.ID, response.Position.UserID)
+
+		var bookmark models.BookmarkedNovel
+		db.Where("user_id = ? AND novel_id = ?", user.ID, novelID).First(&bookmark)
+		assert.Equal(t, 3, bookmark.CurrentChapter)
+	})
+
+	t.Run("#RP_02->Later position from another device replaces the stored one", func(t *testing.T) {
+		response := syncReadingPosition(t, router, novelID, positionAt(5, 10, "desktop", start.Add(10*time.Minute)))
+		assert.True(t, response.Applied)
+		assert.Equal(t, "desktop", response.Position.DeviceID)
+		assert.Equal(t, uint(5), response.Position.ChapterNo)
+		if assert.NotNil(t, response.Superseded) {
+			assert.Equal(t, "phone", response.Superseded.DeviceID)
+			assert.Equal(t, uint(3), response.Superseded.ChapterNo)
+		}
+
+		response = syncReadingPosition(t, router, novelID, positionAt(5, 60, "desktop", start.Add(20*time.Minute)))
+		assert.True(t, response.Applied)
+		assert.Nil(t, response.Superseded)
+	})
+
+	t.Run("#RP_03->Position reached before the stored one loses", func(t *testing.T) {
+		response := syncReadingPosition(t, router, novelID, positionAt(4, 90, "phone", start.Add(15*time.Minute)))
+		assert.False(t, response.Applied)
+		assert.Equal(t, "desktop", response.Position.DeviceID)
+		assert.Equal(t, 60.0, response.Position.Percent)
+		if assert.NotNil(t, response.Superseded) {
+			assert.Equal(t, uint(4), response.Superseded.ChapterNo)
+			assert.Equal(t, "phone", response.Superseded.DeviceID)
+		}
+
+		var bookmark models.BookmarkedNovel
+		db.Where("user_id = ? AND novel_id = ?", user.ID, novelID).First(&bookmark)
+		assert.Equal(t, 5, bookmark.CurrentChapter)
+	})
+
+	t.Run("#RP_04->Position from the future counts as now", func(t *testing.T) {
+		response := syncReadingPosition(t, router, novelID, positionAt(6, 0, "tablet", time.Now().Add(24*time.Hour)))
+		assert.True(t, response.Applied)
+		assert.True(t, response.Position.ReadAt.Before(time.Now().Add(time.Second)))
+
+		response = syncReadingPosition(t, router, novelID, `{"chapterNo":7,"deviceId":"phone"}`)
+		assert.True(t, response.Applied)
+		assert.Equal(t, uint(7), response.Position.ChapterNo)
+	})
+
+	t.Run("#RP_05->Latest positions are fetched per novel", func(t *testing.T) {
+		syncReadingPosition(t, router, novels[1].ID, positionAt(2, 50, "phone", start))
+
+		w := doRequest(router, http.MethodGet, fmt.Sprintf("/novels/%d/reading-position", novelID), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var position models.ReadingPosition
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &position))
+		assert.Equal(t, uint(7), position.ChapterNo)
+		assert.Equal(t, "phone", position.DeviceID)
+
+		w = doRequest(router, http.MethodGet, "/user/reading-positions", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var positions []models.ReadingPosition
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &positions))
+		assert.Len(t, positions, 2)
+		assert.Equal(t, novelID, positions[0].NovelID)
+		assert.Equal(t, novels[1].ID, positions[1].NovelID)
+	})
+
+	t.Run("#RP_06->Invalid reading positions", func(t *testing.T) {
+		for _, body := range []string{
+			`{"chapterNo":0,"deviceId":"phone"}`,
+			`{"chapterNo":1,"percent":120,"deviceId":"phone"}`,
+			`{"chapterNo":1,"paragraph":-1,"deviceId":"phone"}`,
+			`{"chapterNo":1,"deviceId":"  "}`,
+			`{"chapterNo":"one"}`,
+		} {
+			w := doRequest(router, http.MethodPut, fmt.Sprintf("/novels/%d/reading-position", novelID), body)
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+			assert.Contains(t, w.Body.String(), errors.INVALID_READING_POSITION)
+		}
+
+		w := doRequest(router, http.MethodPut, "/novels/99999/reading-position", `{"chapterNo":1,"deviceId":"phone"}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = doRequest(router, http.MethodGet, "/novels/99999/reading-position", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.READING_POSITION_NOT_FOUND)
+
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("/anonymous/%d/reading-position", novelID), `{"chapterNo":1,"deviceId":"phone"}`)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"backend/test/utils"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupRefreshScheduler creates a novel released daily with its chapters 1 and 2 stored, one released weekly with its
+// chapter 1 stored and a completed one, and returns a scheduler refreshing them from the given source.
+func setupRefreshScheduler(t *testing.T, source *mocks.MockSource) (*services.RefreshSchedulerService, *services.ImportJobService) {
+	utils.TruncateTables(t, db)
+
+	novels := []models.Novel{
+		{Title: "Daily", NovelUpdatesID: "daily-novel", Status: "Ongoing", ReleaseFrequency: "Every 0.5 Day(s)", LatestChapter: 2},
+		{Title: "Weekly", NovelUpdatesID: "weekly-novel", Status: "Ongoing", ReleaseFrequency: "Every 5 Day(s)", LatestChapter: 1},
+		{Title: "Done", NovelUpdatesID: "done-novel", Status: "Completed", ReleaseFrequency: "Every 0.5 Day(s)", LatestChapter: 9},
+	}
+	for _, novel := range novels {
+		novel.Synopsis, novel.CoverUrl, novel.Language = "Test", "https://example.com/cover.jpg", "en"
+		if err := db.Create(&novel).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+
+		for chapterNo := 1; chapterNo <= novel.LatestChapter && novel.Status == "Ongoing"; chapterNo++ {
+			chapter := models.Chapter{
+				ChapterNo:  uint(chapterNo),
+				NovelID:    &novel.ID,
+				Title:      fmt.Sprintf("Chapter %d", chapterNo),
+				ChapterUrl: fmt.Sprintf("https://example.com/%s/%d", novel.NovelUpdatesID, chapterNo),
+				Body:       "Body",
+			}
+			if err := db.Create(&chapter).Error; err != nil {
+				t.Fatalf("Failed to create chapter: %v", err)
+			}
+		}
+	}
+
+	novelRepo := repositories.NewNovelRepository(db)
+	chapterService := services.NewChapterService(repositories.NewChapterRepository(db), novelRepo, source)
+	importJobService := services.NewImportJobService(repositories.NewImportJobRepository(db), novelRepo, chapterService, nil)
+	t.Cleanup(importJobService.Close)
+
+	scheduler, err := services.NewRefreshSchedulerService(novelRepo, services.NewNovelService(novelRepo, source), importJobService, []services.RefreshSchedule{
+		{Name: "frequent", Cron: "0 */6 * * *", MaxReleaseInterval: 1},
+		{Name: "slow", Cron: "30 3 * * 0"},
+	})
+	assert.NoError(t, err)
+	t.Cleanup(scheduler.Close)
+
+	return scheduler, importJobService
+}
+
+func importedNovel(title string, latestChapter float64) *models.ImportedNovel {
+	return &models.ImportedNovel{
+		Title:            title,
+		Synopsis:         "Test",
+		Status:           "Ongoing",
+		Language:         models.ImportedLanguage{Name: "en"},
+		ReleaseFrequency: "Every 0.5 Day(s)",
+		LatestChapter:    latestChapter,
+	}
+}
+
+// waitForImportJob waits for an import job to finish and returns it.
+func waitForImportJob(t *testing.T, importJobService *services.ImportJobService, id uint) *models.ImportJob {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := importJobService.GetImportJobByID(id)
+		if err != nil {
+			t.Fatalf("Failed to get import job: %v", err)
+		}
+		if job.IsFinished() {
+			return job
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("Import job %d did not finish", id)
+	return nil
+}
+
+func TestRefreshScheduler(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchNovelMetadata", "daily-novel").Return(importedNovel("Daily", 4), nil)
+	source.On("FetchNovelMetadata", "weekly-novel").Return(importedNovel("Weekly", 1), nil)
+	source.On("FetchChapter", "daily-novel", 3).Return(chapterResult(3), nil)
+	source.On("FetchChapter", "daily-novel", 4).Return(chapterResult(4), nil)
+
+	scheduler, importJobService := setupRefreshScheduler(t, source)
+
+	t.Run("#RS_01->Novels with new chapters get their chapters queued", func(t *testing.T) {
+		run, err := scheduler.RunSchedule("frequent")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, run.Checked)
+		assert.Equal(t, 1, run.Updated)
+		assert.Equal(t, 2, run.NewChapters)
+		assert.Equal(t, "daily-novel", run.Novels[0].NovelUpdatesId)
+
+		jobs, _, err := importJobService.GetImportJobs(1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, jobs, 1)
+		assert.True(t, jobs[0].Delta)
+		assert.Equal(t, 3, jobs[0].FromChapter)
+		assert.Equal(t, 4, jobs[0].ToChapter)
+
+		// Wait for the chapters before refreshing other novels
+		assert.Equal(t, models.ImportJobCompleted, waitForImportJob(t, importJobService, jobs[0].ID).State)
+	})
+
+	t.Run("#RS_02->Completed novels and novels without new chapters are skipped", func(t *testing.T) {
+		run, err := scheduler.RunSchedule("slow")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, run.Checked)
+		assert.Equal(t, 0, run.Updated)
+		assert.Empty(t, run.Novels)
+		source.AssertNotCalled(t, "FetchNovelMetadata", "done-novel")
+	})
+
+	t.Run("#RS_03->Unknown schedule", func(t *testing.T) {
+		_, err := scheduler.RunSchedule("unknown")
+		assert.Equal(t, errors.ErrRefreshScheduleNotFound, err)
+	})
+
+	t.Run("#RS_04->Status reports the last runs", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.GET("/novels/refresh/status", controllers.NewRefreshSchedulerController(scheduler).GetRefreshStatus)
+
+		w := doRequest(router, http.MethodGet, "/novels/refresh/status", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"running":false`)
+		assert.Contains(t, w.Body.String(), `"cron":"0 */6 * * *"`)
+		assert.Contains(t, w.Body.String(), `"newChapters":2`)
+	})
+
+	t.Run("#RS_08->Schedules are run on request", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.POST("/novels/refresh/:schedule", controllers.NewRefreshSchedulerController(scheduler).RunRefreshSchedule)
+
+		w := doRequest(router, http.MethodPost, "/novels/refresh/slow", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"schedule":"slow"`)
+		assert.Contains(t, w.Body.String(), `"finishedAt"`)
+
+		w = doRequest(router, http.MethodPost, "/novels/refresh/unknown", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.REFRESH_SCHEDULE_NOT_FOUND)
+	})
+
+	t.Run("#RS_05->Started scheduler reports the next runs", func(t *testing.T) {
+		scheduler.Start()
+
+		status := scheduler.GetStatus()
+		assert.True(t, status.Running)
+		for _, schedule := range status.Schedules {
+			assert.NotNil(t, schedule.NextRun)
+			assert.True(t, schedule.NextRun.After(time.Now()))
+		}
+	})
+
+	t.Run("#RS_06->Invalid cron expression", func(t *testing.T) {
+		_, err := services.NewRefreshSchedulerService(nil, nil, nil, []services.RefreshSchedule{{Name: "broken", Cron: "* * *"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestRefreshScheduler_Retry(t *testing.T) {
+	source := &mocks.MockSource{SourceName: "mock"}
+	source.On("FetchNovelMetadata", "daily-novel").Return(importedNovel("Daily", 4), nil)
+	source.On("FetchChapter", "daily-novel", 3).Return(chapterResult(3), nil)
+	source.On("FetchChapter", "daily-novel", 4).Return(chapterResult(4), nil)
+
+	scheduler, importJobService := setupRefreshScheduler(t, source)
+
+	t.Run("#RS_07->Chapters that could not be queued are queued by the next run", func(t *testing.T) {
+		var novel models.Novel
+		if err := db.Where("novel_updates_id = ?", "daily-novel").First(&novel).Error; err != nil {
+			t.Fatalf("Failed to get novel: %v", err)
+		}
+		blocking := models.ImportJob{NovelID: novel.ID, NovelUpdatesID: novel.NovelUpdatesID, FromChapter: 1, ToChapter: 1, State: models.ImportJobRunning}
+		if err := db.Create(&blocking).Error; err != nil {
+			t.Fatalf("Failed to create import job: %v", err)
+		}
+
+		run, err := scheduler.RunSchedule("frequent")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, run.Failed)
+		assert.Contains(t, run.Novels[0].Message, "chapters 3-4")
+
+		// The latest chapter of the novel was saved, but its chapters are still missing
+		if err := db.Model(&blocking).Update("state", models.ImportJobFailed).Error; err != nil {
+			t.Fatalf("Failed to update import job: %v", err)
+		}
+
+		run, err = scheduler.RunSchedule("frequent")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, run.Updated)
+		assert.Equal(t, 2, run.NewChapters)
+
+		var job models.ImportJob
+		if err := db.Where("novel_id = ? AND id <> ?", novel.ID, blocking.ID).First(&job).Error; err != nil {
+			t.Fatalf("Failed to get import job: %v", err)
+		}
+		assert.Equal(t, 3, job.FromChapter)
+		assert.Equal(t, 4, job.ToChapter)
+		assert.Equal(t, models.ImportJobCompleted, waitForImportJob(t, importJobService, job.ID).State)
+	})
+}
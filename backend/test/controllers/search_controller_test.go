@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// searchResponse is the paginated response of a search.
+type searchResponse[T any] struct {
+	Data  []T   `json:"data"`
+	Total int64 `json:"total"`
+}
+
+// setupSearch cleans the database, creates novels with chapters and returns a router serving the search endpoints.
+func setupSearch(t *testing.T) (*gin.Engine, []models.Novel) {
+	utils.TruncateTables(t, db)
+
+	novelRepository := repositories.NewNovelRepository(db)
+	novels := []models.Novel{
+		{Title: "Reverend Insanity", Synopsis: "A demon schemes towards eternal life.", NovelUpdatesID: "reverend-insanity", Authors: []models.Author{{Name: "Gu Zhen Ren"}}},
+		{Title: "Lord of the Mysteries", Synopsis: "Klein becomes a Seer in an age of steam, where demons lurk.", NovelUpdatesID: "lord-of-the-mysteries", Authors: []models.Author{{Name: "Cuttlefish That Loves Diving"}}},
+		{Title: "Demon Lord Diaries", Synopsis: "An office worker wakes up in a <b>castle</b>.", NovelUpdatesID: "demon-lord-diaries", Authors: []models.Author{{Name: "Someone"}}},
+	}
+	for i := range novels {
+		created, err := novelRepository.CreateNovel(novels[i])
+		if err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+		novels[i] = *created
+	}
+
+	bodies := []string{
+		"Fang Yuan refined the Spring Autumn Cicada.",
+		"The gu worms of the clan were fed.",
+		"Spring came to Qing Mao Mountain, and the cicada sang <img src=x onerror=alert(1)>.",
+	}
+	for i, body := range bodies {
+		chapter := models.Chapter{
+			ChapterNo:  uint(i + 1),
+			NovelID:    &novels[0].ID,
+			Title:      fmt.Sprintf("Chapter %d", i+1),
+			ChapterUrl: fmt.Sprintf("https://example.com/reverend-insanity/%d", i+1),
+			Body:       body,
+		}
+		if err := db.Create(&chapter).Error; err != nil {
+			t.Fatalf("Failed to create chapter: %v", err)
+		}
+	}
+
+	searchService := services.NewSearchService(novelRepository, repositories.NewSearchRepository(db))
+	searchController := controllers.NewSearchController(searchService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/novels/search", searchController.SearchNovels)
+	router.GET("/novels/:novel_id/search", searchController.SearchChapters)
+
+	return router, novels
+}
+
+// search requests a search and decodes its results.
+func search[T any](t *testing.T, router *gin.Engine, path string) searchResponse[T] {
+	w := doRequest(router, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response searchResponse[T]
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+func TestFullTextSearch(t *testing.T) {
+	router, novels := setupSearch(t)
+
+	t.Run("#SRCH_01->Novels are ranked by where the words match", func(t *testing.T) {
+		response := search[models.NovelSearchResult](t, router, "/novels/search?q=demon")
+		assert.Equal(t, int64(3), response.Total)
+		assert.Equal(t, "Demon Lord Diaries", response.Data[0].Novel.Title)
+		assert.Contains(t, response.Data[0].Snippet, "<mark>Demon</mark>")
+		assert.Equal(t, "Someone", response.Data[0].Novel.Authors[0].Name)
+		assert.Greater(t, response.Data[0].Rank, response.Data[2].Rank)
+	})
+
+	t.Run("#SRCH_02->Every word must match, as a word or its beginning", func(t *testing.T) {
+		response := search[models.NovelSearchResult](t, router, "/novels/search?q=Demon%20LORD")
+		assert.Equal(t, int64(2), response.Total)
+		assert.Equal(t, "Demon Lord Diaries", response.Data[0].Novel.Title)
+		assert.Equal(t, "Lord of the Mysteries", response.Data[1].Novel.Title)
+
+		response = search[models.NovelSearchResult](t, router, "/novels/search?q=myster")
+		assert.Equal(t, "Lord of the Mysteries", response.Data[0].Novel.Title)
+	})
+
+	t.Run("#SRCH_03->Authors are searched", func(t *testing.T) {
+		response := search[models.NovelSearchResult](t, router, "/novels/search?q=cuttlefish")
+		assert.Equal(t, int64(1), response.Total)
+		assert.Equal(t, "Lord of the Mysteries", response.Data[0].Novel.Title)
+		assert.Contains(t, response.Data[0].Snippet, "<mark>Cuttlefish</mark>")
+	})
+
+	t.Run("#SRCH_04->Chapters of a novel are searched", func(t *testing.T) {
+		response := search[models.ChapterSearchResult](t, router, fmt.Sprintf("/novels/%d/search?q=spring%%20cicada", novels[0].ID))
+		assert.Equal(t, int64(2), response.Total)
+		assert.Contains(t, response.Data[0].Snippet, "<mark>Spring</mark>")
+		assert.Contains(t, response.Data[0].Snippet, "<mark>Cicada</mark>")
+
+		db.Model(&models.Chapter{}).Where("novel_id = ? AND chapter_no = ?", novels[0].ID, 2).Update("body", "The spring cicada stirred.")
+		response = search[models.ChapterSearchResult](t, router, fmt.Sprintf("/novels/%d/search?q=spring%%20cicada", novels[0].ID))
+		assert.Equal(t, int64(3), response.Total)
+	})
+
+	t.Run("#SRCH_05->Snippets are escaped", func(t *testing.T) {
+		response := search[models.NovelSearchResult](t, router, "/novels/search?q=castle")
+		assert.Equal(t, int64(1), response.Total)
+		assert.Contains(t, response.Data[0].Snippet, "&lt;b&gt;<mark>castle</mark>&lt;/b&gt;")
+		assert.NotContains(t, response.Data[0].Snippet, "<b>")
+
+		chapters := search[models.ChapterSearchResult](t, router, fmt.Sprintf("/novels/%d/search?q=mountain", novels[0].ID))
+		assert.Equal(t, int64(1), chapters.Total)
+		assert.Contains(t, chapters.Data[0].Snippet, "<mark>Mountain</mark>")
+		assert.Contains(t, chapters.Data[0].Snippet, "&lt;img src=x onerror=alert(1)&gt;")
+	})
+
+	t.Run("#SRCH_06->Invalid searches", func(t *testing.T) {
+		w := doRequest(router, http.MethodGet, "/novels/search?q=%22*%20-", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.SEARCH_QUERY_REQUIRED)
+
+		w = doRequest(router, http.MethodGet, "/novels/search?q=nothing", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.NO_RESULTS)
+
+		w = doRequest(router, http.MethodGet, "/novels/999999/search?q=spring", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupShelves cleans the database, creates two users and two novels, and returns a router serving the shelves with
+// requests authenticated as the first user, and as the second one under /other.
+func setupShelves(t *testing.T) (*gin.Engine, []models.Novel) {
+	utils.TruncateTables(t, db)
+	if err := db.Unscoped().Where("email IN ?", []string{"collector@example.com", "stranger@example.com"}).Delete(&models.User{}).Error; err != nil {
+		log.Fatalf("Failed to clean up the database: %v", err)
+	}
+
+	users := []models.User{
+		{Username: "collector", Email: "collector@example.com", Password: "password"},
+		{Username: "stranger", Email: "stranger@example.com", Password: "password"},
+	}
+	for i := range users {
+		if err := db.Create(&users[i]).Error; err != nil {
+			t.Fatalf("Failed to create user: %v", err)
+		}
+	}
+
+	novels := []models.Novel{
+		{Title: "Warlock of the Magus World", NovelUpdatesID: "warlock-of-the-magus-world"},
+		{Title: "Reverend Insanity", NovelUpdatesID: "reverend-insanity"},
+	}
+	for i := range novels {
+		if err := db.Create(&novels[i]).Error; err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	shelfController := controllers.NewShelfController(services.NewShelfService(repositories.NewShelfRepository(db), repositories.NewNovelRepository(db)))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	for prefix, user := range map[string]*models.User{"": &users[0], "/other": &users[1]} {
+		authenticated := func(c *gin.Context) {
+			c.Set("user", user)
+			c.Next()
+		}
+		shelves := router.Group(prefix+"/user/shelves", authenticated)
+		shelves.GET("", shelfController.GetShelves)
+		shelves.POST("", shelfController.CreateShelf)
+		shelves.GET("/:shelf_id", shelfController.GetShelf)
+		shelves.PUT("/:shelf_id", shelfController.RenameShelf)
+		shelves.DELETE("/:shelf_id", shelfController.DeleteShelf)
+		shelves.PUT("/:shelf_id/novels/:novel_id", shelfController.AddNovelToShelf)
+		shelves.DELETE("/:shelf_id/novels/:novel_id", shelfController.RemoveNovelFromShelf)
+	}
+
+	return router, novels
+}
+
+// decodeShelf checks the status of a response and decodes the shelf in it.
+func decodeShelf(t *testing.T, status int, body []byte, expectedStatus int) models.Shelf {
+	assert.Equal(t, expectedStatus, status, string(body))
+
+	var shelf models.Shelf
+	assert.NoError(t, json.Unmarshal(body, &shelf))
+	return shelf
+}
+
+func TestShelves(t *testing.T) {
+	router, novels := setupShelves(t)
+	t.Cleanup(cleanDB)
+	var favourites, rereads models.Shelf
+
+	t.Run("#SHF_01->Shelves are created and named once per user", func(t *testing.T) {
+		w := doRequest(router, http.MethodPost, "/user/shelves", `{"name":"  Favourites "}`)
+		favourites = decodeShelf(t, w.Code, w.Body.Bytes(), http.StatusCreated)
+		assert.Equal(t, "Favourites", favourites.Name)
+		assert.Empty(t, favourites.Novels)
+
+		w = doRequest(router, http.MethodPost, "/user/shelves", `{"name":"To reread"}`)
+		rereads = decodeShelf(t, w.Code, w.Body.Bytes(), http.StatusCreated)
+
+		w = doRequest(router, http.MethodPost, "/user/shelves", `{"name":"Favourites"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.SHELF_CONFLICT)
+
+		w = doRequest(router, http.MethodPost, "/other/user/shelves", `{"name":"Favourites"}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		for _, body := range []string{`{"name":"   "}`, fmt.Sprintf(`{"name":"%0101d"}`, 0), `{"name":1}`} {
+			w = doRequest(router, http.MethodPost, "/user/shelves", body)
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+			assert.Contains(t, w.Body.String(), errors.INVALID_SHELF)
+		}
+	})
+
+	t.Run("#SHF_02->Novels are put on shelves and taken off", func(t *testing.T) {
+		path := fmt.Sprintf("/user/shelves/%d/novels/", favourites.ID)
+		doRequest(router, http.MethodPut, fmt.Sprintf("%s%d", path, novels[0].ID), "")
+		doRequest(router, http.MethodPut, fmt.Sprintf("%s%d", path, novels[1].ID), "")
+		w := doRequest(router, http.MethodPut, fmt.Sprintf("%s%d", path, novels[1].ID), "")
+		shelf := decodeShelf(t, w.Code, w.Body.Bytes(), http.StatusOK)
+		if assert.Len(t, shelf.Novels, 2) {
+			assert.Equal(t, "Reverend Insanity", shelf.Novels[0].Title)
+			assert.Equal(t, "Warlock of the Magus World", shelf.Novels[1].Title)
+		}
+
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("/user/shelves/%d/novels/%d", rereads.ID, novels[1].ID), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(router, http.MethodDelete, fmt.Sprintf("%s%d", path, novels[0].ID), "")
+		shelf = decodeShelf(t, w.Code, w.Body.Bytes(), http.StatusOK)
+		assert.Len(t, shelf.Novels, 1)
+
+		w = doRequest(router, http.MethodGet, "/user/shelves", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		var shelves []models.Shelf
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &shelves))
+		if assert.Len(t, shelves, 2) {
+			assert.Equal(t, "Favourites", shelves[0].Name)
+			assert.Len(t, shelves[0].Novels, 1)
+			assert.Equal(t, "To reread", shelves[1].Name)
+			assert.Len(t, shelves[1].Novels, 1)
+		}
+
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("%s%d", path, 99999), "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#SHF_03->Shelves are renamed and deleted", func(t *testing.T) {
+		w := doRequest(router, http.MethodPut, fmt.Sprintf("/user/shelves/%d", rereads.ID), `{"name":"Favourites"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("/user/shelves/%d", rereads.ID), `{"name":"Rereads"}`)
+		shelf := decodeShelf(t, w.Code, w.Body.Bytes(), http.StatusOK)
+		assert.Equal(t, "Rereads", shelf.Name)
+		assert.Len(t, shelf.Novels, 1)
+
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("/user/shelves/%d", rereads.ID), `{"name":"Rereads"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(router, http.MethodDelete, fmt.Sprintf("/user/shelves/%d", rereads.ID), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var onShelves int64
+		db.Table("shelf_novels").Where("shelf_id = ?", rereads.ID).Count(&onShelves)
+		assert.Zero(t, onShelves)
+		var stored int64
+		db.Model(&models.Novel{}).Count(&stored)
+		assert.Equal(t, int64(2), stored)
+
+		w = doRequest(router, http.MethodGet, fmt.Sprintf("/user/shelves/%d", rereads.ID), "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.SHELF_NOT_FOUND)
+	})
+
+	t.Run("#SHF_04->Shelves of other users are out of reach", func(t *testing.T) {
+		for _, request := range []struct{ method, path string }{
+			{http.MethodGet, fmt.Sprintf("/other/user/shelves/%d", favourites.ID)},
+			{http.MethodDelete, fmt.Sprintf("/other/user/shelves/%d", favourites.ID)},
+			{http.MethodPut, fmt.Sprintf("/other/user/shelves/%d/novels/%d", favourites.ID, novels[0].ID)},
+		} {
+			w := doRequest(router, request.method, request.path, "")
+			assert.Equal(t, http.StatusNotFound, w.Code, request.path)
+		}
+
+		w := doRequest(router, http.MethodGet, fmt.Sprintf("/user/shelves/%d", favourites.ID), "")
+		assert.Len(t, decodeShelf(t, w.Code, w.Body.Bytes(), http.StatusOK).Novels, 1)
+	})
+}
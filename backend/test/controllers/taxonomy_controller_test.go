@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"backend/internal/controllers"
+	"backend/internal/dtos"
+	"backend/internal/models"
+	"backend/internal/repositories"
+	"backend/internal/services"
+	"backend/internal/types/errors"
+	"backend/test/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupTaxonomy cleans the database, creates novels with duplicate tags and returns a router serving the taxonomy
+// endpoints.
+func setupTaxonomy(t *testing.T) *gin.Engine {
+	utils.TruncateTables(t, db)
+
+	novelRepository := repositories.NewNovelRepository(db)
+	novels := []models.Novel{
+		{Title: "Star Rail", Tags: []models.Tag{{Name: "Sci-fi"}, {Name: "Space"}}, Genres: []models.Genre{{Name: "Action"}}, Authors: []models.Author{{Name: "Liu Cixin"}}},
+		{Title: "Dark Forest", Tags: []models.Tag{{Name: "Sci-Fi"}, {Name: "Space"}}, Genres: []models.Genre{{Name: "Drama"}}, Authors: []models.Author{{Name: "Liu Cixin"}}},
+		{Title: "Mecha Days", Tags: []models.Tag{{Name: "Sci-fi"}, {Name: "Sci-Fi"}}, Genres: []models.Genre{{Name: "Action"}}, Authors: []models.Author{{Name: "Liu  Cixin"}}},
+	}
+	for i, novel := range novels {
+		novel.NovelUpdatesID = fmt.Sprintf("taxonomy-novel-%d", i)
+		novel.Status = "Ongoing"
+		if _, err := novelRepository.CreateNovel(novel); err != nil {
+			t.Fatalf("Failed to create novel: %v", err)
+		}
+	}
+
+	if err := db.Create(&models.Tag{Name: "Unused"}).Error; err != nil {
+		t.Fatalf("Failed to create tag: %v", err)
+	}
+
+	taxonomyController := controllers.NewTaxonomyController(services.NewTaxonomyService(repositories.NewTaxonomyRepository(db)))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	taxonomy := router.Group("/taxonomy/:kind")
+	taxonomy.GET("", taxonomyController.GetEntries)
+	taxonomy.GET("/:id", taxonomyController.GetEntry)
+	taxonomy.PUT("/:id/description", taxonomyController.UpdateEntryDescription)
+	taxonomy.POST("/:id/merge", taxonomyController.MergeEntries)
+	taxonomy.DELETE("/:id", taxonomyController.DeleteEntry)
+
+	return router
+}
+
+// getTaxonomyEntries lists the entries of a taxonomy and decodes them.
+func getTaxonomyEntries(t *testing.T, router *gin.Engine, path string) []models.TaxonomyEntry {
+	w := doRequest(router, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var response struct {
+		dtos.PaginatedResponse
+		Data []models.TaxonomyEntry `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+// taxonomyEntryID returns the ID of the entry of a taxonomy with a name.
+func taxonomyEntryID(t *testing.T, table, name string) uint {
+	var id uint
+	if err := db.Table(table).Select("id").Where("name = ?", name).Scan(&id).Error; err != nil || id == 0 {
+		t.Fatalf("Failed to find %s %q: %v", table, name, err)
+	}
+	return id
+}
+
+func TestTaxonomyController(t *testing.T) {
+	router := setupTaxonomy(t)
+
+	t.Run("#TAX_01->Entries are listed with their number of novels", func(t *testing.T) {
+		tags := getTaxonomyEntries(t, router, "/taxonomy/tags")
+		assert.Equal(t, []models.TaxonomyEntry{
+			{ID: tags[0].ID, Name: "Sci-Fi", NovelCount: 2},
+			{ID: tags[1].ID, Name: "Sci-fi", NovelCount: 2},
+			{ID: tags[2].ID, Name: "Space", NovelCount: 2},
+			{ID: tags[3].ID, Name: "Unused", NovelCount: 0},
+		}, tags)
+
+		authors := getTaxonomyEntries(t, router, "/taxonomy/authors")
+		assert.Len(t, authors, 2)
+
+		w := doRequest(router, http.MethodGet, "/taxonomy/publishers", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_TAXONOMY_KIND)
+	})
+
+	t.Run("#TAX_02->Entries are searched by name", func(t *testing.T) {
+		tags := getTaxonomyEntries(t, router, "/taxonomy/tags?q=SCI")
+		assert.Len(t, tags, 2)
+
+		w := doRequest(router, http.MethodGet, "/taxonomy/genres?q=romance", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("#TAX_03->Descriptions of genres and tags are edited", func(t *testing.T) {
+		id := taxonomyEntryID(t, "genres", "Action")
+		w := doRequest(router, http.MethodPut, fmt.Sprintf("/taxonomy/genres/%d/description", id), `{"description":"Fights and battles."}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"description":"Fights and battles."`)
+		assert.Contains(t, w.Body.String(), `"novelCount":2`)
+
+		authorID := taxonomyEntryID(t, "authors", "Liu Cixin")
+		w = doRequest(router, http.MethodPut, fmt.Sprintf("/taxonomy/authors/%d/description", authorID), `{"description":"A writer."}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errors.INVALID_TAXONOMY_DESCRIPTION)
+
+		w = doRequest(router, http.MethodPut, "/taxonomy/genres/999999/description", `{"description":"Missing."}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), errors.TAXONOMY_ENTRY_NOT_FOUND)
+	})
+
+	t.Run("#TAX_04->Duplicates are merged", func(t *testing.T) {
+		source := taxonomyEntryID(t, "tags", "Sci-Fi")
+		target := taxonomyEntryID(t, "tags", "Sci-fi")
+
+		w := doRequest(router, http.MethodPost, fmt.Sprintf("/taxonomy/tags/%d/merge", source), fmt.Sprintf(`{"targetId":%d}`, target))
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), `"novelCount":3`)
+
+		// The novel tagged with both keeps a single tag
+		var count int64
+		db.Table("novel_tags").Where("tag_id = ?", target).Count(&count)
+		assert.Equal(t, int64(3), count)
+
+		w = doRequest(router, http.MethodGet, fmt.Sprintf("/taxonomy/tags/%d", source), "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// The novels of a duplicate author are moved to the author kept
+		authorSource := taxonomyEntryID(t, "authors", "Liu  Cixin")
+		authorTarget := taxonomyEntryID(t, "authors", "Liu Cixin")
+		w = doRequest(router, http.MethodPost, fmt.Sprintf("/taxonomy/authors/%d/merge", authorSource), fmt.Sprintf(`{"targetId":%d}`, authorTarget))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"novelCount":3`)
+
+		w = doRequest(router, http.MethodPost, fmt.Sprintf("/taxonomy/tags/%d/merge", target), fmt.Sprintf(`{"targetId":%d}`, target))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = doRequest(router, http.MethodPost, fmt.Sprintf("/taxonomy/tags/%d/merge", target), `{"targetId":999999}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = doRequest(router, http.MethodPost, fmt.Sprintf("/taxonomy/tags/%d/merge", target), `{}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("#TAX_05->Only unused entries are deleted", func(t *testing.T) {
+		w := doRequest(router, http.MethodDelete, fmt.Sprintf("/taxonomy/tags/%d", taxonomyEntryID(t, "tags", "Space")), "")
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), errors.TAXONOMY_ENTRY_IN_USE)
+
+		unused := taxonomyEntryID(t, "tags", "Unused")
+		w = doRequest(router, http.MethodDelete, fmt.Sprintf("/taxonomy/tags/%d", unused), "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(router, http.MethodDelete, fmt.Sprintf("/taxonomy/tags/%d", unused), "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
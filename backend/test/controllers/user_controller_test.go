@@ -7,6 +7,7 @@ import (
 	"backend/internal/models"
 	"backend/internal/repositories"
 	repositoryInterfaces "backend/internal/repositories/interfaces"
+	"backend/internal/scrapers"
 	"backend/internal/services"
 	serviceInterfaces "backend/internal/services/interfaces"
 	"backend/internal/utils"
@@ -91,7 +92,7 @@ func cleanDB() {
 	scriptExecutor = &utils.RealScriptExecutor{}
 
 	userService = services.NewUserService(userRepo)
-	novelService = services.NewNovelService(novelRepo, scriptExecutor)
+	novelService = services.NewNovelService(novelRepo, scrapers.NewPythonSource(scriptExecutor))
 	authService = services.NewAuthService(userRepo, authRepo)
 
 	userController = *controllers.NewUserController(userService)
@@ -0,0 +1,84 @@
+package mocks
+
+import (
+	"archive/zip"
+	"bytes"
+	"sort"
+)
+
+// NewMockEPUB zips the given files, keyed by their path, into an EPUB. The mimetype file is added first, as the
+// format requires.
+func NewMockEPUB(files map[string]string) []byte {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mimetype, _ := writer.Create("mimetype")
+	mimetype.Write([]byte("application/epub+zip"))
+	for _, name := range names {
+		file, _ := writer.Create(name)
+		file.Write([]byte(files[name]))
+	}
+
+	writer.Close()
+	return buf.Bytes()
+}
+
+// MockEPUB3 returns the files of an EPUB 3 with a navigation document, a cover image, a cover page, two chapters and
+// a chapter missing from the archive.
+func MockEPUB3() map[string]string {
+	return map[string]string{
+		"META-INF/container.xml": `<?xml version="1.0"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles>
+</container>`,
+		"OEBPS/content.opf": `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="id">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:opf="http://www.idpf.org/2007/opf">
+    <dc:title>The Wandering Inn</dc:title>
+    <dc:creator opf:role="aut">pirateaba</dc:creator>
+    <dc:creator opf:role="ill">Someone Else</dc:creator>
+    <dc:language>en</dc:language>
+    <dc:description>&lt;p&gt;An inn &amp;amp; a girl.&lt;/p&gt;</dc:description>
+    <dc:subject>Fantasy</dc:subject>
+    <dc:subject>LitRPG</dc:subject>
+    <dc:date>2017-06-01</dc:date>
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="cover-image" href="Images/cover.jpg" media-type="image/jpeg" properties="cover-image"/>
+    <item id="cover" href="Text/cover.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch1" href="Text/chapter1.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch2" href="Text/chapter%202.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch3" href="Text/chapter3.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine>
+    <itemref idref="cover"/>
+    <itemref idref="nav" linear="yes"/>
+    <itemref idref="ch1"/>
+    <itemref idref="ch2"/>
+    <itemref idref="ch3"/>
+  </spine>
+</package>`,
+		"OEBPS/nav.xhtml": `<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops"><body>
+  <nav epub:type="toc"><ol>
+    <li><a href="Text/chapter1.xhtml">Prologue</a></li>
+    <li><a href="Text/chapter%202.xhtml#start">1.00 The Inn</a></li>
+  </ol></nav>
+</body></html>`,
+		"OEBPS/Images/cover.jpg": "cover image",
+		"OEBPS/Text/cover.xhtml": `<html><body><div><img src="../Images/cover.jpg"/></div></body></html>`,
+		"OEBPS/Text/chapter1.xhtml": `<html><head><title>Chapter</title><style>p { color: red; }</style></head><body>
+  <h1>Prologue</h1>
+  <p>The inn was empty.</p>
+  <p>Erin <em>sighed</em>.</p>
+  <script>alert("hi")</script>
+</body></html>`,
+		"OEBPS/Text/chapter 2.xhtml": `<html><body><section><div><p>Erin walked.</p></div>Then she ran.<br/>And stopped.</section></body></html>`,
+	}
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// MockPage is a recorded page served by the MockFetcher.
+type MockPage struct {
+	File   string
+	Status int
+}
+
+// MockFetcher serves recorded HTML fixtures instead of downloading pages. URLs without a recorded page respond with 404.
+type MockFetcher struct {
+	Pages     map[string]MockPage
+	Requested []string
+}
+
+func (m *MockFetcher) Fetch(url string) ([]byte, int, error) {
+	m.Requested = append(m.Requested, url)
+
+	page, ok := m.Pages[url]
+	if !ok {
+		return []byte("Page not found"), http.StatusNotFound, nil
+	}
+
+	if page.File == "" {
+		return nil, page.Status, nil
+	}
+
+	body, err := os.ReadFile(page.File)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read fixture %s: %w", page.File, err)
+	}
+
+	if page.Status == 0 {
+		page.Status = http.StatusOK
+	}
+
+	return body, page.Status, nil
+}
@@ -34,6 +34,54 @@ func (m *MockNovelRepository) GetNovels(page, limit int) ([]models.Novel, int64,
 	return args.Get(0).([]models.Novel), args.Get(1).(int64), args.Error(2)
 }
 
+// GetNovelsByQuery gets a list of novels matching a query
+func (m *MockNovelRepository) GetNovelsByQuery(query models.NovelQuery, page, limit int) ([]models.Novel, int64, error) {
+	args := m.Called(query, page, limit)
+	return args.Get(0).([]models.Novel), args.Get(1).(int64), args.Error(2)
+}
+
+// GetNovelsPage gets a page of novels matching a query after a cursor
+func (m *MockNovelRepository) GetNovelsPage(query models.NovelQuery, after *models.NovelCursor, limit int) ([]models.Novel, *models.NovelCursor, error) {
+	args := m.Called(query, after, limit)
+	if args.Get(1) == nil {
+		return args.Get(0).([]models.Novel), nil, args.Error(2)
+	}
+	return args.Get(0).([]models.Novel), args.Get(1).(*models.NovelCursor), args.Error(2)
+}
+
+// GetOngoingNovels gets the novels that are not completed
+func (m *MockNovelRepository) GetOngoingNovels() ([]models.Novel, error) {
+	args := m.Called()
+	return args.Get(0).([]models.Novel), args.Error(1)
+}
+
+// UpdateNovelSourcePriority updates the source priority of a novel
+func (m *MockNovelRepository) UpdateNovelSourcePriority(novelID uint, sourcePriority string) error {
+	args := m.Called(novelID, sourcePriority)
+	return args.Error(0)
+}
+
+// UpdateNovel saves the edited fields of a novel
+func (m *MockNovelRepository) UpdateNovel(novel models.Novel, fields []string) (*models.Novel, error) {
+	args := m.Called(novel, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Novel), args.Error(1)
+}
+
+// DeleteNovel deletes a novel
+func (m *MockNovelRepository) DeleteNovel(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// SearchNovelsByTitle gets a list of novels whose title contains a query
+func (m *MockNovelRepository) SearchNovelsByTitle(query string, page, limit int) ([]models.Novel, int64, error) {
+	args := m.Called(query, page, limit)
+	return args.Get(0).([]models.Novel), args.Get(1).(int64), args.Error(2)
+}
+
 // GetNovelsByAuthorName gets a list of novels by author name
 func (m *MockNovelRepository) GetNovelsByAuthorName(authorName string, page, limit int) ([]models.Novel, int64, error) {
 	args := m.Called(authorName, page, limit)
@@ -63,3 +111,9 @@ func (m *MockNovelRepository) GetNovelByUpdatesID(title string) (*models.Novel,
 	args := m.Called(title)
 	return args.Get(0).(*models.Novel), args.Error(1)
 }
+
+// GetNovelByTitle gets a novel by title
+func (m *MockNovelRepository) GetNovelByTitle(title string) (*models.Novel, error) {
+	args := m.Called(title)
+	return args.Get(0).(*models.Novel), args.Error(1)
+}
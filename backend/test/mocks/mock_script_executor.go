@@ -34,3 +34,12 @@ func (m *MockScriptExecutorSourceWebsiteDown) ExecuteScript(script string, args
 
 	return []byte(message), nil
 }
+
+// MockScriptExecutorOutput returns the same output for every script.
+type MockScriptExecutorOutput struct {
+	Output string
+}
+
+func (m *MockScriptExecutorOutput) ExecuteScript(script string, args ...string) ([]byte, error) {
+	return []byte(m.Output), nil
+}
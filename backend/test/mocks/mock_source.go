@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"backend/internal/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSource struct {
+	mock.Mock
+	SourceName string
+}
+
+func (m *MockSource) Name() string {
+	return m.SourceName
+}
+
+func (m *MockSource) FetchNovelMetadata(novelID string) (*models.ImportedNovel, error) {
+	args := m.Called(novelID)
+
+	// Avoid panic if nil is returned
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.ImportedNovel), args.Error(1)
+}
+
+func (m *MockSource) FetchChapter(novelID string, chapterNo int) (*models.ImportedChapterMetadata, error) {
+	args := m.Called(novelID, chapterNo)
+
+	// Avoid panic if nil is returned
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.ImportedChapterMetadata), args.Error(1)
+}
+
+func (m *MockSource) ListChapters(novelID string) ([]models.ImportedChapterListing, error) {
+	args := m.Called(novelID)
+
+	// Avoid panic if nil is returned
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.ImportedChapterListing), args.Error(1)
+}
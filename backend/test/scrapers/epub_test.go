@@ -0,0 +1,118 @@
+package scrapers
+
+import (
+	"backend/internal/models"
+	"backend/internal/scrapers"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// chapterErrorCode returns the code of the error of a chapter of a book, empty if it has none.
+func chapterErrorCode(chapter models.ImportedBookChapter) string {
+	if customErr, ok := chapter.Err.(*types.MyCustomError); ok {
+		return customErr.Code
+	}
+	return ""
+}
+
+func TestParseEPUB(t *testing.T) {
+	t.Run("#EP_01->Reads the metadata of an EPUB 3", func(t *testing.T) {
+		book, err := scrapers.ParseEPUB(mocks.NewMockEPUB(mocks.MockEPUB3()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "The Wandering Inn", book.Title)
+		assert.Equal(t, "An inn & a girl.", book.Synopsis)
+		assert.Equal(t, "en", book.Language)
+		assert.Equal(t, "2017", book.Year)
+		assert.Equal(t, []models.Author{{Name: "pirateaba"}}, book.Authors)
+		assert.Equal(t, []models.Tag{{Name: "Fantasy"}, {Name: "LitRPG"}}, book.Tags)
+		assert.Equal(t, []byte("cover image"), book.Cover)
+		assert.Equal(t, ".jpg", book.CoverExtension)
+	})
+
+	t.Run("#EP_02->Splits the spine into sanitized chapters", func(t *testing.T) {
+		book, err := scrapers.ParseEPUB(mocks.NewMockEPUB(mocks.MockEPUB3()))
+		assert.NoError(t, err)
+		assert.Len(t, book.Chapters, 5)
+
+		assert.Equal(t, errors.CHAPTER_EMPTY, chapterErrorCode(book.Chapters[0]))
+		assert.Equal(t, errors.EPUB_NOT_CHAPTER, chapterErrorCode(book.Chapters[1]))
+
+		assert.NoError(t, book.Chapters[2].Err)
+		assert.Equal(t, "Prologue", book.Chapters[2].Title)
+		assert.Equal(t, "The inn was empty.\n\nErin sighed.", book.Chapters[2].Body)
+
+		assert.NoError(t, book.Chapters[3].Err)
+		assert.Equal(t, "OEBPS/Text/chapter 2.xhtml", book.Chapters[3].Href)
+		assert.Equal(t, "1.00 The Inn", book.Chapters[3].Title)
+		assert.Equal(t, "Erin walked.\n\nThen she ran.\n\nAnd stopped.", book.Chapters[3].Body)
+
+		assert.Equal(t, errors.PARSING_EPUB_CHAPTER, chapterErrorCode(book.Chapters[4]))
+	})
+
+	t.Run("#EP_03->Reads the table of contents and the cover of an EPUB 2", func(t *testing.T) {
+		book, err := scrapers.ParseEPUB(mocks.NewMockEPUB(map[string]string{
+			"META-INF/container.xml": `<container><rootfiles><rootfile full-path="content.opf"/></rootfiles></container>`,
+			"content.opf": `<package xmlns="http://www.idpf.org/2007/opf" version="2.0">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:title>Mother of Learning</dc:title>
+    <dc:creator>nobody103</dc:creator>
+    <meta name="cover" content="cover"/>
+  </metadata>
+  <manifest>
+    <item id="ncx" href="toc.ncx" media-type="application/x-dtbncx+xml"/>
+    <item id="cover" href="cover.png" media-type="image/png"/>
+    <item id="ch1" href="ch1.html" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine toc="ncx"><itemref idref="ch1"/></spine>
+</package>`,
+			"toc.ncx": `<ncx><navMap><navPoint><navLabel><text>Arc 1</text></navLabel><content src="ch1.html"/>
+  <navPoint><navLabel><text>Good Morning Brother</text></navLabel><content src="ch1.html#p1"/></navPoint>
+</navPoint></navMap></ncx>`,
+			"cover.png": "png",
+			"ch1.html":  `<html><body><h2>Chapter 1</h2><p>Zorian woke up.</p></body></html>`,
+		}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.Author{{Name: "nobody103"}}, book.Authors)
+		assert.Equal(t, ".png", book.CoverExtension)
+		assert.Len(t, book.Chapters, 1)
+		assert.Equal(t, "Arc 1", book.Chapters[0].Title)
+		assert.Equal(t, "Chapter 1\n\nZorian woke up.", book.Chapters[0].Body)
+	})
+
+	t.Run("#EP_04->Rejects files that are not EPUBs", func(t *testing.T) {
+		for _, data := range [][]byte{[]byte("not a zip"), mocks.NewMockEPUB(map[string]string{"OEBPS/content.opf": "<package/>"})} {
+			_, err := scrapers.ParseEPUB(data)
+
+			customErr, ok := err.(*types.MyCustomError)
+			assert.True(t, ok)
+			assert.Equal(t, errors.INVALID_EPUB, customErr.Code)
+		}
+	})
+
+	t.Run("#EP_05->Keeps only the image types accepted as covers", func(t *testing.T) {
+		for _, cover := range []struct{ href, mediaType, extension string }{
+			{"Images/cover.jpeg", "image/jpeg", ".jpg"},
+			{"Images/cover.html", "image/webp", ".webp"},
+			{"Images/cover.svg", "image/svg+xml", ""},
+			{"Images/cover.html", "text/html", ""},
+		} {
+			files := mocks.MockEPUB3()
+			files["OEBPS/content.opf"] = strings.Replace(files["OEBPS/content.opf"],
+				`href="Images/cover.jpg" media-type="image/jpeg"`, `href="`+cover.href+`" media-type="`+cover.mediaType+`"`, 1)
+			files["OEBPS/"+cover.href] = "cover image"
+
+			book, err := scrapers.ParseEPUB(mocks.NewMockEPUB(files))
+
+			assert.NoError(t, err)
+			assert.Equal(t, cover.extension, book.CoverExtension, cover.mediaType)
+			assert.Equal(t, cover.extension != "", book.Cover != nil, cover.mediaType)
+		}
+	})
+}
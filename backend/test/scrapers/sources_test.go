@@ -0,0 +1,327 @@
+package scrapers
+
+import (
+	"backend/internal/models"
+	"backend/internal/scrapers"
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/test/mocks"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNovelBinSource_FetchNovelMetadata(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://novelbin.com/b/reverend-insanity": {File: "testdata/novelbin_novel.html"},
+	}}
+	source := scrapers.NewNovelBinSource(fetcher)
+
+	t.Run("#NB_01->Parses the novel page", func(t *testing.T) {
+		novel, err := source.FetchNovelMetadata("reverend-insanity")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Reverend Insanity", novel.Title)
+		assert.Equal(t, "https://novelbin.com/media/novel/reverend-insanity.jpg", novel.CoverUrl)
+		assert.Equal(t, "Completed", novel.Status)
+		assert.Equal(t, "2012", novel.Year)
+		assert.Equal(t, 2334, novel.LatestChapter)
+		assert.Equal(t, []models.Author{{Name: "Gu Zhen Ren"}}, novel.Authors)
+		assert.Equal(t, []models.Genre{{Name: "Action"}, {Name: "Xianxia"}}, novel.Genres)
+		assert.Equal(t, []models.Tag{{Name: "Villain Protagonist"}, {Name: "Cultivation"}}, novel.Tags)
+		assert.Contains(t, novel.Synopsis, "Gu are the true refined essences")
+	})
+
+	t.Run("#NB_02->Unknown novel returns not found", func(t *testing.T) {
+		_, err := source.FetchNovelMetadata("unknown-novel")
+
+		assert.Equal(t, errors.ErrNovelNotFound, err)
+	})
+}
+
+func TestNovelBinSource_ListChapters(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://novelbin.com/ajax/chapter-archive?novelId=reverend-insanity": {File: "testdata/novelbin_chapter_archive.html"},
+	}}
+	source := scrapers.NewNovelBinSource(fetcher)
+
+	chapters, err := source.ListChapters("reverend-insanity")
+
+	assert.NoError(t, err)
+	assert.Len(t, chapters, 3)
+	for i, chapter := range chapters {
+		assert.Equal(t, uint(i+1), chapter.ChapterNo)
+	}
+	assert.Equal(t, "https://novelbin.com/b/reverend-insanity/chapter-1", chapters[0].ChapterUrl)
+}
+
+func TestLightNovelWorldSource_FetchNovelMetadata(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://www.lightnovelworld.co/novel/shadow-slave": {File: "testdata/lightnovelworld_novel.html"},
+	}}
+	source := scrapers.NewLightNovelWorldSource(fetcher)
+
+	novel, err := source.FetchNovelMetadata("shadow-slave")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Shadow Slave", novel.Title)
+	assert.Equal(t, 2105, novel.LatestChapter)
+	assert.Equal(t, "Ongoing", novel.Status)
+	assert.Equal(t, []models.Author{{Name: "Guiltythree"}}, novel.Authors)
+	assert.Equal(t, []models.Genre{{Name: "Action"}, {Name: "Fantasy"}}, novel.Genres)
+	assert.Equal(t, []models.Tag{{Name: "Dark"}, {Name: "Survival"}}, novel.Tags)
+	assert.Equal(t, "Growing up in poverty, Sunny never expected anything good from life.\n\nHowever, even he did not anticipate being chosen by the Nightmare Spell.", novel.Synopsis)
+}
+
+func TestWuxiaBoxSource_FetchChapter(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://www.wuxiabox.com/novel/reverend-insanity_1.html":    {File: "testdata/wuxiabox_chapter.html"},
+		"https://www.wuxiabox.com/novel/reverend-insanity_9000.html": {File: "testdata/wuxiabox_empty_chapter.html"},
+		"https://www.wuxiabox.com/novel/reverend-insanity_2.html":    {Status: http.StatusServiceUnavailable},
+		"https://www.wuxiabox.com/novel/reverend-insanity_3.html":    {File: "testdata/captcha.html"},
+		"https://www.wuxiabox.com/novel/reverend-insanity_4.html":    {Status: http.StatusForbidden},
+		"https://www.wuxiabox.com/novel/reverend-insanity_5.html":    {Status: http.StatusGatewayTimeout},
+		"https://www.wuxiabox.com/novel/reverend-insanity_6.html":    {File: "testdata/wuxiabox_captcha_chapter.html"},
+		"https://www.wuxiabox.com/novel/reverend-insanity_7.html":    {File: "testdata/cloudflare_challenge.html"},
+	}}
+	source := scrapers.NewWuxiaBoxSource(fetcher)
+
+	t.Run("#WB_01->Parses the chapter page", func(t *testing.T) {
+		chapter, err := source.FetchChapter("reverend-insanity", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), chapter.ID)
+		assert.Equal(t, "Chapter 1: Demon Venerable", chapter.Title)
+		assert.Equal(t, "Fang Yuan stood on the mountain.\n\nThe wind howled.", chapter.Body)
+		assert.Equal(t, "https://www.wuxiabox.com/novel/reverend-insanity_1.html", chapter.ChapterUrl)
+	})
+
+	t.Run("#WB_02->Empty chapter returns empty content", func(t *testing.T) {
+		_, err := source.FetchChapter("reverend-insanity", 9000)
+
+		assert.Equal(t, errors.ErrChapterEmpty, err)
+	})
+
+	t.Run("#WB_03->Source error is wrapped", func(t *testing.T) {
+		_, err := source.FetchChapter("reverend-insanity", 2)
+
+		customErr, ok := err.(*types.MyCustomError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.SOURCE_DOWN, customErr.Code)
+	})
+
+	t.Run("#WB_04->Cloudflare challenge pages are reported as blocked", func(t *testing.T) {
+		for _, chapterNo := range []int{3, 7} {
+			_, err := source.FetchChapter("reverend-insanity", chapterNo)
+
+			customErr, ok := err.(*types.MyCustomError)
+			assert.True(t, ok)
+			assert.Equal(t, errors.SOURCE_BLOCKED, customErr.Code)
+		}
+	})
+
+	t.Run("#WB_05->Forbidden response is reported as blocked", func(t *testing.T) {
+		_, err := source.FetchChapter("reverend-insanity", 4)
+
+		customErr, ok := err.(*types.MyCustomError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.SOURCE_BLOCKED, customErr.Code)
+	})
+
+	t.Run("#WB_06->Gateway timeout is reported as a timeout", func(t *testing.T) {
+		_, err := source.FetchChapter("reverend-insanity", 5)
+
+		customErr, ok := err.(*types.MyCustomError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.SOURCE_TIMEOUT, customErr.Code)
+	})
+
+	t.Run("#WB_07->Chapters mentioning a captcha are not blocked", func(t *testing.T) {
+		chapter, err := source.FetchChapter("reverend-insanity", 6)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "The captcha Gu tested every visitor of the clan.", chapter.Body)
+	})
+}
+
+func TestNovTalesSource_FetchChapter(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://novtales.com/chapter/reverend-insanity-1": {File: "testdata/novtales_chapter.html"},
+	}}
+	source := scrapers.NewNovTalesSource(fetcher)
+
+	chapter, err := source.FetchChapter("reverend-insanity", 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Reverend Insanity - Chapter 1: Demon Venerable", chapter.Title)
+	assert.Equal(t, "Fang Yuan stood on the mountain.", chapter.Body)
+}
+
+func TestRegistry(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://novtales.com/chapter/reverend-insanity-1":        {File: "testdata/novtales_chapter.html"},
+		"https://novelbin.com/b/reverend-insanity":                {File: "testdata/novelbin_novel.html"},
+		"https://www.wuxiabox.com/novel/reverend-insanity_2.html": {Status: http.StatusServiceUnavailable},
+	}}
+	registry := scrapers.NewRegistry(
+		scrapers.NewWuxiaBoxSource(fetcher),
+		scrapers.NewNovTalesSource(fetcher),
+		scrapers.NewNovelBinSource(fetcher),
+	)
+
+	t.Run("#RG_01->Falls back to the next source", func(t *testing.T) {
+		chapter, err := registry.FetchChapter("reverend-insanity", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://novtales.com/chapter/reverend-insanity-1", chapter.ChapterUrl)
+	})
+
+	t.Run("#RG_02->Skips sources that don't support the operation", func(t *testing.T) {
+		novel, err := registry.FetchNovelMetadata("reverend-insanity")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Reverend Insanity", novel.Title)
+	})
+
+	t.Run("#RG_03->Returns the error of the last source that failed", func(t *testing.T) {
+		_, err := registry.FetchChapter("reverend-insanity", 3)
+
+		assert.Equal(t, errors.ErrChapterNotFound, err)
+	})
+
+	t.Run("#RG_04->Gets sources by name", func(t *testing.T) {
+		source, err := registry.Get("novtales")
+		assert.NoError(t, err)
+		assert.Equal(t, "novtales", source.Name())
+
+		_, err = registry.Get("unknown")
+		assert.Equal(t, errors.ErrSourceNotRegistered, err)
+
+		assert.Equal(t, []string{"wuxiabox", "novtales", "novelbin"}, registry.Names())
+	})
+
+	t.Run("#RG_05->Prefers a missing chapter over a transient error", func(t *testing.T) {
+		_, err := registry.FetchChapter("reverend-insanity", 2)
+		assert.Equal(t, errors.ErrChapterNotFound, err)
+
+		// Without a source reporting the chapter missing, the source being down is worth retrying
+		_, err = registry.FetchChapterFrom([]string{"wuxiabox"}, "reverend-insanity", 2)
+		customErr, ok := err.(*types.MyCustomError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.SOURCE_DOWN, customErr.Code)
+	})
+
+	t.Run("#RG_06->Records the source a chapter was fetched from", func(t *testing.T) {
+		chapter, err := registry.FetchChapter("reverend-insanity", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "novtales", chapter.Source)
+	})
+
+	t.Run("#RG_07->Fetches chapters only from the given sources", func(t *testing.T) {
+		chapter, err := registry.FetchChapterFrom([]string{"novtales", "wuxiabox"}, "reverend-insanity", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "novtales", chapter.Source)
+
+		// novtales has the chapter, but it isn't one of the given sources
+		_, err = registry.FetchChapterFrom([]string{"novelbin"}, "reverend-insanity", 1)
+		assert.Equal(t, errors.ErrChapterNotFound, err)
+
+		_, err = registry.FetchChapterFrom([]string{"unknown"}, "reverend-insanity", 1)
+		assert.Equal(t, errors.ErrSourceNotRegistered, err)
+	})
+}
+
+func TestRegistry_PreferForMetadata(t *testing.T) {
+	fetcher := &mocks.MockFetcher{Pages: map[string]mocks.MockPage{
+		"https://novelbin.com/b/reverend-insanity": {File: "testdata/novelbin_novel.html"},
+	}}
+	python := &mocks.MockSource{SourceName: "python"}
+	python.On("FetchNovelMetadata", "reverend-insanity").Return(&models.ImportedNovel{
+		Title:            "Reverend Insanity",
+		Year:             "2012",
+		ReleaseFrequency: "Every 1.2 Day(s)",
+	}, nil)
+	python.On("FetchNovelMetadata", "lord-of-the-mysteries").Return(nil, errors.ErrNovelNotFound)
+
+	registry := scrapers.NewRegistry(scrapers.NewNovelBinSource(fetcher), python)
+	registry.PreferForMetadata("python", "unknown")
+
+	t.Run("#RG_08->Fetches the metadata from the preferred sources first", func(t *testing.T) {
+		novel, err := registry.FetchNovelMetadata("reverend-insanity")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2012", novel.Year)
+		assert.Equal(t, "Every 1.2 Day(s)", novel.ReleaseFrequency)
+		assert.Equal(t, []string{"novelbin", "python"}, registry.Names())
+	})
+
+	t.Run("#RG_09->Falls back to the other sources for the metadata", func(t *testing.T) {
+		fetcher.Pages["https://novelbin.com/b/lord-of-the-mysteries"] = mocks.MockPage{File: "testdata/novelbin_novel.html"}
+
+		novel, err := registry.FetchNovelMetadata("lord-of-the-mysteries")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Reverend Insanity", novel.Title)
+		python.AssertCalled(t, "FetchNovelMetadata", "lord-of-the-mysteries")
+	})
+}
+
+func TestPythonSource(t *testing.T) {
+	t.Run("#PY_01->Script errors are reported", func(t *testing.T) {
+		source := scrapers.NewPythonSource(&mocks.MockScriptExecutorSourceWebsiteDown{})
+
+		_, err := source.FetchNovelMetadata("reverend-insanity")
+
+		customErr, ok := err.(*types.MyCustomError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.SCRIPT_ERROR, customErr.Code)
+		assert.Equal(t, "Source website down", customErr.Message)
+	})
+
+	t.Run("#PY_02->Chapter listing is unsupported", func(t *testing.T) {
+		source := scrapers.NewPythonSource(&mocks.MockScriptExecutorNetworkDown{})
+
+		_, err := source.ListChapters("reverend-insanity")
+
+		assert.Equal(t, errors.ErrSourceUnsupported, err)
+	})
+
+	t.Run("#PY_03->Chapter errors are classified by status", func(t *testing.T) {
+		statuses := map[int]string{
+			http.StatusNotFound:            errors.CHAPTER_NOT_FOUND,
+			http.StatusNoContent:           errors.CHAPTER_EMPTY,
+			http.StatusForbidden:           errors.SOURCE_BLOCKED,
+			http.StatusBadGateway:          errors.PARSING_SOURCE,
+			http.StatusGatewayTimeout:      errors.SOURCE_TIMEOUT,
+			http.StatusServiceUnavailable:  errors.SOURCE_DOWN,
+			http.StatusInternalServerError: errors.SCRIPT_ERROR,
+		}
+
+		for status, code := range statuses {
+			source := scrapers.NewPythonSource(&mocks.MockScriptExecutorOutput{
+				Output: fmt.Sprintf(`{"status": %d, "chapter_no": "1", "error": "Failed"}`, status),
+			})
+
+			_, err := source.FetchChapter("reverend-insanity", 1)
+
+			customErr, ok := err.(*types.MyCustomError)
+			assert.True(t, ok)
+			assert.Equal(t, code, customErr.Code, "status %d", status)
+		}
+	})
+
+	t.Run("#PY_04->Chapter keeps the website it was fetched from", func(t *testing.T) {
+		source := scrapers.NewPythonSource(&mocks.MockScriptExecutorOutput{
+			Output: `{"status": 200, "chapter_no": "1", "title": "Chapter 1", "url": "https://novtales.com/chapter/reverend-insanity-1", "source": "novtales", "body": "Text"}`,
+		})
+
+		chapter, err := source.FetchChapter("reverend-insanity", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "novtales", chapter.Source)
+		assert.Equal(t, "https://novtales.com/chapter/reverend-insanity-1", chapter.ChapterUrl)
+	})
+}
@@ -0,0 +1,175 @@
+package scrapers
+
+import (
+	"backend/internal/models"
+	"backend/internal/scrapers"
+	"backend/internal/types/errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// statusFetcher responds with the given statuses in order, repeating the last one, and the given body.
+type statusFetcher struct {
+	mu       sync.Mutex
+	statuses []int
+	body     []byte
+	calls    int
+}
+
+func (f *statusFetcher) Fetch(url string) ([]byte, int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := f.statuses[min(f.calls, len(f.statuses)-1)]
+	f.calls++
+	return f.body, status, nil
+}
+
+// fastLimits are limits whose delays don't slow the tests down.
+var fastLimits = models.SourceLimits{
+	RequestsPerSecond: 1000,
+	Burst:             10,
+	MaxRetries:        3,
+	BaseBackoff:       time.Millisecond,
+	MaxBackoff:        2 * time.Millisecond,
+	FailureThreshold:  2,
+	Cooldown:          50 * time.Millisecond,
+}
+
+func TestThrottledFetcher_Retries(t *testing.T) {
+	t.Run("#TH_01->Retries 429 and 5xx responses until one succeeds", func(t *testing.T) {
+		fetcher := &statusFetcher{statuses: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusOK}}
+		throttled := scrapers.NewThrottledFetcher("test", fetcher, fastLimits)
+
+		_, status, err := throttled.Fetch("https://example.com/a")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, 3, fetcher.calls)
+		assert.Equal(t, int64(2), throttled.Status().Retries)
+	})
+
+	t.Run("#TH_02->Doesn't retry other responses", func(t *testing.T) {
+		fetcher := &statusFetcher{statuses: []int{http.StatusNotFound}}
+		throttled := scrapers.NewThrottledFetcher("test", fetcher, fastLimits)
+
+		_, status, _ := throttled.Fetch("https://example.com/a")
+
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, 1, fetcher.calls)
+	})
+
+	t.Run("#TH_03->Gives up after the maximum number of retries", func(t *testing.T) {
+		fetcher := &statusFetcher{statuses: []int{http.StatusServiceUnavailable}}
+		throttled := scrapers.NewThrottledFetcher("test", fetcher, fastLimits)
+
+		_, status, _ := throttled.Fetch("https://example.com/a")
+
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+		assert.Equal(t, 4, fetcher.calls)
+		assert.Equal(t, int64(1), throttled.Status().Failures)
+	})
+}
+
+func TestThrottledFetcher_CircuitBreaker(t *testing.T) {
+	fetcher := &statusFetcher{statuses: []int{500, 500, 500, 500, 500, 500, 500, 500, http.StatusOK}}
+	limits := fastLimits
+	limits.MaxRetries = 3
+	throttled := scrapers.NewThrottledFetcher("test", fetcher, limits)
+
+	t.Run("#TH_04->Source is paused after consecutive failures", func(t *testing.T) {
+		throttled.Fetch("https://example.com/a")
+		assert.Equal(t, models.CircuitClosed, throttled.Status().State)
+
+		throttled.Fetch("https://example.com/a")
+		status := throttled.Status()
+		assert.Equal(t, models.CircuitOpen, status.State)
+		assert.NotNil(t, status.PausedUntil)
+
+		_, _, err := throttled.Fetch("https://example.com/a")
+		assert.Equal(t, errors.ErrSourceCircuitOpen, err)
+		assert.Equal(t, 8, fetcher.calls)
+		assert.Equal(t, int64(1), throttled.Status().Rejected)
+	})
+
+	t.Run("#TH_05->Source is resumed once a request succeeds after the pause", func(t *testing.T) {
+		time.Sleep(limits.Cooldown)
+		assert.Equal(t, models.CircuitHalfOpen, throttled.Status().State)
+
+		_, status, err := throttled.Fetch("https://example.com/a")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, models.CircuitClosed, throttled.Status().State)
+		assert.Equal(t, 0, throttled.Status().ConsecutiveFailures)
+	})
+}
+
+func TestThrottledFetcher_Blocked(t *testing.T) {
+	responses := map[string]*statusFetcher{
+		"403":            {statuses: []int{http.StatusForbidden}},
+		"challenge page": {statuses: []int{http.StatusOK}, body: []byte("<html><head><title>Just a moment...</title></head></html>")},
+	}
+
+	for name, fetcher := range responses {
+		t.Run("#TH_10->Source is paused after consecutive blocked requests: "+name, func(t *testing.T) {
+			throttled := scrapers.NewThrottledFetcher("test", fetcher, fastLimits)
+
+			throttled.Fetch("https://example.com/a")
+			throttled.Fetch("https://example.com/a")
+
+			// Blocked requests aren't retried
+			assert.Equal(t, 2, fetcher.calls)
+			assert.Equal(t, int64(2), throttled.Status().Failures)
+			assert.Equal(t, models.CircuitOpen, throttled.Status().State)
+		})
+	}
+}
+
+func TestThrottledFetcher_RateLimit(t *testing.T) {
+	fetcher := &statusFetcher{statuses: []int{http.StatusOK}}
+	limits := fastLimits
+	limits.RequestsPerSecond = 50
+	limits.Burst = 1
+	throttled := scrapers.NewThrottledFetcher("test", fetcher, limits)
+
+	t.Run("#TH_06->Requests to a host are spread out", func(t *testing.T) {
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			throttled.Fetch("https://example.com/a")
+		}
+
+		// The first request uses the burst, the 4 others wait 20ms each
+		assert.GreaterOrEqual(t, time.Since(start), 75*time.Millisecond)
+	})
+
+	t.Run("#TH_07->Hosts are limited separately", func(t *testing.T) {
+		start := time.Now()
+		throttled.Fetch("https://other.example.com/a")
+
+		assert.Less(t, time.Since(start), 15*time.Millisecond)
+	})
+}
+
+func TestParseSourceLimits(t *testing.T) {
+	t.Run("#TH_08->Configured keys override the defaults", func(t *testing.T) {
+		limits, err := scrapers.ParseSourceLimits("novelbin:rps=0.5,burst=1; wuxiabox:cooldown=5m,retries=0", scrapers.DefaultSourceLimits)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.5, limits["novelbin"].RequestsPerSecond)
+		assert.Equal(t, 1, limits["novelbin"].Burst)
+		assert.Equal(t, scrapers.DefaultSourceLimits.Cooldown, limits["novelbin"].Cooldown)
+		assert.Equal(t, 5*time.Minute, limits["wuxiabox"].Cooldown)
+		assert.Equal(t, 0, limits["wuxiabox"].MaxRetries)
+	})
+
+	for _, spec := range []string{"novelbin", "novelbin:rps", "novelbin:speed=1", "novelbin:cooldown=soon"} {
+		t.Run("#TH_09->Invalid specification "+spec, func(t *testing.T) {
+			_, err := scrapers.ParseSourceLimits(spec, scrapers.DefaultSourceLimits)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package utils_test
+
+import (
+	"backend/internal/models"
+	"backend/internal/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMissingChapterRanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    []uint
+		from, to    int
+		gaps        []models.ChapterRange
+		newChapters *models.ChapterRange
+	}{
+		{"#CR_01->No stored chapters", nil, 1, 5, nil, &models.ChapterRange{From: 1, To: 5}},
+		{"#CR_02->All chapters stored", []uint{1, 2, 3}, 1, 3, nil, nil},
+		{"#CR_03->Gaps and new chapters", []uint{5, 1, 2, 7}, 1, 9, []models.ChapterRange{{From: 3, To: 4}, {From: 6, To: 6}}, &models.ChapterRange{From: 8, To: 9}},
+		{"#CR_04->Range below the latest stored chapter", []uint{1, 10}, 3, 5, []models.ChapterRange{{From: 3, To: 5}}, nil},
+		{"#CR_05->Range above the latest stored chapter", []uint{1, 2}, 5, 6, nil, &models.ChapterRange{From: 5, To: 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gaps, newChapters := utils.GetMissingChapterRanges(tt.existing, tt.from, tt.to)
+			assert.Equal(t, tt.gaps, gaps)
+			assert.Equal(t, tt.newChapters, newChapters)
+		})
+	}
+}
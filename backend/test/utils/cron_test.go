@@ -0,0 +1,68 @@
+package utils_test
+
+import (
+	"backend/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronSchedule_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2024, time.January, 10, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   time.Time
+	}{
+		{"#CRON_01->Every minute", "* * * * *", time.Date(2024, time.January, 10, 10, 18, 0, 0, time.UTC)},
+		{"#CRON_02->Every 6 hours", "0 */6 * * *", time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)},
+		{"#CRON_03->Daily at a fixed time", "30 2 * * *", time.Date(2024, time.January, 11, 2, 30, 0, 0, time.UTC)},
+		{"#CRON_04->Weekly on sunday written as 7", "30 3 * * 7", time.Date(2024, time.January, 14, 3, 30, 0, 0, time.UTC)},
+		{"#CRON_05->Lists and ranges", "15,45 9-11 * * 1-5", time.Date(2024, time.January, 10, 10, 45, 0, 0, time.UTC)},
+		{"#CRON_06->Day of month or day of week", "0 0 1 * 5", time.Date(2024, time.January, 12, 0, 0, 0, 0, time.UTC)},
+		{"#CRON_07->Next year", "0 0 1 1 *", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"#CRON_08->Never", "0 0 31 2 *", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := utils.ParseCronSchedule(tt.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseCronSchedule_Invalid(t *testing.T) {
+	for _, expression := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+		t.Run("#CRON_09->"+expression, func(t *testing.T) {
+			_, err := utils.ParseCronSchedule(expression)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseReleaseFrequency(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+		known    bool
+	}{
+		{"#RF_01->NovelUpdates days", "Every 2.5 Day(s)", 2.5, true},
+		{"#RF_02->Weeks", "Every 2 Week(s)", 14, true},
+		{"#RF_03->Daily", "Daily", 1, true},
+		{"#RF_04->Unknown", "N/A", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days, known := utils.ParseReleaseFrequency(tt.input)
+			assert.Equal(t, tt.expected, days)
+			assert.Equal(t, tt.known, known)
+		})
+	}
+}
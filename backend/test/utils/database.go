@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"backend/config"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// TruncateTables deletes the rows of every table migrated by config.ConnectDB, the join tables of the models included,
+// so that a test starts from an empty database. The tables are emptied in reverse migration order, the join tables
+// first, so that no row is left referencing a deleted one.
+//
+// Parameters:
+//   - t testing.TB (The test, failed if a table could not be emptied)
+//   - db *gorm.DB (The database of the tests)
+func TruncateTables(t testing.TB, db *gorm.DB) {
+	t.Helper()
+
+	var joinTables, modelTables []string
+	for i := len(config.MigratedModels) - 1; i >= 0; i-- {
+		statement := &gorm.Statement{DB: db}
+		if err := statement.Parse(config.MigratedModels[i]); err != nil {
+			t.Fatalf("Failed to parse the model of a table: %v", err)
+		}
+
+		for _, relationship := range statement.Schema.Relationships.Many2Many {
+			joinTables = append(joinTables, relationship.JoinTable.Table)
+		}
+		modelTables = append(modelTables, statement.Schema.Table)
+	}
+
+	emptied := make(map[string]bool)
+	for _, table := range append(joinTables, modelTables...) {
+		if emptied[table] {
+			continue
+		}
+		if err := db.Exec("DELETE FROM " + table).Error; err != nil {
+			t.Fatalf("Failed to clean up the database: %v", err)
+		}
+		emptied[table] = true
+	}
+}
@@ -0,0 +1,79 @@
+package utils_test
+
+import (
+	"archive/zip"
+	"backend/internal/scrapers"
+	"backend/internal/utils"
+	"bytes"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pngImage returns the content of a 1x1 PNG image.
+func pngImage(t *testing.T) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatalf("Failed to encode image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestEPUBWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := utils.NewEPUBWriter(&buf, utils.EPUBMetadata{
+		Identifier:  "urn:novel:reverend-insanity",
+		Title:       "Reverend Insanity",
+		Description: "A demon & his schemes.",
+		Authors:     []string{"Gu Zhen Ren"},
+		Modified:    time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	added, err := writer.AddCover([]byte("not an image"))
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	added, err = writer.AddCover(pngImage(t))
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	assert.NoError(t, writer.AddChapter("Chapter 1 <Start>", []string{"Fang Yuan stood on the mountain.", "The wind \x01howled & roared."}))
+	assert.NoError(t, writer.AddChapter("Chapter 2", []string{"Spring Autumn Cicada."}))
+	assert.NoError(t, writer.Close())
+
+	t.Run("#EW_01->Mimetype is the first entry and is stored uncompressed", func(t *testing.T) {
+		archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+		assert.Equal(t, "mimetype", archive.File[0].Name)
+		assert.Equal(t, zip.Store, archive.File[0].Method)
+
+		file, _ := archive.File[0].Open()
+		mimetype, _ := io.ReadAll(file)
+		assert.Equal(t, "application/epub+zip", string(mimetype))
+	})
+
+	t.Run("#EW_02->Written EPUB can be read back", func(t *testing.T) {
+		book, err := scrapers.ParseEPUB(buf.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, "Reverend Insanity", book.Title)
+		assert.Equal(t, "en", book.Language)
+		assert.Equal(t, "A demon & his schemes.", book.Synopsis)
+		assert.Equal(t, "Gu Zhen Ren", book.Authors[0].Name)
+		assert.Equal(t, ".png", book.CoverExtension)
+
+		// The cover page and the table of contents are not chapters
+		var chapters []string
+		for _, chapter := range book.Chapters {
+			if chapter.Err == nil {
+				chapters = append(chapters, chapter.Title)
+			}
+		}
+		assert.Equal(t, []string{"Chapter 1 <Start>", "Chapter 2"}, chapters)
+		assert.Equal(t, "Fang Yuan stood on the mountain.\n\nThe wind howled & roared.", book.Chapters[len(book.Chapters)-2].Body)
+	})
+}
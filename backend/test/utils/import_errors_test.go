@@ -0,0 +1,49 @@
+package utils_test
+
+import (
+	"backend/internal/types"
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// timeoutError is a net.Error reporting a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestGetImportErrorCode(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		code      string
+		retryable bool
+	}{
+		{"#IE_01->No error", nil, "", false},
+		{"#IE_02->Missing chapter", errors.ErrChapterNotFound, errors.CHAPTER_NOT_FOUND, false},
+		{"#IE_03->Empty chapter", errors.ErrChapterEmpty, errors.CHAPTER_EMPTY, false},
+		{"#IE_04->Blocked request", types.WrapError(errors.SOURCE_BLOCKED, "Captcha", http.StatusBadGateway, nil), errors.SOURCE_BLOCKED, false},
+		{"#IE_05->Unexpected page structure", types.WrapError(errors.PARSING_SOURCE, "No content", http.StatusBadGateway, nil), errors.PARSING_SOURCE, false},
+		{"#IE_06->Invalid script output", types.WrapError(errors.IMPORTING_CHAPTER, "Invalid JSON", http.StatusInternalServerError, nil), errors.PARSING_SOURCE, false},
+		{"#IE_07->Source timeout", types.WrapError(errors.SOURCE_TIMEOUT, "Timed out", http.StatusGatewayTimeout, nil), errors.SOURCE_TIMEOUT, true},
+		{"#IE_08->Script failure wrapping a worker timeout", types.WrapError(errors.SCRIPT_ERROR, "Failed", http.StatusServiceUnavailable, errors.ErrScriptWorkerTimeout), errors.SOURCE_TIMEOUT, true},
+		{"#IE_09->Script failure", types.WrapError(errors.SCRIPT_ERROR, "Failed", http.StatusServiceUnavailable, fmt.Errorf("exit status 1")), errors.SOURCE_DOWN, true},
+		{"#IE_10->Open circuit", errors.ErrSourceCircuitOpen, errors.SOURCE_DOWN, true},
+		{"#IE_11->Network timeout", fmt.Errorf("get: %w", timeoutError{}), errors.SOURCE_TIMEOUT, true},
+		{"#IE_12->Unknown error", fmt.Errorf("connection reset"), errors.SOURCE_DOWN, true},
+		{"#IE_13->Error outside the taxonomy", errors.ErrSourceUnsupported, errors.SOURCE_UNSUPPORTED, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, utils.GetImportErrorCode(tt.err))
+			assert.Equal(t, tt.retryable, utils.IsRetryableImportError(tt.err))
+		})
+	}
+}
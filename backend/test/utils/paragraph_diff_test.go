@@ -0,0 +1,56 @@
+package utils_test
+
+import (
+	"backend/internal/dtos"
+	"backend/internal/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitParagraphs(t *testing.T) {
+	assert.Equal(t, []string{"First.", "Second.", "Third."}, utils.SplitParagraphs("First.\n\n  Second.  \n\n\nThird.\n"))
+	assert.Nil(t, utils.SplitParagraphs(" \n\n "))
+}
+
+func TestDiffParagraphs(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to []string
+		changes  []dtos.ParagraphChange
+	}{
+		{"#PD_01->Identical versions", []string{"A", "B"}, []string{"A", "B"}, []dtos.ParagraphChange{
+			{Change: dtos.ParagraphUnchanged, From: 1, To: 1, Text: "A"},
+			{Change: dtos.ParagraphUnchanged, From: 2, To: 2, Text: "B"},
+		}},
+		{"#PD_02->Truncated chapter completed", []string{"A"}, []string{"A", "B", "C"}, []dtos.ParagraphChange{
+			{Change: dtos.ParagraphUnchanged, From: 1, To: 1, Text: "A"},
+			{Change: dtos.ParagraphAdded, To: 2, Text: "B"},
+			{Change: dtos.ParagraphAdded, To: 3, Text: "C"},
+		}},
+		{"#PD_03->Ad removed", []string{"A", "Ad", "B"}, []string{"A", "B"}, []dtos.ParagraphChange{
+			{Change: dtos.ParagraphUnchanged, From: 1, To: 1, Text: "A"},
+			{Change: dtos.ParagraphRemoved, From: 2, Text: "Ad"},
+			{Change: dtos.ParagraphUnchanged, From: 3, To: 2, Text: "B"},
+		}},
+		{"#PD_04->Paragraph rewritten", []string{"A", "B", "C", "D"}, []string{"A", "X", "C", "D"}, []dtos.ParagraphChange{
+			{Change: dtos.ParagraphUnchanged, From: 1, To: 1, Text: "A"},
+			{Change: dtos.ParagraphRemoved, From: 2, Text: "B"},
+			{Change: dtos.ParagraphAdded, To: 2, Text: "X"},
+			{Change: dtos.ParagraphUnchanged, From: 3, To: 3, Text: "C"},
+			{Change: dtos.ParagraphUnchanged, From: 4, To: 4, Text: "D"},
+		}},
+		{"#PD_05->Paragraphs moved", []string{"A", "B", "C"}, []string{"C", "A", "B"}, []dtos.ParagraphChange{
+			{Change: dtos.ParagraphAdded, To: 1, Text: "C"},
+			{Change: dtos.ParagraphUnchanged, From: 1, To: 2, Text: "A"},
+			{Change: dtos.ParagraphUnchanged, From: 2, To: 3, Text: "B"},
+			{Change: dtos.ParagraphRemoved, From: 3, Text: "C"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.changes, utils.DiffParagraphs(tt.from, tt.to))
+		})
+	}
+}
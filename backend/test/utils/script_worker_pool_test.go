@@ -0,0 +1,186 @@
+package utils_test
+
+import (
+	"backend/internal/types/errors"
+	"backend/internal/utils"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScriptWorkerHelper is not a real test, it is the worker process started by the pool in the tests below.
+func TestScriptWorkerHelper(t *testing.T) {
+	if os.Getenv("SCRIPT_WORKER_HELPER") != "1" {
+		return
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		var request struct {
+			ID     uint64   `json:"id"`
+			Action string   `json:"action"`
+			Args   []string `json:"args"`
+		}
+		_ = json.Unmarshal(scanner.Bytes(), &request)
+
+		switch request.Action {
+		case "crash":
+			os.Exit(3)
+		case "hang":
+			time.Sleep(time.Minute)
+		case "noise":
+			fmt.Println("not a response")
+		case "flood":
+			fmt.Printf("{\"id\": %d, \"result\": \"%s\"}\n", request.ID, strings.Repeat("x", 17*1024*1024))
+			continue
+		}
+
+		result, _ := json.Marshal(map[string]any{"status": 200, "pid": os.Getpid(), "args": request.Args})
+		fmt.Printf("{\"id\": %d, \"result\": %s}\n", request.ID, result)
+
+		if request.Action == "last" {
+			os.Exit(0)
+		}
+	}
+	os.Exit(0)
+}
+
+type workerResult struct {
+	Status int      `json:"status"`
+	Pid    int      `json:"pid"`
+	Args   []string `json:"args"`
+}
+
+func newTestPool(t *testing.T, size int) *utils.ScriptWorkerPool {
+	t.Setenv("SCRIPT_WORKER_HELPER", "1")
+
+	pool := utils.NewScriptWorkerPool(utils.ScriptWorkerPoolConfig{
+		Command:        os.Args[0],
+		Args:           []string{"-test.run=^TestScriptWorkerHelper$"},
+		Size:           size,
+		RequestTimeout: 2 * time.Second,
+	})
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func call(t *testing.T, pool *utils.ScriptWorkerPool, action string, args ...string) workerResult {
+	output, err := pool.Call(action, args...)
+	assert.NoError(t, err)
+
+	var result workerResult
+	assert.NoError(t, json.Unmarshal(output, &result))
+	return result
+}
+
+func TestScriptWorkerPool_ExecuteScript(t *testing.T) {
+	pool := newTestPool(t, 1)
+
+	t.Run("#SWP_01->Module arguments are stripped", func(t *testing.T) {
+		output, err := pool.ExecuteScript("python", "-m", "novel_updates_scraper.client", "import-chapter", "novel", "1")
+
+		assert.NoError(t, err)
+		var result workerResult
+		assert.NoError(t, json.Unmarshal(output, &result))
+		assert.Equal(t, []string{"novel", "1"}, result.Args)
+	})
+
+	t.Run("#SWP_02->Worker is reused between requests", func(t *testing.T) {
+		first := call(t, pool, "echo")
+		second := call(t, pool, "noise")
+
+		assert.Equal(t, first.Pid, second.Pid)
+	})
+
+	t.Run("#SWP_03->Missing action is rejected", func(t *testing.T) {
+		_, err := pool.ExecuteScript("python", "-m", "novel_updates_scraper.client")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestScriptWorkerPool_Restart(t *testing.T) {
+	pool := newTestPool(t, 1)
+
+	t.Run("#SWP_04->Crashed worker is restarted", func(t *testing.T) {
+		before := call(t, pool, "echo")
+
+		_, err := pool.Call("crash")
+		assert.Equal(t, errors.ErrScriptWorkerCrashed, err)
+
+		after := call(t, pool, "echo")
+		assert.NotEqual(t, before.Pid, after.Pid)
+	})
+
+	t.Run("#SWP_05->Unresponsive worker is killed and restarted", func(t *testing.T) {
+		before := call(t, pool, "echo")
+
+		_, err := pool.Call("hang")
+		assert.Equal(t, errors.ErrScriptWorkerTimeout, err)
+
+		after := call(t, pool, "echo")
+		assert.NotEqual(t, before.Pid, after.Pid)
+	})
+
+	t.Run("#SWP_06->Worker whose responses can't be read is restarted", func(t *testing.T) {
+		before := call(t, pool, "echo")
+
+		start := time.Now()
+		_, err := pool.Call("flood")
+		assert.Equal(t, errors.ErrScriptWorkerCrashed, err)
+		assert.Less(t, time.Since(start), 2*time.Second)
+
+		after := call(t, pool, "echo")
+		assert.NotEqual(t, before.Pid, after.Pid)
+	})
+
+	t.Run("#SWP_07->Response written right before exiting is returned", func(t *testing.T) {
+		// Each worker exits after answering, so each request gets a pool of its own
+		for i := 0; i < 20; i++ {
+			result := call(t, newTestPool(t, 1), "last", fmt.Sprint(i))
+			assert.Equal(t, []string{fmt.Sprint(i)}, result.Args)
+		}
+	})
+}
+
+func TestScriptWorkerPool_Concurrency(t *testing.T) {
+	pool := newTestPool(t, 2)
+
+	var mu sync.Mutex
+	pids := make(map[int]bool)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			result := call(t, pool, "echo", fmt.Sprint(i))
+			assert.Equal(t, []string{fmt.Sprint(i)}, result.Args)
+
+			mu.Lock()
+			pids[result.Pid] = true
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, len(pids), 2)
+}
+
+func TestScriptWorkerPool_Close(t *testing.T) {
+	pool := newTestPool(t, 1)
+	call(t, pool, "echo")
+
+	pool.Close()
+
+	_, err := pool.Call("echo")
+	assert.Equal(t, errors.ErrScriptWorkerPoolClosed, err)
+}
@@ -28,7 +28,7 @@ export class BaseBookmarkService implements BookmarkService {
             novelId: novelId,
             userId: userId,
             score: 0,
-            status: 'Plan to Read',
+            status: 'plan-to-read',
             currentChapter: 1,
         };
 